@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+)
+
+func runCheck(args []string) error {
+	cfg, err := loadConfig(flag.NewFlagSet("check", flag.ContinueOnError), args)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	if cfg.TLSEnabled() {
+		if _, err := cfg.LoadTLSCertificate(); err != nil {
+			return fmt.Errorf("failed to load TLS certificate: %v", err)
+		}
+	}
+
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	status, err := checkSchema(context.Background(), db)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Configuration is valid and the database schema is at version %d\n", status.Version)
+	return nil
+}
@@ -0,0 +1,335 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// envPrefix is prepended to the name of every flag to find the
+// environment variable that can be used to set it.
+const envPrefix = "CHACERAPP_"
+
+// Config holds the settings for the API server. Every setting can be
+// provided from a config file, an environment variable, or a flag. Flags
+// take precedence over environment variables, which take precedence over
+// the config file.
+type Config struct {
+	// The URL used to connect to the CockroachDB database.
+	DatabaseURL string
+	// The maximum number of open connections to the database.
+	MaxOpenConns int
+	// The maximum number of idle connections kept in the pool. This
+	// will be reduced to MaxOpenConns when it is greater.
+	MaxIdleConns int
+	// The maximum amount of time a connection may be reused.
+	ConnMaxLifetime time.Duration
+
+	// The address the gRPC server will listen on.
+	GRPCAddr string
+	// The address the HTTP gateway and gRPC-Web server will listen on.
+	HTTPAddr string
+	// The address Prometheus metrics will be served on. The metrics are
+	// not served when it is empty.
+	MetricsAddr string
+	// The TLS certificate and key files. When both are empty the
+	// servers will not use TLS.
+	TLSCertFile string
+	TLSKeyFile  string
+	// The CA certificates client certificates are verified against. Clients
+	// aren't asked for a certificate when it is empty.
+	TLSClientCAFile string
+	// A JSON file of the permissions granted to each client, keyed by the
+	// common name of their client certificate. Clients have none of the
+	// additional permissions of the endpoints when it is empty.
+	PermissionsFile string
+	// The origins that browser clients may call the API from.
+	AllowedOrigins stringList
+
+	// The secret used to encrypt page tokens. It must be 16, 24, or
+	// 32 bytes long.
+	PaginationSecret string
+
+	// The amount of time in-flight requests are given to complete when
+	// the server is shutting down.
+	ShutdownTimeout time.Duration
+	// How often the health of the database is checked.
+	HealthCheckInterval time.Duration
+	// How often accounts with expired suspensions are activated.
+	ReactivationInterval time.Duration
+	// How often watches check for changes made through other servers.
+	WatchInterval time.Duration
+	// How often scheduled messages that are due are sent.
+	DispatchInterval time.Duration
+	// How often messages that have reached their expire time are expired.
+	ExpiryInterval time.Duration
+	// How often the escalation steps that are due are taken.
+	EscalationInterval time.Duration
+
+	// The exporter spans are sent to, in the format `name` or
+	// `name:target`. Tracing is disabled when it is empty.
+	TraceExporter string
+	// The fraction of new traces that will be recorded.
+	TraceSampleRatio float64
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		MaxOpenConns:         25,
+		MaxIdleConns:         25,
+		ConnMaxLifetime:      5 * time.Minute,
+		GRPCAddr:             ":8080",
+		HTTPAddr:             ":8081",
+		MetricsAddr:          ":9090",
+		ShutdownTimeout:      30 * time.Second,
+		HealthCheckInterval:  10 * time.Second,
+		ReactivationInterval: time.Minute,
+		WatchInterval:        2 * time.Second,
+		DispatchInterval:     15 * time.Second,
+		ExpiryInterval:       15 * time.Second,
+		EscalationInterval:   15 * time.Second,
+		TraceSampleRatio:     1,
+	}
+}
+
+// register adds a flag for every setting to the flag set.
+func (c *Config) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.DatabaseURL, "database-url", c.DatabaseURL, "URL of the CockroachDB database, e.g. postgres://root@localhost:26257/chacerapp?sslmode=disable")
+	fs.IntVar(&c.MaxOpenConns, "db-max-open-conns", c.MaxOpenConns, "maximum number of open database connections")
+	fs.IntVar(&c.MaxIdleConns, "db-max-idle-conns", c.MaxIdleConns, "maximum number of idle database connections")
+	fs.DurationVar(&c.ConnMaxLifetime, "db-conn-max-lifetime", c.ConnMaxLifetime, "maximum amount of time a database connection may be reused")
+	fs.StringVar(&c.GRPCAddr, "grpc-addr", c.GRPCAddr, "address the gRPC server will listen on")
+	fs.StringVar(&c.HTTPAddr, "http-addr", c.HTTPAddr, "address the HTTP gateway and gRPC-Web server will listen on")
+	fs.StringVar(&c.MetricsAddr, "metrics-addr", c.MetricsAddr, "address Prometheus metrics will be served on, or empty to disable them")
+	fs.StringVar(&c.TLSCertFile, "tls-cert-file", c.TLSCertFile, "path to the TLS certificate")
+	fs.StringVar(&c.TLSKeyFile, "tls-key-file", c.TLSKeyFile, "path to the TLS private key")
+	fs.StringVar(&c.TLSClientCAFile, "tls-client-ca-file", c.TLSClientCAFile, "path to the CA certificates client certificates are verified against")
+	fs.StringVar(&c.PermissionsFile, "permissions-file", c.PermissionsFile, "path to a JSON file of the permissions granted to each client certificate common name")
+	fs.Var(&c.AllowedOrigins, "allowed-origins", "comma separated list of origins that browser clients may call the API from, or \"*\" to allow any origin")
+	fs.StringVar(&c.PaginationSecret, "pagination-secret", c.PaginationSecret, "secret used to encrypt page tokens, must be 16, 24, or 32 bytes")
+	fs.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout, "amount of time in-flight requests are given to complete during shutdown")
+	fs.DurationVar(&c.HealthCheckInterval, "health-check-interval", c.HealthCheckInterval, "how often the health of the database is checked")
+	fs.DurationVar(&c.ReactivationInterval, "reactivation-interval", c.ReactivationInterval, "how often accounts with expired suspensions are activated")
+	fs.DurationVar(&c.WatchInterval, "watch-interval", c.WatchInterval, "how often watches check for changes made through other servers")
+	fs.DurationVar(&c.DispatchInterval, "dispatch-interval", c.DispatchInterval, "how often scheduled messages that are due are sent")
+	fs.DurationVar(&c.ExpiryInterval, "expiry-interval", c.ExpiryInterval, "how often messages that have reached their expire time are expired")
+	fs.DurationVar(&c.EscalationInterval, "escalation-interval", c.EscalationInterval, "how often the escalation steps that are due are taken")
+	fs.StringVar(&c.TraceExporter, "trace-exporter", c.TraceExporter, "exporter spans are sent to, e.g. stdout or file:/tmp/traces.json, or empty to disable tracing")
+	fs.Float64Var(&c.TraceSampleRatio, "trace-sample-ratio", c.TraceSampleRatio, "fraction of new traces that will be recorded, between 0 and 1")
+}
+
+// Validate will return an error describing every setting that is invalid.
+func (c *Config) Validate() error {
+	var errs []string
+	if c.DatabaseURL == "" {
+		errs = append(errs, "database-url is required")
+	}
+	if c.MaxOpenConns < 0 || c.MaxIdleConns < 0 {
+		errs = append(errs, "database pool sizes must not be negative")
+	}
+	if c.GRPCAddr == "" {
+		errs = append(errs, "grpc-addr is required")
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		errs = append(errs, "tls-cert-file and tls-key-file must be provided together")
+	}
+	if c.TLSClientCAFile != "" && c.TLSCertFile == "" {
+		errs = append(errs, "tls-client-ca-file requires tls-cert-file")
+	}
+	if c.PermissionsFile != "" && c.TLSClientCAFile == "" {
+		errs = append(errs, "permissions-file requires tls-client-ca-file")
+	}
+	switch len(c.PaginationSecret) {
+	case 16, 24, 32:
+	default:
+		errs = append(errs, "pagination-secret must be 16, 24, or 32 bytes")
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, "shutdown-timeout must be greater than zero")
+	}
+	if c.HealthCheckInterval <= 0 {
+		errs = append(errs, "health-check-interval must be greater than zero")
+	}
+	if c.ReactivationInterval <= 0 {
+		errs = append(errs, "reactivation-interval must be greater than zero")
+	}
+	if c.WatchInterval <= 0 {
+		errs = append(errs, "watch-interval must be greater than zero")
+	}
+	if c.DispatchInterval <= 0 {
+		errs = append(errs, "dispatch-interval must be greater than zero")
+	}
+	if c.ExpiryInterval <= 0 {
+		errs = append(errs, "expiry-interval must be greater than zero")
+	}
+	if c.EscalationInterval <= 0 {
+		errs = append(errs, "escalation-interval must be greater than zero")
+	}
+	if c.TraceSampleRatio < 0 || c.TraceSampleRatio > 1 {
+		errs = append(errs, "trace-sample-ratio must be between 0 and 1")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// TLSEnabled reports whether the servers should be served with TLS.
+func (c *Config) TLSEnabled() bool {
+	return c.TLSCertFile != ""
+}
+
+// LoadTLSCertificate loads the configured TLS certificate and key.
+func (c *Config) LoadTLSCertificate() (tls.Certificate, error) {
+	return tls.LoadX509KeyPair(c.TLSCertFile, c.TLSKeyFile)
+}
+
+// LoadClientCAs loads the CA certificates client certificates are verified
+// against.
+func (c *Config) LoadClientCAs() (*x509.CertPool, error) {
+	raw, err := ioutil.ReadFile(c.TLSClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %v", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(raw) {
+		return nil, fmt.Errorf("no certificates found in client CA file %s", c.TLSClientCAFile)
+	}
+	return pool, nil
+}
+
+// LoadPermissions loads the permissions granted to each client certificate
+// common name. No permissions are granted when no file is configured.
+func (c *Config) LoadPermissions() (map[string][]string, error) {
+	if c.PermissionsFile == "" {
+		return nil, nil
+	}
+
+	raw, err := ioutil.ReadFile(c.PermissionsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read permissions file: %v", err)
+	}
+
+	permissions := map[string][]string{}
+	if err := json.Unmarshal(raw, &permissions); err != nil {
+		return nil, fmt.Errorf("failed to parse permissions file %s: %v", c.PermissionsFile, err)
+	}
+	return permissions, nil
+}
+
+// loadConfig will parse the arguments for a command and return the resulting
+// configuration. Settings are loaded from the defaults, then the config file,
+// then the environment, and finally from any flags that were provided.
+func loadConfig(fs *flag.FlagSet, args []string) (*Config, error) {
+	configFile := fs.String("config", os.Getenv(envPrefix+"CONFIG"), "path to a JSON config file")
+	// Flags are parsed into a throwaway config so that we only apply the
+	// ones that were explicitly set once the other sources are loaded.
+	defaultConfig().register(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := defaultConfig()
+	settings := flag.NewFlagSet(fs.Name(), flag.ContinueOnError)
+	cfg.register(settings)
+
+	if *configFile != "" {
+		if err := loadConfigFile(settings, *configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	settings.VisitAll(func(f *flag.Flag) {
+		value, ok := os.LookupEnv(envName(f.Name))
+		if ok && err == nil {
+			if setErr := settings.Set(f.Name, value); setErr != nil {
+				err = fmt.Errorf("invalid value for %s: %v", envName(f.Name), setErr)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip any flags that are specific to the command, such as -config
+	fs.Visit(func(f *flag.Flag) {
+		if settings.Lookup(f.Name) != nil && err == nil {
+			err = settings.Set(f.Name, f.Value.String())
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// loadConfigFile sets the value of each setting in the JSON config file. The
+// file is an object keyed by the names of the flags.
+func loadConfigFile(settings *flag.FlagSet, path string) error {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %v", err)
+	}
+
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %v", path, err)
+	}
+
+	for name, value := range values {
+		if settings.Lookup(name) == nil {
+			return fmt.Errorf("unknown setting %q in config file %s", name, path)
+		}
+
+		var str string
+		switch v := value.(type) {
+		case string:
+			str = v
+		case []interface{}:
+			parts := make([]string, len(v))
+			for i := range v {
+				parts[i] = fmt.Sprint(v[i])
+			}
+			str = strings.Join(parts, ",")
+		default:
+			str = fmt.Sprint(v)
+		}
+
+		if err := settings.Set(name, str); err != nil {
+			return fmt.Errorf("invalid value for %q in config file %s: %v", name, path, err)
+		}
+	}
+	return nil
+}
+
+// envName returns the environment variable for the flag with the given name.
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.Replace(flagName, "-", "_", -1))
+}
+
+// stringList is a flag that accepts a comma separated list of values.
+type stringList []string
+
+func (s *stringList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringList) Set(value string) error {
+	*s = nil
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*s = append(*s, v)
+		}
+	}
+	return nil
+}
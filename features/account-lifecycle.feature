@@ -0,0 +1,271 @@
+Feature: Account lifecycle
+  In order to control which accounts can use the platform
+  As an administrator of the system
+  I need accounts to only move between phases in a defined order
+
+  Background:
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Testing Account" },
+              "account_id": "my-testing-account"
+            },
+            {
+              "@type": "chacerapp.v1.CreateLocationRequest",
+              "parent": "accounts/my-testing-account",
+              "location_id": "default",
+              "location": { "displayName": "Default" }
+            },
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/my-testing-account",
+              "contact": { "displayName": "Alice Smith" },
+              "contactId": "alice-smith"
+            }
+          ]
+        }
+      """
+
+  Scenario: Self-service accounts must be activated
+    Given a JSON "chacerapp.v1.CreateAccountRequest"
+      """
+        {
+          "account": { "displayName": "My Self-Service Account" },
+          "account_id": "my-self-service-account",
+          "self_service": true
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/CreateAccount" RPC
+     Then I will receive a successful response
+      And the response value "status.phase" will be "ACCOUNT_PHASE_PENDING"
+    Given a JSON "chacerapp.v1.ActivateAccountRequest"
+      """
+        { "name": "accounts/my-self-service-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/ActivateAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.GetAccountStatusRequest"
+      """
+        { "name": "accounts/my-self-service-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccountStatus" RPC
+     Then I will receive a successful response
+      And the response value "phase" will be "ACCOUNT_PHASE_ACTIVE"
+
+  Scenario: A suspended account has its resources locked until it is activated
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Billing" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.GetAccountStatusRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccountStatus" RPC
+     Then I will receive a successful response
+      And the response value "phase" will be "ACCOUNT_PHASE_SUSPENDED"
+    Given a JSON "chacerapp.v1.CreateLocationRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account",
+          "location": { "displayName": "Secondary" }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.UpdateLocationRequest"
+      """
+        {
+          "location": {
+            "name": "accounts/my-testing-account/locations/default",
+            "displayName": "Renamed"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/UpdateLocation" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.UpdateContactRequest"
+      """
+        {
+          "contact": {
+            "name": "accounts/my-testing-account/contacts/alice-smith",
+            "displayName": "Alice Jones"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/UpdateContact" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.CheckInRequest"
+      """
+        {
+          "name": "accounts/my-testing-account/contacts/alice-smith",
+          "location": "accounts/my-testing-account/locations/default"
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.GetLocationRequest"
+      """
+        { "name": "accounts/my-testing-account/locations/default" }
+      """
+     When calling the "chacerapp.v1.Locations/GetLocation" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ActivateAccountRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/ActivateAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.CreateLocationRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account",
+          "location": { "displayName": "Secondary" }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     Then I will receive a successful response
+
+  Scenario: An account can be deactivated on request
+    Given a JSON "chacerapp.v1.DeactivateAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "message": "Office closed" }
+      """
+     When calling the "chacerapp.v1.Accounts/DeactivateAccount" RPC
+     Then I will receive a successful response
+     When calling the "chacerapp.v1.Accounts/DeactivateAccount" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.CreateLocationRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account",
+          "location": { "displayName": "Secondary" }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Billing" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+
+  Scenario: The status can only move the account to an allowed phase
+    Given a JSON "chacerapp.v1.UpdateAccountStatusRequest"
+      """
+        {
+          "accountStatus": {
+            "name": "accounts/my-testing-account",
+            "phase": "ACCOUNT_PHASE_SUSPENDED"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountStatus" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.UpdateAccountStatusRequest"
+      """
+        {
+          "accountStatus": {
+            "name": "accounts/my-testing-account",
+            "phase": "ACCOUNT_PHASE_ACTIVE",
+            "message": "Verified by support"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountStatus" RPC
+     Then I will receive a successful response
+      And the response value "message" will be "Verified by support"
+    Given a JSON "chacerapp.v1.UpdateAccountStatusRequest"
+      """
+        {
+          "accountStatus": {
+            "name": "accounts/my-testing-account",
+            "phase": "ACCOUNT_PHASE_ACTIVE",
+            "reason": "Vacation"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountStatus" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | account_status.reason | |
+
+  Scenario: A suspension can only be changed through the suspension endpoints
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Fraud" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.UpdateAccountStatusRequest"
+      """
+        {
+          "accountStatus": {
+            "name": "accounts/my-testing-account",
+            "phase": "ACCOUNT_PHASE_ACTIVE"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountStatus" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.UpdateAccountStatusRequest"
+      """
+        {
+          "accountStatus": {
+            "name": "accounts/my-testing-account",
+            "phase": "ACCOUNT_PHASE_SUSPENDED",
+            "reason": "Billing"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountStatus" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.ActivateAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "selfService": true }
+      """
+     When calling the "chacerapp.v1.Accounts/ActivateAccount" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+
+  Scenario: Suspending an account cancels its messages and disconnects its devices
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/default",
+          "message": { "reason": "Patient ready" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.WatchRoomStatesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/default" }
+      """
+     When watching the "chacerapp.v1.Rooms/WatchRoomStates" RPC
+      And receiving the next streamed response
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Billing" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive a successful response
+      And the stream will end with an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/default" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].state" will be "CANCELLED"
+    Given a JSON "chacerapp.v1.WatchRoomStatesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/default" }
+      """
+     When watching the "chacerapp.v1.Rooms/WatchRoomStates" RPC
+     Then the stream will end with an error with code "FAILED_PRECONDITION"
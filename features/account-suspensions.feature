@@ -0,0 +1,136 @@
+Feature: Account suspensions
+  In order to handle accounts that can't be used for different reasons
+  As an administrator of the system
+  I need accounts to be suspended for a reason from a catalog that decides how the suspension ends
+
+  Background:
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Testing Account" },
+              "account_id": "my-testing-account"
+            }
+          ]
+        }
+      """
+
+  Scenario: Listing the reasons an account can be suspended for
+    Given a JSON "chacerapp.v1.ListSuspensionReasonsRequest"
+      """
+        {}
+      """
+     When calling the "chacerapp.v1.Accounts/ListSuspensionReasons" RPC
+     Then I will receive a successful response
+      And the response value "suspensionReasons" will have a length of 4
+      And the response value "suspensionReasons[0].code" will be "Billing"
+      And the response value "suspensionReasons[0].selfReactivate" will be "true"
+      And the response value "suspensionReasons[2].code" will be "Abuse"
+      And the response value "suspensionReasons[2].reactivateAfter" will be "86400s"
+
+  Scenario: Suspending an account for a reason that is not in the catalog
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Boredom" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | reason | supported values: "Billing", "Fraud", "Abuse", "Security" |
+
+  Scenario: A suspension can't expire in the past
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        {
+          "name": "accounts/my-testing-account",
+          "reason": "Billing",
+          "expire_time": "2020-01-01T00:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | expire_time | expire_time must be in the future |
+
+  Scenario: A temporary suspension is reactivated once it expires
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Abuse" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive a successful response
+    Given the suspensions that expire within "1h" are reactivated
+      And a JSON "chacerapp.v1.GetAccountStatusRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccountStatus" RPC
+     Then I will receive a successful response
+      And the response value "phase" will be "ACCOUNT_PHASE_SUSPENDED"
+    Given the suspensions that expire within "25h" are reactivated
+      And a JSON "chacerapp.v1.GetAccountStatusRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccountStatus" RPC
+     Then I will receive a successful response
+      And the response value "phase" will be "ACCOUNT_PHASE_ACTIVE"
+    Given a JSON "chacerapp.v1.ListAccountSuspensionsRequest"
+      """
+        { "parent": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/ListAccountSuspensions" RPC
+     Then I will receive a successful response
+      And the response value "accountSuspensions" will have a length of 1
+      And the response value "accountSuspensions[0].reason" will be "Abuse"
+      And the response value "accountSuspensions[0].end" will be "SUSPENSION_END_EXPIRED"
+
+  Scenario: The owner of an account can reactivate it when the reason allows it
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Billing" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ActivateAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "self_service": true }
+      """
+     When calling the "chacerapp.v1.Accounts/ActivateAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ListAccountSuspensionsRequest"
+      """
+        { "parent": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/ListAccountSuspensions" RPC
+     Then I will receive a successful response
+      And the response value "accountSuspensions[0].end" will be "SUSPENSION_END_SELF_ACTIVATED"
+
+  Scenario: The owner of an account can't reactivate it when the reason doesn't allow it
+    Given a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Fraud" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ActivateAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "self_service": true }
+      """
+     When calling the "chacerapp.v1.Accounts/ActivateAccount" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.ActivateAccountRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/ActivateAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ListAccountSuspensionsRequest"
+      """
+        { "parent": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/ListAccountSuspensions" RPC
+     Then I will receive a successful response
+      And the response value "accountSuspensions[0].end" will be "SUSPENSION_END_ACTIVATED"
@@ -0,0 +1,176 @@
+Feature: Audit log
+  In order to know who changed a resource and when
+  As an administrator of the system
+  I need every request that could change a resource to be recorded in an audit log
+
+  Background:
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Testing Account" },
+              "account_id": "my-testing-account"
+            },
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Other Account" },
+              "account_id": "my-other-account"
+            }
+          ]
+        }
+      """
+
+  Scenario: Changes to an account are recorded with the fields that changed
+    Given using the request ID "suspend-my-testing-account"
+      And a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Billing" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        { "resource_prefix": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive a successful response
+      And the response value "auditEvents" will have a length of 2
+      And the response value "auditEvents[0].method" will be "/chacerapp.v1.Accounts/SuspendAccount"
+      And the response value "auditEvents[0].resource" will be "accounts/my-testing-account"
+      And the response value "auditEvents[0].requestId" will be "suspend-my-testing-account"
+      And the response value "auditEvents[0].code" will be "OK"
+      And the response value "auditEvents[0].changes[0].path" will be "status.phase"
+      And the response value "auditEvents[0].changes[0].before" will be "ACCOUNT_PHASE_ACTIVE"
+      And the response value "auditEvents[0].changes[0].after" will be "ACCOUNT_PHASE_SUSPENDED"
+      And the response value "auditEvents[0].changes[1].path" will be "status.reason"
+      And the response value "auditEvents[0].changes[1].after" will be "Billing"
+      And the response value "auditEvents[1].method" will be "/chacerapp.v1.Accounts/CreateAccount"
+      And the response value "auditEvents[1].changes[0].path" will be "create_time"
+
+  Scenario: Changes to a contact are recorded with the fields that changed
+    Given a JSON "chacerapp.v1.CreateContactRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account",
+          "contact": { "displayName": "Alice Smith" },
+          "contactId": "alice-smith"
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/CreateContact" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.UpdateContactRequest"
+      """
+        {
+          "contact": {
+            "name": "accounts/my-testing-account/contacts/alice-smith",
+            "displayName": "Alice Jones"
+          },
+          "updateMask": { "paths": ["displayName"] }
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/UpdateContact" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        { "resource_prefix": "accounts/my-testing-account/contacts/alice-smith" }
+      """
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive a successful response
+      And the response value "auditEvents" will have a length of 2
+      And the response value "auditEvents[0].method" will be "/chacerapp.v1.Contacts/UpdateContact"
+      And the response value "auditEvents[0].changes[0].path" will be "display_name"
+      And the response value "auditEvents[0].changes[0].before" will be "Alice Smith"
+      And the response value "auditEvents[0].changes[0].after" will be "Alice Jones"
+
+  Scenario: Failed requests are recorded with their status code
+    Given a JSON "chacerapp.v1.ActivateAccountRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/ActivateAccount" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        { "resource_prefix": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive a successful response
+      And the response value "auditEvents[0].method" will be "/chacerapp.v1.Accounts/ActivateAccount"
+      And the response value "auditEvents[0].code" will be "FailedPrecondition"
+      And the response value "auditEvents[0].changes" will have a length of 0
+
+  Scenario: Requests that only read resources are not recorded
+    Given a JSON "chacerapp.v1.GetAccountRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        {}
+      """
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive a successful response
+      And the response value "auditEvents" will have a length of 2
+      And the response value "auditEvents[0].resource" will be "accounts/my-other-account"
+      And the response value "auditEvents[1].resource" will be "accounts/my-testing-account"
+
+  Scenario: Listing the audit events in pages
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        { "page_size": 1 }
+      """
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive a successful response
+      And the response value "auditEvents" will have a length of 1
+      And the response value "auditEvents[0].resource" will be "accounts/my-other-account"
+      And stashing the next page token from the response
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        { "page_size": 1 }
+      """
+      And using the stashed next page token
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive a successful response
+      And the response value "auditEvents[0].resource" will be "accounts/my-testing-account"
+
+  Scenario: Listing the audit events of an actor
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        { "actor": "192.0.2.1" }
+      """
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive a successful response
+      And the response value "auditEvents" will have a length of 0
+
+  Scenario: A forwarded address sent by a client isn't recorded as the actor
+    Given using the forwarded address "192.0.2.1"
+      And a JSON "chacerapp.v1.SuspendAccountRequest"
+      """
+        { "name": "accounts/my-testing-account", "reason": "Billing" }
+      """
+     When calling the "chacerapp.v1.Accounts/SuspendAccount" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        { "actor": "192.0.2.1" }
+      """
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive a successful response
+      And the response value "auditEvents" will have a length of 0
+
+  Scenario: The end of the time range must be after the start
+    Given a JSON "chacerapp.v1.ListAuditEventsRequest"
+      """
+        {
+          "start_time": "2020-02-01T00:00:00Z",
+          "end_time": "2020-01-01T00:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.AuditLog/ListAuditEvents" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | end_time | end_time must be after start_time |
@@ -0,0 +1,273 @@
+Feature: Contact groups
+  In order to page a whole team at once
+  As a manager of an account
+  I need groups of contacts that messages can be sent to
+
+  Background:
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+
+  Scenario: Members can be added to and removed from a contact group
+    Given a JSON "chacerapp.v1.CreateContactGroupRequest"
+      """
+        {
+          "parent": "accounts/default",
+          "contact_group_id": "front-desk",
+          "contact_group": { "displayName": "Front Desk" }
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/CreateContactGroup" RPC
+     Then I will receive a successful response
+      And the response value "name" will be "accounts/default/contactGroups/front-desk"
+    Given a JSON "chacerapp.v1.AddContactGroupMembersRequest"
+      """
+        {
+          "name": "accounts/default/contactGroups/front-desk",
+          "members": ["accounts/default/contacts/alice-smith", "accounts/default/contacts/bobby-jones"]
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/AddContactGroupMembers" RPC
+     Then I will receive a successful response
+      And the response value "members" will have a length of 2
+    Given a JSON "chacerapp.v1.RemoveContactGroupMembersRequest"
+      """
+        {
+          "name": "accounts/default/contactGroups/front-desk",
+          "members": ["accounts/default/contacts/alice-smith"]
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/RemoveContactGroupMembers" RPC
+     Then I will receive a successful response
+      And the response value "members[0]" will be "accounts/default/contacts/bobby-jones"
+    Given a JSON "chacerapp.v1.ListContactGroupsRequest"
+      """
+        { "parent": "accounts/default" }
+      """
+     When calling the "chacerapp.v1.Contacts/ListContactGroups" RPC
+     Then I will receive a successful response
+      And the response value "contactGroups" will have a length of 1
+
+  Scenario: A contact can only be added to a group once
+    Given a JSON "chacerapp.v1.AddContactGroupMembersRequest"
+      """
+        {
+          "name": "accounts/default/contactGroups/front-desk",
+          "members": ["accounts/default/contacts/alice-smith", "accounts/default/contacts/alice-smith"]
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/AddContactGroupMembers" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | members[1] | contact is listed more than once |
+
+  Scenario: Only contacts in the account of a group can be added to it
+    Given a JSON "chacerapp.v1.AddContactGroupMembersRequest"
+      """
+        {
+          "name": "accounts/default/contactGroups/front-desk",
+          "members": ["accounts/secondary/contacts/alice-smith"]
+        }
+      """
+     When calling the "chacerapp.v1.Contacts/AddContactGroupMembers" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | members[0] | contact must be in the account of the group |
+
+  Scenario: A message can only be sent to a contact group of its account
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Lunch order", "recipient": "accounts/secondary/contactGroups/front-desk" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | message.recipient | contact group must be in the account of the message |
+
+  Scenario: A claim mode can only be set for a contact group
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Lunch order", "recipient": "accounts/default/contacts/alice-smith", "claimMode": "MESSAGE_CLAIM_MODE_ANY_MEMBER" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | message.claim_mode | claim_mode can only be set when the recipient is a contact group |
+
+  Scenario: A message sent to any member is claimed by the first to acknowledge it
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateContactGroupRequest",
+              "parent": "accounts/default",
+              "contact_group_id": "front-desk",
+              "contact_group": { "displayName": "Front Desk" }
+            },
+            {
+              "@type": "chacerapp.v1.AddContactGroupMembersRequest",
+              "name": "accounts/default/contactGroups/front-desk",
+              "members": ["accounts/default/contacts/alice-smith", "accounts/default/contacts/bobby-jones"]
+            }
+          ]
+        }
+      """
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": {
+            "reason": "Patient ready",
+            "recipient": "accounts/default/contactGroups/front-desk",
+            "claimMode": "MESSAGE_CLAIM_MODE_ANY_MEMBER"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "deliveries" will have a length of 2
+      And the response value "deliveries[0].recipient" will be "accounts/default/contacts/alice-smith"
+      And the response value "deliveries[1].state" will be "MESSAGE_DELIVERY_STATE_PENDING"
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/alice-smith", "response": "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "claimedBy" will be "accounts/default/contacts/alice-smith"
+      And the response value "deliveries[0].response" will be "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED"
+      And the response value "deliveries[1].state" will be "MESSAGE_DELIVERY_STATE_WITHDRAWN"
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/bobby-jones", "response": "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/alice-smith", "response": "MESSAGE_RESPONSE_STATE_DECLINED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "ACTIVE"
+      And the response value "claimedBy" will be ""
+      And the response value "deliveries[1].state" will be "MESSAGE_DELIVERY_STATE_PENDING"
+
+  Scenario: A message sent to all members is declined once every member has declined it
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateContactGroupRequest",
+              "parent": "accounts/default",
+              "contact_group_id": "front-desk",
+              "contact_group": { "displayName": "Front Desk" }
+            },
+            {
+              "@type": "chacerapp.v1.AddContactGroupMembersRequest",
+              "name": "accounts/default/contactGroups/front-desk",
+              "members": ["accounts/default/contacts/alice-smith", "accounts/default/contacts/bobby-jones"]
+            }
+          ]
+        }
+      """
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": {
+            "reason": "Staff meeting",
+            "recipient": "accounts/default/contactGroups/front-desk",
+            "claimMode": "MESSAGE_CLAIM_MODE_ALL_MEMBERS"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/alice-smith", "response": "MESSAGE_RESPONSE_STATE_DECLINED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "ACTIVE"
+      And the response value "deliveries[0].response" will be "MESSAGE_RESPONSE_STATE_DECLINED"
+      And the response value "deliveries[1].state" will be "MESSAGE_DELIVERY_STATE_PENDING"
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/bobby-jones", "response": "MESSAGE_RESPONSE_STATE_DECLINED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "DECLINED"
+      And the response value "responses" will have a length of 2
+
+  Scenario: Only the members of a group when a message is sent can respond to it
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateContactGroupRequest",
+              "parent": "accounts/default",
+              "contact_group_id": "front-desk",
+              "contact_group": { "displayName": "Front Desk" }
+            },
+            {
+              "@type": "chacerapp.v1.AddContactGroupMembersRequest",
+              "name": "accounts/default/contactGroups/front-desk",
+              "members": ["accounts/default/contacts/alice-smith", "accounts/default/contacts/bobby-jones"]
+            }
+          ]
+        }
+      """
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": {
+            "reason": "Staff meeting",
+            "recipient": "accounts/default/contactGroups/front-desk",
+            "claimMode": "MESSAGE_CLAIM_MODE_ALL_MEMBERS"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.AddContactGroupMembersRequest"
+      """
+        { "name": "accounts/default/contactGroups/front-desk", "members": ["accounts/default/contacts/carol-white"] }
+      """
+     When calling the "chacerapp.v1.Contacts/AddContactGroupMembers" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/carol-white", "response": "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/bobby-jones", "response": "MESSAGE_RESPONSE_STATE_DECLINED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "ACTIVE"
+      And the response value "deliveries[1].response" will be "MESSAGE_RESPONSE_STATE_DECLINED"
+      And the response value "claimedBy" will be ""
@@ -0,0 +1,156 @@
+Feature: Contact presence
+  In order to page the staff who are in the building
+  As a manager of a location
+  I need contacts to check in and out of locations
+
+  Background:
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/default",
+              "contact": { "displayName": "Alice Smith" },
+              "contactId": "alice-smith"
+            }
+          ]
+        }
+      """
+
+  Scenario: Each change to the presence of a contact is recorded
+    Given using the client certificate for "accounts/default/contacts/alice-smith"
+    Given a JSON "chacerapp.v1.CheckInRequest"
+      """
+        { "name": "accounts/default/contacts/alice-smith", "location": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "PRESENCE_STATE_CHECKED_IN"
+      And the response value "location" will be "accounts/default/locations/default"
+    Given a JSON "chacerapp.v1.CheckOutRequest"
+      """
+        { "name": "accounts/default/contacts/alice-smith", "state": "PRESENCE_STATE_OFF_DUTY" }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckOut" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "PRESENCE_STATE_OFF_DUTY"
+    Given a JSON "chacerapp.v1.ListContactPresenceChangesRequest"
+      """
+        { "parent": "accounts/default/contacts/alice-smith" }
+      """
+     When calling the "chacerapp.v1.Contacts/ListContactPresenceChanges" RPC
+     Then I will receive a successful response
+      And the response value "contactPresenceChanges" will have a length of 2
+      And the response value "contactPresenceChanges[0].previousState" will be "PRESENCE_STATE_CHECKED_IN"
+
+  Scenario: A contact can only check in at a location that exists in its account
+    Given using the client certificate for "accounts/default/contacts/alice-smith"
+    Given a JSON "chacerapp.v1.CheckInRequest"
+      """
+        { "name": "accounts/default/contacts/alice-smith", "location": "accounts/default/locations/missing" }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | location | location does not exist |
+    Given a JSON "chacerapp.v1.CheckInRequest"
+      """
+        { "name": "accounts/default/contacts/alice-smith", "location": "accounts/secondary/locations/default" }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | location | location must be in the account of the contact |
+
+  Scenario: Only the contact or a device at the location can change the presence of a contact
+    Given a JSON "chacerapp.v1.CheckInRequest"
+      """
+        { "name": "accounts/default/contacts/alice-smith", "location": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive an error with code "PERMISSION_DENIED"
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.RegisterDeviceRequest",
+              "parent": "accounts/default/locations/default",
+              "device": { "displayName": "Front desk" },
+              "device_id": "front-desk"
+            }
+          ]
+        }
+      """
+    Given using the client certificate for "accounts/default/contacts/bobby-jones"
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive an error with code "PERMISSION_DENIED"
+    Given using the client certificate for "accounts/default/locations/secondary/devices/front-desk"
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive an error with code "PERMISSION_DENIED"
+    Given using the client certificate for "accounts/default/locations/default/devices/front-desk"
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive a successful response
+      And the response value "location" will be "accounts/default/locations/default"
+    Given a JSON "chacerapp.v1.CheckOutRequest"
+      """
+        { "name": "accounts/default/contacts/alice-smith" }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckOut" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "PRESENCE_STATE_AWAY"
+     When calling the "chacerapp.v1.Contacts/CheckOut" RPC
+     Then I will receive an error with code "PERMISSION_DENIED"
+    Given using the client certificate for "accounts/default/contacts/alice-smith"
+     When calling the "chacerapp.v1.Contacts/CheckOut" RPC
+     Then I will receive a successful response
+
+  Scenario: A message to a contact that isn't checked in is sent with a warning
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Lunch order", "recipient": "accounts/default/contacts/alice-smith" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "warnings[0]" will be "accounts/default/contacts/alice-smith is not checked in at accounts/default/locations/default"
+
+  Scenario: A location can require the recipient to be checked in
+    Given a JSON "chacerapp.v1.UpdateLocationRequest"
+      """
+        {
+          "location": { "name": "accounts/default/locations/default", "presenceCheck": "PRESENCE_CHECK_REQUIRE" },
+          "update_mask": "presence_check"
+        }
+      """
+     When calling the "chacerapp.v1.Locations/UpdateLocation" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Lunch order", "recipient": "accounts/default/contacts/alice-smith" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given using the client certificate for "accounts/default/contacts/alice-smith"
+    Given a JSON "chacerapp.v1.CheckInRequest"
+      """
+        { "name": "accounts/default/contacts/alice-smith", "location": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Lunch order", "recipient": "accounts/default/contacts/alice-smith" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
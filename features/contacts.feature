@@ -1,18 +1,24 @@
-Feature: Manage devices on the API
-  Background: Create an account we can create locations in
+Feature: Manage contacts on the API
+  Background: Create the accounts we can create contacts in
     Given these resources are created:
       """
         {
           "resources": [
             {
               "@type": "chacerapp.v1.CreateAccountRequest",
-              "account": { "displayName": "My Testing Account" },
-              "account_id": "my-testing-account"
+              "account": { "displayName": "Default" },
+              "account_id": "default"
             },
             {
               "@type": "chacerapp.v1.CreateAccountRequest",
-              "account": { "displayName": "My Second Testing Account" },
-              "account_id": "my-second-testing-account"
+              "account": { "displayName": "Secondary" },
+              "account_id": "secondary"
+            },
+            {
+              "@type": "chacerapp.v1.CreateLocationRequest",
+              "parent": "accounts/default",
+              "location": { "displayName": "Default" },
+              "location_id": "default"
             }
           ]
         }
@@ -22,182 +28,156 @@ Feature: Manage devices on the API
     Given a JSON "chacerapp.v1.CreateContactRequest"
       """
         {
-          "parent": "accounts/default-account",
+          "parent": "accounts/default",
           "contact": {
-            "displayName": "Default"
-          }
+            "displayName": "Alice Smith",
+            "labels": { "role": "hygienist" }
+          },
+          "contactId": "alice-smith"
         }
       """
-     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     When calling the "chacerapp.v1.Contacts/CreateContact" RPC
      Then I will receive a successful response
-      And the response value "displayName" will be "Default"
-     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+      And the response value "name" will be "accounts/default/contacts/alice-smith"
+      And the response value "displayName" will be "Alice Smith"
+     When calling the "chacerapp.v1.Contacts/CreateContact" RPC
      Then I will receive an error with code "ALREADY_EXISTS"
     Given a JSON "chacerapp.v1.GetContactRequest"
       """
-        { "name": "accounts/default-account/locations/default" }
+        { "name": "accounts/default/contacts/alice-smith" }
       """
-     When calling the "chacerapp.v1.Locations/GetLocation" RPC
+     When calling the "chacerapp.v1.Contacts/GetContact" RPC
      Then I will receive a successful response
-      And the response value "displayName" will be "Default"
+      And the response value "displayName" will be "Alice Smith"
+      And the response value "labels.role" will be "hygienist"
     Given a JSON "chacerapp.v1.UpdateContactRequest"
       """
         {
           "contact": {
-            "name": "accounts/default-account/locations/default",
-            "displayName": "Default #2"
+            "name": "accounts/default/contacts/alice-smith",
+            "displayName": "Alice Jones"
+          },
+          "updateMask": {
+            "paths": [
+              "displayName"
+            ]
           }
         }
       """
-     When calling the "chacerapp.v1.Locations/UpdateLocation" RPC
+     When calling the "chacerapp.v1.Contacts/UpdateContact" RPC
      Then I will receive a successful response
-      And the response value "displayName" will be "Default #2"
+      And the response value "displayName" will be "Alice Jones"
+      And the response value "labels.role" will be "hygienist"
     Given a JSON "chacerapp.v1.DeleteContactRequest"
       """
-        { "name": "accounts/default-account/locations/default" }
+        { "name": "accounts/default/contacts/alice-smith" }
       """
-     When calling the "chacerapp.v1.Locations/DeleteLocation" RPC
+     When calling the "chacerapp.v1.Contacts/DeleteContact" RPC
      Then I will receive a successful response
     Given a JSON "chacerapp.v1.GetContactRequest"
       """
-        { "name": "accounts/default-account/locations/default" }
+        { "name": "accounts/default/contacts/alice-smith" }
       """
-     When calling the "chacerapp.v1.Locations/GetLocation" RPC
+     When calling the "chacerapp.v1.Contacts/GetContact" RPC
      Then I will receive an error with code "NOT_FOUND"
 
-  Scenario: Verify that a partial update with a field mask will work correctly
-    Given a JSON "chacerapp.v1.CreateContactRequest"
-      """
-        {
-          "parent": "accounts/default-account",
-          "contact": {
-            "displayName": "Default"
-          }
-        }
-      """
-     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
-     Then I will receive a successful response
-    Given a JSON "chacerapp.v1.UpdateContactRequest"
+  Scenario: Contacts are named within their account so the same ID can be used in each account
+    Given these resources are created:
       """
         {
-          "contact": {
-            "name": "accounts/default-account/locations/default",
-            "displayName": "Default #2"
-          },
-          "updateMask": {
-            "paths": [
-              "displayName"
-            ]
-          }
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/default",
+              "contact": { "displayName": "Alice Smith" },
+              "contactId": "alice-smith"
+            }
+          ]
         }
       """
-     When calling the "chacerapp.v1.Locations/UpdateLocation" RPC
-     Then I will receive a successful response
-      And the response value "displayName" will be "Default #2"
-
-  Scenario: Able to correctly list out the locations
-    # Create several devices across the two accounts
     Given a JSON "chacerapp.v1.CreateContactRequest"
       """
         {
-          "parent": "accounts/default-account",
-          "contact": {
-            "displayName": "Default"
-          }
+          "parent": "accounts/secondary",
+          "contact": { "displayName": "Alice Smith" },
+          "contactId": "alice-smith"
         }
       """
-     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     When calling the "chacerapp.v1.Contacts/CreateContact" RPC
      Then I will receive a successful response
-    Given a JSON "chacerapp.v1.CreateContactRequest"
+      And the response value "name" will be "accounts/secondary/contacts/alice-smith"
+    Given a JSON "chacerapp.v1.GetContactRequest"
       """
-        {
-          "parent": "accounts/default-account",
-          "contact": {
-            "displayName": "Secondary"
-          }
-        }
+        { "name": "accounts/secondary/contacts/alice-smith" }
       """
-     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     When calling the "chacerapp.v1.Contacts/GetContact" RPC
      Then I will receive a successful response
-    Given a JSON "chacerapp.v1.CreateContactRequest"
+      And the response value "name" will be "accounts/secondary/contacts/alice-smith"
+
+  Scenario: The presence of a contact is included when getting and listing contacts
+    Given these resources are created:
       """
         {
-          "parent": "accounts/secondary-account",
-          "contact": {
-            "displayName": "Default"
-          }
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/default",
+              "contact": { "displayName": "Alice Smith" },
+              "contactId": "alice-smith"
+            },
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/default",
+              "contact": { "displayName": "Bobby Jones" },
+              "contactId": "bobby-jones"
+            },
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/secondary",
+              "contact": { "displayName": "Carol White" },
+              "contactId": "carol-white"
+            }
+          ]
         }
       """
-     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
-     Then I will receive a successful response
-    Given a JSON "chacerapp.v1.CreateContactRequest"
+    Given using the client certificate for "accounts/default/contacts/alice-smith"
+      And these resources are created:
       """
         {
-          "parent": "accounts/secondary-account",
-          "contact": {
-            "displayName": "Secondary"
-          }
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CheckInRequest",
+              "name": "accounts/default/contacts/alice-smith",
+              "location": "accounts/default/locations/default"
+            }
+          ]
         }
       """
-     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
-     Then I will receive a successful response
-    Given a JSON "chacerapp.v1.ListLocationsRequest"
-      """
-        { "parent": "accounts/default-account", "pageSize": 3 }
-      """
-     When calling the "chacerapp.v1.Locations/ListLocations" RPC
-     Then I will receive a successful response
-      And the response value "locations" will have a length of 2
-      And the response value "locations[0].name" will be "accounts/default-account/locations/default"
-      And the response value "nextPageToken" will be ""
-    Given a JSON "chacerapp.v1.ListLocationsRequest"
-      """
-        { "parent": "accounts/-", "pageSize": 5 }
-      """
-     When calling the "chacerapp.v1.Locations/ListLocations" RPC
-     Then I will receive a successful response
-      And the response value "locations" will have a length of 4
-      And the response value "locations[0].name" will be "accounts/default-account/locations/default"
-      And the response value "nextPageToken" will be ""
-    Given a JSON "chacerapp.v1.ListLocationsRequest"
-      """
-        { "parent": "accounts/-", "pageSize": 3 }
-      """
-     When calling the "chacerapp.v1.Locations/ListLocations" RPC
-     Then I will receive a successful response
-      And the response value "locations" will have a length of 3
-      And stashing the next page token from the response
-    Given a JSON "chacerapp.v1.ListLocationsRequest"
+    Given a JSON "chacerapp.v1.GetContactRequest"
       """
-        { "parent": "accounts/-", "pageSize": 3 }
+        { "name": "accounts/default/contacts/alice-smith" }
       """
-      And using the stashed next page token
-     When calling the "chacerapp.v1.Locations/ListLocations" RPC
+     When calling the "chacerapp.v1.Contacts/GetContact" RPC
      Then I will receive a successful response
-      And the response value "nextPageToken" will be ""
-      And the response value "locations" will have a length of 1
-    # Verify that when the URL is changed, the previous next page token cannot be used
-    Given a JSON "chacerapp.v1.ListLocationsRequest"
+      And the response value "presence.state" will be "PRESENCE_STATE_CHECKED_IN"
+      And the response value "presence.location" will be "accounts/default/locations/default"
+    Given a JSON "chacerapp.v1.ListContactsRequest"
       """
-        { "parent": "accounts/-", "pageSize": 3 }
+        { "parent": "accounts/default" }
       """
-     When calling the "chacerapp.v1.Locations/ListLocations" RPC
+     When calling the "chacerapp.v1.Contacts/ListContacts" RPC
      Then I will receive a successful response
-      And stashing the next page token from the response
-    Given a JSON "chacerapp.v1.ListLocationsRequest"
-      """
-        { "parent": "accounts/default", "pageSize": 3 }
-      """
-      And using the stashed next page token
-     When calling the "chacerapp.v1.Locations/ListLocations" RPC
-     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the response value "contacts" will have a length of 2
+      And the response value "contacts[0].name" will be "accounts/default/contacts/alice-smith"
+      And the response value "contacts[0].presence.state" will be "PRESENCE_STATE_CHECKED_IN"
+      And the response value "contacts[1].name" will be "accounts/default/contacts/bobby-jones"
 
   Scenario: Verify that all endpoints return a not found error when the account or contact do not exist
     Given a JSON "chacerapp.v1.GetContactRequest"
       """
-        { "name": "accounts/non-existant-account/locations/some-contact" }
+        { "name": "accounts/default/contacts/does-not-exist" }
       """
-     When calling the "chacerapp.v1.Locations/GetLocation" RPC
+     When calling the "chacerapp.v1.Contacts/GetContact" RPC
      Then I will receive an error with code "NOT_FOUND"
     Given a JSON "chacerapp.v1.CreateContactRequest"
       """
@@ -208,22 +188,35 @@ Feature: Manage devices on the API
           }
         }
       """
-     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     When calling the "chacerapp.v1.Contacts/CreateContact" RPC
      Then I will receive an error with code "NOT_FOUND"
     Given a JSON "chacerapp.v1.UpdateContactRequest"
       """
         {
           "contact": {
-            "name": "accounts/non-existant-account/locations/does-not-exist",
+            "name": "accounts/default/contacts/does-not-exist",
             "displayName": "Default"
           }
         }
       """
-     When calling the "chacerapp.v1.Locations/UpdateLocation" RPC
+     When calling the "chacerapp.v1.Contacts/UpdateContact" RPC
      Then I will receive an error with code "NOT_FOUND"
     Given a JSON "chacerapp.v1.DeleteContactRequest"
       """
-        { "name": "accounts/non-existant-account/locations/does-not-exist" }
+        { "name": "accounts/default/contacts/does-not-exist" }
+      """
+     When calling the "chacerapp.v1.Contacts/DeleteContact" RPC
+     Then I will receive an error with code "NOT_FOUND"
+    Given using the client certificate for "accounts/default/contacts/does-not-exist"
+    Given a JSON "chacerapp.v1.CheckInRequest"
+      """
+        { "name": "accounts/default/contacts/does-not-exist", "location": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Contacts/CheckIn" RPC
+     Then I will receive an error with code "NOT_FOUND"
+    Given a JSON "chacerapp.v1.CheckOutRequest"
+      """
+        { "name": "accounts/default/contacts/does-not-exist" }
       """
-     When calling the "chacerapp.v1.Locations/DeleteLocation" RPC
+     When calling the "chacerapp.v1.Contacts/CheckOut" RPC
      Then I will receive an error with code "NOT_FOUND"
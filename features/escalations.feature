@@ -0,0 +1,173 @@
+Feature: Escalation policies
+  In order to get urgent pages answered
+  As a member of the front desk
+  I need locations to describe how unanswered messages are escalated
+
+  Background:
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Testing Account" },
+              "account_id": "my-testing-account"
+            },
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/my-testing-account",
+              "contact": { "displayName": "Hygienist" },
+              "contactId": "hygienist"
+            },
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/my-testing-account",
+              "contact": { "displayName": "Backup Hygienist" },
+              "contactId": "backup-hygienist"
+            },
+            {
+              "@type": "chacerapp.v1.CreateLocationRequest",
+              "parent": "accounts/my-testing-account",
+              "location_id": "main-office",
+              "location": {
+                "displayName": "Main Office",
+                "escalationPolicy": {
+                  "steps": [
+                    { "delay": "60s", "action": "ESCALATION_ACTION_RESEND" },
+                    { "delay": "120s", "action": "ESCALATION_ACTION_CHANGE_SOUND", "sound": "sounds/siren" },
+                    { "delay": "300s", "action": "ESCALATION_ACTION_REDIRECT", "contact": "accounts/my-testing-account/contacts/backup-hygienist" }
+                  ]
+                }
+              }
+            }
+          ]
+        }
+      """
+
+  Scenario: The escalation policy of a location is returned with it
+    Given a JSON "chacerapp.v1.GetLocationRequest"
+      """
+        { "name": "accounts/my-testing-account/locations/main-office" }
+      """
+     When calling the "chacerapp.v1.Locations/GetLocation" RPC
+     Then I will receive a successful response
+      And the response value "escalationPolicy.steps" will have a length of 3
+      And the response value "escalationPolicy.steps[1].sound" will be "sounds/siren"
+      And the response value "escalationPolicy.steps[2].contact" will be "accounts/my-testing-account/contacts/backup-hygienist"
+
+  Scenario: Escalation steps must be in order and have what their action needs
+    Given a JSON "chacerapp.v1.UpdateLocationRequest"
+      """
+        {
+          "location": {
+            "name": "accounts/my-testing-account/locations/main-office",
+            "escalationPolicy": {
+              "steps": [
+                { "delay": "120s", "action": "ESCALATION_ACTION_RESEND" },
+                { "delay": "60s", "action": "ESCALATION_ACTION_BROADCAST" },
+                { "delay": "300s", "action": "ESCALATION_ACTION_REDIRECT" }
+              ]
+            }
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/UpdateLocation" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | location.escalation_policy.steps[1].delay   | delay must be longer than the delay of the previous step |
+        | location.escalation_policy.steps[2].contact | contact is required to redirect a message               |
+
+  Scenario: A message that isn't acknowledged is escalated step by step
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/main-office",
+          "message": { "reason": "Patient ready", "recipient": "accounts/my-testing-account/contacts/hygienist" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "nextEscalationTime" will match "^\d{4}-\d{2}-\d{2}T"
+      And stashing the name from the response
+    Given the messages due to escalate within "90s" are escalated
+      And a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/main-office" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].escalations" will have a length of 1
+      And the response value "messages[0].escalations[0].action" will be "ESCALATION_ACTION_RESEND"
+    Given the messages due to escalate within "10m" are escalated
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].escalations" will have a length of 3
+      And the response value "messages[0].escalations[1].sound" will be "sounds/siren"
+      And the response value "messages[0].displayConfig.sound" will be "sounds/siren"
+      And the response value "messages[0].escalations[2].contact" will be "accounts/my-testing-account/contacts/backup-hygienist"
+      And the response value "messages[0].deliveries[0].recipient" will be "accounts/my-testing-account/contacts/backup-hygienist"
+      And the response value "messages[0].nextEscalationTime" will be ""
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/my-testing-account/contacts/backup-hygienist", "response": "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "ACKNOWLEDGED"
+
+  Scenario: Escalation stops once a message is acknowledged
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/main-office",
+          "message": { "reason": "Patient ready", "recipient": "accounts/my-testing-account/contacts/hygienist" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/my-testing-account/contacts/hygienist", "response": "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "nextEscalationTime" will be ""
+    Given the messages due to escalate within "10m" are escalated
+      And a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/main-office" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].escalations" will have a length of 0
+
+  Scenario: Escalation stops once a message is cancelled
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/main-office",
+          "message": { "reason": "Patient ready" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.CancelMessageRequest"
+      """
+        {}
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/CancelMessage" RPC
+     Then I will receive a successful response
+    Given the messages due to escalate within "10m" are escalated
+      And a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/main-office" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].state" will be "CANCELLED"
+      And the response value "messages[0].escalations" will have a length of 0
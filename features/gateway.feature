@@ -0,0 +1,39 @@
+Feature: HTTP/JSON gateway
+  In order to call the API from a web browser
+  As a user of the system
+  I need to be able to call the API with RESTful JSON requests
+
+  Scenario: Create and get an account over HTTP
+    When sending a "POST" HTTP request to "/v1/accounts?account_id=my-http-account" with the body
+      """
+        { "displayName": "My HTTP Account" }
+      """
+    Then I will receive an HTTP status code of 200
+     And the HTTP response value "name" will be "accounts/my-http-account"
+     And the HTTP response value "displayName" will be "My HTTP Account"
+    When sending a "GET" HTTP request to "/v1/accounts/my-http-account"
+    Then I will receive an HTTP status code of 200
+     And the HTTP response value "displayName" will be "My HTTP Account"
+    When sending a "POST" HTTP request to "/v1/accounts?account_id=my-http-account" with the body
+      """
+        { "displayName": "My HTTP Account" }
+      """
+    Then I will receive an HTTP status code of 409
+     And the HTTP response value "error.status" will be "ALREADY_EXISTS"
+
+  Scenario: Getting an account that does not exist over HTTP
+    When sending a "GET" HTTP request to "/v1/accounts/missing-account"
+    Then I will receive an HTTP status code of 404
+     And the HTTP response value "error.code" will be "404"
+     And the HTTP response value "error.status" will be "NOT_FOUND"
+
+  Scenario: Validation errors are rendered in the HTTP error body
+    When sending a "POST" HTTP request to "/v1/accounts?account_id=invalid!" with the body
+      """
+        { "displayName": "My HTTP Account" }
+      """
+    Then I will receive an HTTP status code of 400
+     And the HTTP response value "error.status" will be "INVALID_ARGUMENT"
+     And the HTTP response value "error.details[0].@type" will be "type.googleapis.com/google.rpc.BadRequest"
+     And the HTTP response value "error.details[0].fieldViolations[0].field" will be "account_id"
+     And the HTTP response value "error.details[0].fieldViolations[0].description" will be "invalid account ID"
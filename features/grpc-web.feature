@@ -0,0 +1,39 @@
+Feature: gRPC-Web support
+  In order to call the API from the browser based console
+  As a user of the system
+  I need to be able to call the API with gRPC-Web requests
+
+  Scenario: Create an account over gRPC-Web
+    Given a JSON "chacerapp.v1.CreateAccountRequest"
+      """
+        {
+          "account": { "displayName": "My gRPC-Web Account" },
+          "account_id": "my-grpc-web-account"
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/CreateAccount" RPC over gRPC-Web
+     Then I will receive a successful response
+      And the response value "name" will be "accounts/my-grpc-web-account"
+      And the response value "displayName" will be "My gRPC-Web Account"
+     When calling the "chacerapp.v1.Accounts/CreateAccount" RPC over gRPC-Web
+     Then I will receive an error with code "ALREADY_EXISTS"
+
+  Scenario: Validation errors are returned over gRPC-Web
+    Given a JSON "chacerapp.v1.CreateAccountRequest"
+      """
+        {
+          "account": { "displayName": "My gRPC-Web Account" },
+          "account_id": "invalid!"
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/CreateAccount" RPC over gRPC-Web
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | account_id | invalid account ID |
+
+  Scenario: CORS preflight requests are only accepted from allowed origins
+     When sending a CORS preflight request for "chacerapp.v1.Rooms/ListRooms" from the origin "https://console.chacerapp.test"
+     Then I will receive an HTTP status code of 200
+      And the HTTP response header "Access-Control-Allow-Origin" will be "https://console.chacerapp.test"
+     When sending a CORS preflight request for "chacerapp.v1.Rooms/ListRooms" from the origin "https://example.com"
+     Then the HTTP response header "Access-Control-Allow-Origin" will be ""
@@ -0,0 +1,52 @@
+Feature: Health checking
+  In order to route traffic to healthy servers
+  As an operator of the system
+  I need to be able to check the serving status of the API services
+
+  Scenario: The server and its services report they are serving
+    Given a JSON "grpc.health.v1.HealthCheckRequest"
+      """
+        {}
+      """
+     When calling the "grpc.health.v1.Health/Check" RPC
+     Then I will receive a successful response
+      And the response value "status" will be "SERVING"
+    Given a JSON "grpc.health.v1.HealthCheckRequest"
+      """
+        { "service": "chacerapp.v1.Rooms" }
+      """
+     When calling the "grpc.health.v1.Health/Check" RPC
+     Then I will receive a successful response
+      And the response value "status" will be "SERVING"
+
+  Scenario: Checking an unknown service
+    Given a JSON "grpc.health.v1.HealthCheckRequest"
+      """
+        { "service": "chacerapp.v1.Unknown" }
+      """
+     When calling the "grpc.health.v1.Health/Check" RPC
+     Then I will receive an error with code "NOT_FOUND"
+
+  Scenario: A service reports it isn't serving when its own dependency fails
+    Given a dependency of the "chacerapp.v1.Messenger" service is failing
+      And a JSON "grpc.health.v1.HealthCheckRequest"
+      """
+        { "service": "chacerapp.v1.Messenger" }
+      """
+     When calling the "grpc.health.v1.Health/Check" RPC
+     Then I will receive a successful response
+      And the response value "status" will be "NOT_SERVING"
+    Given a JSON "grpc.health.v1.HealthCheckRequest"
+      """
+        { "service": "chacerapp.v1.Rooms" }
+      """
+     When calling the "grpc.health.v1.Health/Check" RPC
+     Then I will receive a successful response
+      And the response value "status" will be "SERVING"
+    Given a JSON "grpc.health.v1.HealthCheckRequest"
+      """
+        {}
+      """
+     When calling the "grpc.health.v1.Health/Check" RPC
+     Then I will receive a successful response
+      And the response value "status" will be "SERVING"
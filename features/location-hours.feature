@@ -0,0 +1,110 @@
+Feature: Location business hours
+  In order to know when an office can be reached
+  As a user of the system
+  I need locations to keep business hours and holidays in their own time zone
+
+  Background:
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Testing Account" },
+              "account_id": "my-testing-account"
+            },
+            {
+              "@type": "chacerapp.v1.CreateLocationRequest",
+              "parent": "accounts/my-testing-account",
+              "location_id": "new-york",
+              "location": {
+                "displayName": "New York",
+                "timeZone": "America/New_York",
+                "businessHours": [
+                  { "day": "MONDAY", "openTime": { "hours": 9 }, "closeTime": { "hours": 17 } },
+                  { "day": "TUESDAY", "openTime": { "hours": 9 }, "closeTime": { "hours": 17 } },
+                  { "day": "FRIDAY", "openTime": { "hours": 9 }, "closeTime": { "hours": 12 } },
+                  { "day": "FRIDAY", "openTime": { "hours": 13 }, "closeTime": { "hours": 17 } }
+                ],
+                "holidays": [
+                  { "date": { "year": 2026, "month": 12, "day": 25 }, "displayName": "Christmas Day" }
+                ]
+              }
+            }
+          ]
+        }
+      """
+
+  Scenario: The business hours of a location are returned with it
+    Given a JSON "chacerapp.v1.GetLocationRequest"
+      """
+        { "name": "accounts/my-testing-account/locations/new-york" }
+      """
+     When calling the "chacerapp.v1.Locations/GetLocation" RPC
+     Then I will receive a successful response
+      And the response value "timeZone" will be "America/New_York"
+      And the response value "businessHours" will have a length of 4
+      And the response value "businessHours[0].day" will be "MONDAY"
+      And the response value "holidays[0].displayName" will be "Christmas Day"
+
+  Scenario: A location is open during its business hours in its time zone
+    Given a JSON "chacerapp.v1.GetLocationOpenStateRequest"
+      """
+        {
+          "name": "accounts/my-testing-account/locations/new-york",
+          "check_time": "2026-10-19T14:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.Locations/GetLocationOpenState" RPC
+     Then I will receive a successful response
+      And the response value "open" will be "true"
+      And the response value "nextCloseTime" will be "2026-10-19T21:00:00Z"
+
+  Scenario: A closed location reports when it next opens
+    Given a JSON "chacerapp.v1.GetLocationOpenStateRequest"
+      """
+        {
+          "name": "accounts/my-testing-account/locations/new-york",
+          "check_time": "2026-10-20T22:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.Locations/GetLocationOpenState" RPC
+     Then I will receive a successful response
+      And the response value "open" will be "false"
+      And the response value "nextOpenTime" will be "2026-10-23T13:00:00Z"
+
+  Scenario: A location is closed on its holidays
+    Given a JSON "chacerapp.v1.GetLocationOpenStateRequest"
+      """
+        {
+          "name": "accounts/my-testing-account/locations/new-york",
+          "check_time": "2026-12-25T15:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.Locations/GetLocationOpenState" RPC
+     Then I will receive a successful response
+      And the response value "open" will be "false"
+      And the response value "holiday" will be "Christmas Day"
+      And the response value "nextOpenTime" will be "2026-12-28T14:00:00Z"
+
+  Scenario: The time zone and business hours of a location must be valid
+    Given a JSON "chacerapp.v1.UpdateLocationRequest"
+      """
+        {
+          "location": {
+            "name": "accounts/my-testing-account/locations/new-york",
+            "timeZone": "Mars/Olympus_Mons",
+            "businessHours": [
+              { "day": "MONDAY", "openTime": { "hours": 9 }, "closeTime": { "hours": 17 } },
+              { "day": "MONDAY", "openTime": { "hours": 16 }, "closeTime": { "hours": 20 } },
+              { "day": "TUESDAY", "openTime": { "hours": 17 }, "closeTime": { "hours": 9 } }
+            ]
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/UpdateLocation" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | location.time_zone                    | time_zone must be an IANA time zone, e.g. America/New_York |
+        | location.business_hours[1]            | business hours must not overlap on the same day            |
+        | location.business_hours[2].close_time | close_time must be after open_time                         |
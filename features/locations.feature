@@ -23,6 +23,7 @@ Feature: Manage devices on the API
       """
         {
           "parent": "accounts/default-account",
+          "location_id": "default",
           "location": {
             "displayName": "Default",
             "description": "This is my default location"
@@ -75,6 +76,7 @@ Feature: Manage devices on the API
       """
         {
           "parent": "accounts/default-account",
+          "location_id": "default",
           "location": {
             "displayName": "Default",
             "description": "This is my default location"
@@ -108,6 +110,7 @@ Feature: Manage devices on the API
       """
         {
           "parent": "accounts/default-account",
+          "location_id": "default",
           "location": {
             "displayName": "Default",
             "description": "This is my default location"
@@ -120,6 +123,7 @@ Feature: Manage devices on the API
       """
         {
           "parent": "accounts/default-account",
+          "location_id": "secondary",
           "location": {
             "displayName": "Secondary",
             "description": "This is my secondary location"
@@ -132,6 +136,7 @@ Feature: Manage devices on the API
       """
         {
           "parent": "accounts/secondary-account",
+          "location_id": "default",
           "location": {
             "displayName": "Default",
             "description": "This is my default location"
@@ -144,6 +149,7 @@ Feature: Manage devices on the API
       """
         {
           "parent": "accounts/secondary-account",
+          "location_id": "secondary",
           "location": {
             "displayName": "Secondary",
             "description": "This is my secondary location"
@@ -239,3 +245,42 @@ Feature: Manage devices on the API
       """
      When calling the "chacerapp.v1.Locations/DeleteLocation" RPC
      Then I will receive an error with code "NOT_FOUND"
+
+  Scenario: The location ID must be valid when it is provided
+    Given a JSON "chacerapp.v1.CreateLocationRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account",
+          "location_id": "HQ",
+          "location": { "displayName": "Headquarters" }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | location_id | invalid location ID |
+
+  Scenario: A location ID is generated from the display name when it isn't provided
+    Given a JSON "chacerapp.v1.CreateLocationRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account",
+          "location": { "displayName": "Main Office" }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     Then I will receive a successful response
+      And the response value "name" will match "^accounts/my-testing-account/locations/main-office-[a-z0-9]{6}$"
+     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     Then I will receive a successful response
+      And the response value "name" will match "^accounts/my-testing-account/locations/main-office-[a-z0-9]{6}$"
+    Given a JSON "chacerapp.v1.CreateLocationRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account",
+          "location": { "displayName": "本社" }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     Then I will receive a successful response
+      And the response value "name" will match "^accounts/my-testing-account/locations/location-[a-z0-9]{6}$"
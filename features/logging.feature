@@ -0,0 +1,67 @@
+Feature: Request logging
+  In order to investigate problems with requests
+  As an operator of the system
+  I need every request to be logged with an ID that is returned to the caller
+
+  Scenario: A request ID is generated and logged with the request
+    Given a JSON "chacerapp.v1.GetAccountRequest"
+      """
+        { "name": "accounts/missing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive an error with code "NOT_FOUND"
+      And the response header "x-request-id" will be set
+      And the request log will contain an entry with
+        | method   | /chacerapp.v1.Accounts/GetAccount |
+        | resource | accounts/missing-account          |
+        | code     | NotFound                          |
+        | level    | info                              |
+
+  Scenario: The request ID provided by the caller is used
+    Given using the request ID "my-request-1234"
+      And a JSON "chacerapp.v1.GetAccountRequest"
+      """
+        { "name": "accounts/missing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive an error with code "NOT_FOUND"
+      And the response header "x-request-id" will be "my-request-1234"
+      And the request log will contain an entry with
+        | request_id | my-request-1234 |
+        | code       | NotFound        |
+
+  Scenario: An invalid request ID is replaced
+    Given using the request ID "not a valid ID"
+      And a JSON "chacerapp.v1.GetAccountRequest"
+      """
+        { "name": "accounts/missing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive an error with code "NOT_FOUND"
+      And the response header "x-request-id" will be set
+
+  Scenario: A panic in a handler is returned as an internal error
+    Given a JSON "chacerapp.v1.UpdateAccountStatusRequest"
+      """
+        {}
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountStatus" RPC
+     Then I will receive an error with code "INTERNAL"
+      And the request log will contain an entry with
+        | msg    | recovered from panic                       |
+        | method | /chacerapp.v1.Accounts/UpdateAccountStatus |
+      And the request log will contain an entry with
+        | msg   | request completed |
+        | code  | Internal          |
+        | level | error             |
+    Given a JSON "grpc.health.v1.HealthCheckRequest"
+      """
+        {}
+      """
+     When calling the "grpc.health.v1.Health/Check" RPC
+     Then I will receive a successful response
+
+  Scenario: The request ID is returned by the HTTP gateway
+    When sending a "GET" HTTP request to "/v1/accounts/missing-account"
+    Then I will receive an HTTP status code of 404
+     And the HTTP response header "X-Request-Id" will be set
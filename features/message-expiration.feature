@@ -0,0 +1,153 @@
+Feature: Message expiration
+  In order to keep our displays clear of stale pages
+  As a manager of a location
+  I need messages that are never completed to expire
+
+  Background:
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Testing Account" },
+              "account_id": "my-testing-account"
+            },
+            {
+              "@type": "chacerapp.v1.CreateLocationRequest",
+              "parent": "accounts/my-testing-account",
+              "location_id": "main-office",
+              "location": { "displayName": "Main Office", "messageTtl": "3600s" }
+            }
+          ]
+        }
+      """
+
+  Scenario: The message TTL of a location is returned with it
+    Given a JSON "chacerapp.v1.GetLocationRequest"
+      """
+        { "name": "accounts/my-testing-account/locations/main-office" }
+      """
+     When calling the "chacerapp.v1.Locations/GetLocation" RPC
+     Then I will receive a successful response
+      And the response value "messageTtl" will be "3600s"
+
+  Scenario: The message TTL of a location must be between a minute and a week
+    Given a JSON "chacerapp.v1.UpdateLocationRequest"
+      """
+        {
+          "location": { "name": "accounts/my-testing-account/locations/main-office", "messageTtl": "30s" },
+          "update_mask": "message_ttl"
+        }
+      """
+     When calling the "chacerapp.v1.Locations/UpdateLocation" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | location.message_ttl | must be a whole number of seconds between 1 minute and 7 days |
+
+  Scenario: A message must expire after it is sent
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/main-office",
+          "message": { "reason": "Lunch order", "expireTime": "2099-01-05T11:00:00Z" },
+          "sendTime": "2099-01-05T12:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | message.expire_time | expire_time must be after the message is sent |
+
+  Scenario: A recurring message can only expire after a TTL
+    Given a JSON "chacerapp.v1.CreateScheduledMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/main-office",
+          "scheduled_message": {
+            "message": { "reason": "Morning huddle", "expireTime": "2099-01-05T09:00:00Z" },
+            "startTime": "2099-01-05T08:30:00Z",
+            "recurrence": "FREQ=DAILY"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/CreateScheduledMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | scheduled_message.message.expire_time | expire_time can not be used with a recurrence, use ttl instead |
+
+  Scenario: A sent message expires after the message TTL of its location
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/main-office",
+          "message": { "reason": "Lunch order" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "expireTime" will match "^\d{4}-\d{2}-\d{2}T"
+    Given the messages that expire within "30m" are expired
+      And a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/main-office" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].state" will be "ACTIVE"
+    Given the messages that expire within "2h" are expired
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].state" will be "EXPIRED"
+      And the response value "messages[0].stateChanges" will have a length of 2
+      And the response value "messages[0].stateChanges[1].state" will be "EXPIRED"
+      And the response value "messages[0].stateChanges[1].actor" will be ""
+
+  Scenario: A completed message doesn't expire
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/main-office",
+          "message": { "reason": "Lunch order", "ttl": "120s" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.CompleteMessageRequest"
+      """
+        {}
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/CompleteMessage" RPC
+     Then I will receive a successful response
+    Given the messages that expire within "5m" are expired
+      And a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/main-office" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].state" will be "COMPLETED"
+
+  Scenario: Expired messages are removed from the devices watching the location
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/main-office",
+          "message": { "reason": "Lunch order", "ttl": "120s" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.WatchMessagesRequest"
+      """
+        { "parent": "accounts/my-testing-account/locations/main-office" }
+      """
+     When watching the "chacerapp.v1.Messenger/WatchMessages" RPC
+      And receiving the next streamed response
+     Then the response value "messages" will have a length of 1
+    Given the messages that expire within "5m" are expired
+     When receiving the next streamed response
+     Then the response value "messages" will have a length of 1
+      And the response value "messages[0].state" will be "EXPIRED"
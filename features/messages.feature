@@ -0,0 +1,327 @@
+Feature: Messages
+  In order to know whether a page was seen
+  As a member of the front desk
+  I need recipients to acknowledge or decline the messages sent to them
+
+  Scenario: A response must say how the responder answered
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "name": "accounts/default/locations/default/messages/page-1", "responder": "accounts/default/contacts/hygienist" }
+      """
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | response | response is required |
+
+  Scenario: Only an acknowledgement can have an ETA
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        {
+          "name": "accounts/default/locations/default/messages/page-1",
+          "responder": "accounts/default/contacts/hygienist",
+          "response": "MESSAGE_RESPONSE_STATE_DECLINED",
+          "eta": "300s"
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | eta | eta can only be set when acknowledging a message |
+
+  Scenario: The priority of a message decides how it is displayed
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient ready", "priority": "MESSAGE_PRIORITY_URGENT" },
+          "sendTime": "2099-01-05T12:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "priority" will be "MESSAGE_PRIORITY_URGENT"
+      And the response value "displayConfig.sound" will be "sounds/urgent"
+
+  Scenario: A message must have a known priority
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient ready", "priority": 9 }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | message.priority | |
+
+  Scenario: Sending an emergency message requires a permission
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Fire", "priority": "MESSAGE_PRIORITY_EMERGENCY" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive an error with code "PERMISSION_DENIED"
+
+  Scenario: Messages are listed with the highest priority first
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.SendMessageRequest",
+              "parent": "accounts/default/locations/default",
+              "message": { "reason": "Lunch order", "priority": "MESSAGE_PRIORITY_ROUTINE" }
+            },
+            {
+              "@type": "chacerapp.v1.SendMessageRequest",
+              "parent": "accounts/default/locations/default",
+              "message": { "reason": "Patient ready" }
+            },
+            {
+              "@type": "chacerapp.v1.SendMessageRequest",
+              "parent": "accounts/default/locations/default",
+              "message": { "reason": "Patient bleeding", "priority": "MESSAGE_PRIORITY_URGENT" }
+            }
+          ]
+        }
+      """
+      And a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages" will have a length of 3
+      And the response value "messages[0].reason" will be "Patient bleeding"
+      And the response value "messages[1].reason" will be "Patient ready"
+      And the response value "messages[1].priority" will be "MESSAGE_PRIORITY_NORMAL"
+      And the response value "messages[2].reason" will be "Lunch order"
+
+  Scenario: Only urgent messages are delivered to contacts that don't want to be disturbed
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateContactRequest",
+              "parent": "accounts/default",
+              "contact": { "displayName": "Hygienist", "doNotDisturb": true },
+              "contactId": "hygienist"
+            }
+          ]
+        }
+      """
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Lunch order", "recipient": "accounts/default/contacts/hygienist" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "deliveries[0].state" will be "MESSAGE_DELIVERY_STATE_HELD"
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient bleeding", "recipient": "accounts/default/contacts/hygienist", "priority": "MESSAGE_PRIORITY_URGENT" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "deliveries[0].state" will be "MESSAGE_DELIVERY_STATE_PENDING"
+
+  Scenario: Sent messages are stored until they are completed
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And data loaded from the seed file "seed-data/contacts-background.json"
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient ready", "recipient": "accounts/default/contacts/hygienist" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "name" will match "^accounts/default/locations/default/messages/patient-ready-[a-z0-9]+$"
+      And the response value "location" will be "accounts/default/locations/default"
+      And the response value "state" will be "ACTIVE"
+      And the response value "stateChanges" will have a length of 1
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/default/locations/-" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages" will have a length of 1
+      And the response value "messages[0].state" will be "ACTIVE"
+    Given a JSON "chacerapp.v1.CompleteMessageRequest"
+      """
+        {}
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/CompleteMessage" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages[0].state" will be "COMPLETED"
+      And the response value "messages[0].stateChanges" will have a length of 2
+      And the response value "messages[0].stateChanges[1].previousState" will be "ACTIVE"
+
+  Scenario: Each responder has a single response
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And data loaded from the seed file "seed-data/contacts-background.json"
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient ready", "recipient": "accounts/default/contacts/hygienist" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        {
+          "responder": "accounts/default/contacts/hygienist",
+          "response": "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED",
+          "eta": "300s"
+        }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "ACKNOWLEDGED"
+      And the response value "responses" will have a length of 1
+      And the response value "responses[0].eta" will be "300s"
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/hygienist", "response": "MESSAGE_RESPONSE_STATE_DECLINED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "DECLINED"
+      And the response value "responses" will have a length of 1
+      And the response value "responses[0].state" will be "MESSAGE_RESPONSE_STATE_DECLINED"
+      And the response value "stateChanges" will have a length of 3
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/dentist", "response": "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | responder | responder is not a recipient of the message |
+
+  Scenario: A message can only be sent to a contact of its account
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient ready", "recipient": "accounts/default/contacts/does-not-exist" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | message.recipient | contact does not exist |
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient ready", "recipient": "accounts/secondary/contacts/hygienist" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | message.recipient | contact must be in the account of the message |
+
+  Scenario: Completed and cancelled messages can't be changed
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient ready" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.CancelMessageRequest"
+      """
+        {}
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/CancelMessage" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.CompleteMessageRequest"
+      """
+        {}
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/CompleteMessage" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.AcknowledgeMessageRequest"
+      """
+        { "responder": "accounts/default/contacts/hygienist", "response": "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED" }
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/AcknowledgeMessage" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.CompleteMessageRequest"
+      """
+        { "name": "accounts/default/locations/default/messages/does-not-exist" }
+      """
+     When calling the "chacerapp.v1.Messenger/CompleteMessage" RPC
+     Then I will receive an error with code "NOT_FOUND"
+
+  Scenario: Watching the messages of a location
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Patient ready" }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And stashing the name from the response
+    Given a JSON "chacerapp.v1.WatchMessagesRequest"
+      """
+        { "parent": "accounts/default/locations/default" }
+      """
+     When watching the "chacerapp.v1.Messenger/WatchMessages" RPC
+      And receiving the next streamed response
+     Then the response value "messages" will have a length of 1
+      And the response value "messages[0].state" will be "ACTIVE"
+    Given a JSON "chacerapp.v1.CancelMessageRequest"
+      """
+        {}
+      """
+      And using the stashed name as the "name"
+     When calling the "chacerapp.v1.Messenger/CancelMessage" RPC
+     Then I will receive a successful response
+     When receiving the next streamed response
+     Then the response value "messages" will have a length of 1
+      And the response value "messages[0].state" will be "CANCELLED"
@@ -0,0 +1,195 @@
+Feature: Account quotas
+  In order to keep the resources used by each account within its subscription
+  As an operator of the system
+  I need the quotas of an account to be enforced when resources are created
+
+  Background:
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Testing Account" },
+              "account_id": "my-testing-account"
+            },
+            {
+              "@type": "chacerapp.v1.CreateLocationRequest",
+              "parent": "accounts/my-testing-account",
+              "location_id": "default",
+              "location": { "displayName": "Default" }
+            }
+          ]
+        }
+      """
+
+  Scenario: New accounts use the default quotas of their tier
+    Given a JSON "chacerapp.v1.GetAccountQuotasRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccountQuotas" RPC
+     Then I will receive a successful response
+      And the response value "locations" will be "10"
+      And the response value "devices" will be "50"
+      And the response value "rooms" will be "100"
+      And the response value "usage.locations" will be "1"
+      And the response value "usage.rooms" will be "0"
+      And the response value "usage.devices" will be "0"
+
+  Scenario: Locations can't be created once the account has reached its quota
+    Given a JSON "chacerapp.v1.UpdateAccountQuotasRequest"
+      """
+        {
+          "accountQuotas": {
+            "name": "accounts/my-testing-account",
+            "locations": 1
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountQuotas" RPC
+     Then I will receive a successful response
+      And the response value "locations" will be "1"
+      And the response value "rooms" will be "100"
+    Given a JSON "chacerapp.v1.CreateLocationRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account",
+          "location": { "displayName": "Secondary" }
+        }
+      """
+     When calling the "chacerapp.v1.Locations/CreateLocation" RPC
+     Then I will receive an error with code "RESOURCE_EXHAUSTED"
+      And the QuotaFailure error details will be for the subject "accounts/my-testing-account"
+
+  Scenario: Rooms can't be created once the account has reached its quota
+    Given a JSON "chacerapp.v1.UpdateAccountQuotasRequest"
+      """
+        {
+          "accountQuotas": {
+            "name": "accounts/my-testing-account",
+            "rooms": 1
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountQuotas" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.CreateRoomRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/default",
+          "room": { "displayName": "Room 1" },
+          "room_id": "room-1"
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoom" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.CreateRoomRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/default",
+          "room": { "displayName": "Room 2" },
+          "room_id": "room-2"
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoom" RPC
+     Then I will receive an error with code "RESOURCE_EXHAUSTED"
+      And the QuotaFailure error details will be for the subject "accounts/my-testing-account"
+
+  Scenario: A quota of 0 doesn't allow any resources
+    Given a JSON "chacerapp.v1.UpdateAccountQuotasRequest"
+      """
+        {
+          "accountQuotas": {
+            "name": "accounts/my-testing-account",
+            "rooms": 0
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountQuotas" RPC
+     Then I will receive a successful response
+      And the response value "rooms" will be "0"
+      And the response value "locations" will be "10"
+    Given a JSON "chacerapp.v1.CreateRoomRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/default",
+          "room": { "displayName": "Room 1" },
+          "room_id": "room-1"
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoom" RPC
+     Then I will receive an error with code "RESOURCE_EXHAUSTED"
+      And the QuotaFailure error details will be for the subject "accounts/my-testing-account"
+
+  Scenario: Devices can't be registered once the account has reached its quota
+    Given a JSON "chacerapp.v1.UpdateAccountQuotasRequest"
+      """
+        {
+          "accountQuotas": {
+            "name": "accounts/my-testing-account",
+            "devices": 1
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountQuotas" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.RegisterDeviceRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/default",
+          "device": { "displayName": "Front desk" },
+          "device_id": "front-desk"
+        }
+      """
+     When calling the "chacerapp.v1.Locations/RegisterDevice" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.RegisterDeviceRequest"
+      """
+        {
+          "parent": "accounts/my-testing-account/locations/default",
+          "device": { "displayName": "Back office" },
+          "device_id": "back-office"
+        }
+      """
+     When calling the "chacerapp.v1.Locations/RegisterDevice" RPC
+     Then I will receive an error with code "RESOURCE_EXHAUSTED"
+      And the QuotaFailure error details will be for the subject "accounts/my-testing-account"
+    Given a JSON "chacerapp.v1.GetAccountQuotasRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccountQuotas" RPC
+     Then I will receive a successful response
+      And the response value "usage.devices" will be "1"
+
+  Scenario: Accounts in the unlimited tier have no quotas
+    Given a JSON "chacerapp.v1.UpdateAccountQuotasRequest"
+      """
+        {
+          "accountQuotas": {
+            "name": "accounts/my-testing-account",
+            "rateLimitTier": "RATE_LIMIT_TIER_UNLIMITED"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountQuotas" RPC
+     Then I will receive a successful response
+      And the response value "locations" will be ""
+      And the response value "devices" will be ""
+      And the response value "rooms" will be ""
+
+  Scenario: Negative quotas are rejected
+    Given a JSON "chacerapp.v1.UpdateAccountQuotasRequest"
+      """
+        {
+          "accountQuotas": {
+            "name": "accounts/my-testing-account",
+            "rooms": -1
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountQuotas" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | account_quotas.rooms | rooms must not be negative |
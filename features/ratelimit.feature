@@ -0,0 +1,93 @@
+Feature: Rate limiting
+  In order to keep the service available for every office
+  As an operator of the system
+  I need requests for an account to be limited to the rate set in its quotas
+
+  Background:
+    Given these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Testing Account" },
+              "account_id": "my-testing-account"
+            },
+            {
+              "@type": "chacerapp.v1.CreateAccountRequest",
+              "account": { "displayName": "My Second Testing Account" },
+              "account_id": "my-second-testing-account"
+            },
+            {
+              "@type": "chacerapp.v1.UpdateAccountQuotasRequest",
+              "accountQuotas": {
+                "name": "accounts/my-testing-account",
+                "rateLimits": [{
+                  "method": "chacerapp.v1.Accounts/GetAccount",
+                  "requestsPerSecond": 0.01,
+                  "burst": 1
+                }]
+              }
+            }
+          ]
+        }
+      """
+
+  Scenario: Requests over the limit of an account are rejected
+    Given a JSON "chacerapp.v1.GetAccountRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive a successful response
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive an error with code "RESOURCE_EXHAUSTED"
+      And the QuotaFailure error details will be for the subject "accounts/my-testing-account"
+      And the error details will include a retry delay
+
+  Scenario: The limits of an account don't apply to other accounts
+    Given a JSON "chacerapp.v1.GetAccountRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive a successful response
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive an error with code "RESOURCE_EXHAUSTED"
+    Given a JSON "chacerapp.v1.GetAccountRequest"
+      """
+        { "name": "accounts/my-second-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccount" RPC
+     Then I will receive a successful response
+
+  Scenario: The limits of an account are returned with its quotas
+    Given a JSON "chacerapp.v1.GetAccountQuotasRequest"
+      """
+        { "name": "accounts/my-testing-account" }
+      """
+     When calling the "chacerapp.v1.Accounts/GetAccountQuotas" RPC
+     Then I will receive a successful response
+      And the response value "rateLimits[0].method" will be "chacerapp.v1.Accounts/GetAccount"
+      And the response value "rateLimits[0].burst" will be "1"
+
+  Scenario: Invalid rate limits are rejected
+    Given a JSON "chacerapp.v1.UpdateAccountQuotasRequest"
+      """
+        {
+          "accountQuotas": {
+            "name": "accounts/my-testing-account",
+            "rateLimits": [{
+              "method": "chacerapp.v1.Accounts/DoesNotExist",
+              "requestsPerSecond": 0,
+              "burst": 0
+            }]
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Accounts/UpdateAccountQuotas" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | account_quotas.rate_limits[0].method              | method must be the full name of a method or * |
+        | account_quotas.rate_limits[0].requests_per_second | requests_per_second must be greater than 0    |
+        | account_quotas.rate_limits[0].burst               | burst must be at least 1                      |
@@ -0,0 +1,204 @@
+Feature: Room groups
+  In order to find rooms quickly on our boards
+  As a member of the front desk
+  I need the rooms of a location grouped and ordered the way they are laid out
+
+  Background:
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateRoomRequest",
+              "parent": "accounts/default/locations/default",
+              "room": { "displayName": "Exam 10" },
+              "room_id": "exam-10"
+            },
+            {
+              "@type": "chacerapp.v1.CreateRoomRequest",
+              "parent": "accounts/default/locations/default",
+              "room": { "displayName": "Exam 2" },
+              "room_id": "exam-2"
+            },
+            {
+              "@type": "chacerapp.v1.CreateRoomRequest",
+              "parent": "accounts/default/locations/default",
+              "room": { "displayName": "Operatory 1" },
+              "room_id": "operatory-1"
+            },
+            {
+              "@type": "chacerapp.v1.CreateRoomRequest",
+              "parent": "accounts/default/locations/default",
+              "room": { "displayName": "Operatory 2" },
+              "room_id": "operatory-2"
+            },
+            {
+              "@type": "chacerapp.v1.CreateRoomRequest",
+              "parent": "accounts/default/locations/default",
+              "room": { "displayName": "Lab", "sortIndex": 1 },
+              "room_id": "lab"
+            }
+          ]
+        }
+      """
+
+  Scenario: Rooms that aren't in a group are displayed in natural order
+    Given a JSON "chacerapp.v1.ListRoomsRequest"
+      """
+        { "parent": "accounts/default/locations/default", "order": "display" }
+      """
+     When calling the "chacerapp.v1.Rooms/ListRooms" RPC
+     Then I will receive a successful response
+      And the response value "rooms" will have a length of 5
+      And the response value "rooms[0].name" will be "accounts/default/locations/default/rooms/exam-2"
+      And the response value "rooms[1].name" will be "accounts/default/locations/default/rooms/exam-10"
+      And the response value "rooms[2].name" will be "accounts/default/locations/default/rooms/operatory-1"
+      And the response value "rooms[3].name" will be "accounts/default/locations/default/rooms/operatory-2"
+      And the response value "rooms[4].name" will be "accounts/default/locations/default/rooms/lab"
+
+  Scenario: Grouped rooms are displayed first in the order of their group
+    Given a JSON "chacerapp.v1.CreateRoomGroupRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "room_group_id": "east-hall",
+          "room_group": {
+            "displayName": "East hall",
+            "rooms": [
+              "accounts/default/locations/default/rooms/operatory-2",
+              "accounts/default/locations/default/rooms/operatory-1"
+            ]
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoomGroup" RPC
+     Then I will receive a successful response
+      And the response value "name" will be "accounts/default/locations/default/roomGroups/east-hall"
+    Given a JSON "chacerapp.v1.ListRoomsRequest"
+      """
+        { "parent": "accounts/default/locations/default", "order": "display" }
+      """
+     When calling the "chacerapp.v1.Rooms/ListRooms" RPC
+     Then I will receive a successful response
+      And the response value "rooms[0].name" will be "accounts/default/locations/default/rooms/operatory-2"
+      And the response value "rooms[1].name" will be "accounts/default/locations/default/rooms/operatory-1"
+      And the response value "rooms[2].name" will be "accounts/default/locations/default/rooms/exam-2"
+      And the response value "roomGroups" will have a length of 1
+      And the response value "roomGroups[0].displayName" will be "East hall"
+
+  Scenario: Deleting a room removes it from its group
+    Given a JSON "chacerapp.v1.CreateRoomGroupRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "room_group_id": "exam-rooms",
+          "room_group": {
+            "displayName": "Exam rooms",
+            "rooms": [
+              "accounts/default/locations/default/rooms/exam-2",
+              "accounts/default/locations/default/rooms/exam-10"
+            ]
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoomGroup" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.DeleteRoomRequest"
+      """
+        { "name": "accounts/default/locations/default/rooms/exam-2" }
+      """
+     When calling the "chacerapp.v1.Rooms/DeleteRoom" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.GetRoomGroupRequest"
+      """
+        { "name": "accounts/default/locations/default/roomGroups/exam-rooms" }
+      """
+     When calling the "chacerapp.v1.Rooms/GetRoomGroup" RPC
+     Then I will receive a successful response
+      And the response value "rooms" will have a length of 1
+      And the response value "rooms[0]" will be "accounts/default/locations/default/rooms/exam-10"
+
+  Scenario: Member rooms must exist in the location of the group
+    Given a JSON "chacerapp.v1.CreateRoomGroupRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "room_group_id": "east-hall",
+          "room_group": {
+            "displayName": "East hall",
+            "rooms": [
+              "accounts/default/locations/secondary/rooms/operatory-1",
+              "accounts/default/locations/default/rooms/operatory-2",
+              "accounts/default/locations/default/rooms/operatory-2"
+            ]
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoomGroup" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | room_group.rooms[0] | room must be in the location of the group |
+        | room_group.rooms[2] |                                           |
+    Given a JSON "chacerapp.v1.CreateRoomGroupRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "room_group_id": "east-hall",
+          "room_group": {
+            "displayName": "East hall",
+            "rooms": ["accounts/default/locations/default/rooms/does-not-exist"]
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoomGroup" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | room_group.rooms[0] | room does not exist |
+
+  Scenario: A room can only be in one group
+    Given a JSON "chacerapp.v1.CreateRoomGroupRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "room_group_id": "east-hall",
+          "room_group": {
+            "displayName": "East hall",
+            "rooms": ["accounts/default/locations/default/rooms/lab"]
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoomGroup" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.CreateRoomGroupRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "room_group_id": "west-hall",
+          "room_group": { "displayName": "West hall" }
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/CreateRoomGroup" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.UpdateRoomGroupRequest"
+      """
+        {
+          "room_group": {
+            "name": "accounts/default/locations/default/roomGroups/west-hall",
+            "rooms": ["accounts/default/locations/default/rooms/lab"]
+          },
+          "update_mask": "rooms"
+        }
+      """
+     When calling the "chacerapp.v1.Rooms/UpdateRoomGroup" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | room_group.rooms[0] | room is already in accounts/default/locations/default/roomGroups/east-hall |
+
+  Scenario: Display order requires a single location
+    Given a JSON "chacerapp.v1.ListRoomsRequest"
+      """
+        { "parent": "accounts/default/locations/-", "order": "display" }
+      """
+     When calling the "chacerapp.v1.Rooms/ListRooms" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
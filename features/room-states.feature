@@ -0,0 +1,106 @@
+Feature: Room states
+  In order to know which rooms are free
+  As a member of the front desk
+  I need the state of each room to be tracked and watched as it changes
+
+  Background:
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+      And these resources are created:
+      """
+        {
+          "resources": [
+            {
+              "@type": "chacerapp.v1.CreateRoomRequest",
+              "parent": "accounts/default/locations/default",
+              "room": { "displayName": "Operatory 1" },
+              "room_id": "operatory-1"
+            },
+            {
+              "@type": "chacerapp.v1.CreateRoomRequest",
+              "parent": "accounts/default/locations/default",
+              "room": { "displayName": "Operatory 2" },
+              "room_id": "operatory-2"
+            }
+          ]
+        }
+      """
+
+  Scenario: New rooms are available
+    Given a JSON "chacerapp.v1.GetRoomRequest"
+      """
+        { "name": "accounts/default/locations/default/rooms/operatory-1" }
+      """
+     When calling the "chacerapp.v1.Rooms/GetRoom" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "ROOM_STATE_AVAILABLE"
+      And the response value "stateChangeTime" will match "^\d{4}-\d{2}-\d{2}T"
+
+  Scenario: A room moves through its states and keeps a history of them
+    Given a JSON "chacerapp.v1.SetRoomStateRequest"
+      """
+        { "name": "accounts/default/locations/default/rooms/operatory-1", "state": "ROOM_STATE_OCCUPIED" }
+      """
+     When calling the "chacerapp.v1.Rooms/SetRoomState" RPC
+     Then I will receive a successful response
+      And the response value "state" will be "ROOM_STATE_OCCUPIED"
+      And the response value "stateChangedBy" will match ".+"
+    Given a JSON "chacerapp.v1.SetRoomStateRequest"
+      """
+        { "name": "accounts/default/locations/default/rooms/operatory-1", "state": "ROOM_STATE_NEEDS_CLEANING" }
+      """
+     When calling the "chacerapp.v1.Rooms/SetRoomState" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.ListRoomStateChangesRequest"
+      """
+        { "parent": "accounts/default/locations/default/rooms/operatory-1" }
+      """
+     When calling the "chacerapp.v1.Rooms/ListRoomStateChanges" RPC
+     Then I will receive a successful response
+      And the response value "roomStateChanges" will have a length of 2
+      And the response value "roomStateChanges[0].previousState" will be "ROOM_STATE_OCCUPIED"
+      And the response value "roomStateChanges[0].state" will be "ROOM_STATE_NEEDS_CLEANING"
+      And the response value "roomStateChanges[1].previousState" will be "ROOM_STATE_AVAILABLE"
+      And the response value "roomStateChanges[1].state" will be "ROOM_STATE_OCCUPIED"
+
+  Scenario: A room can only move to the states allowed from its current state
+    Given a JSON "chacerapp.v1.SetRoomStateRequest"
+      """
+        { "name": "accounts/default/locations/default/rooms/operatory-1", "state": "ROOM_STATE_NEEDS_CLEANING" }
+      """
+     When calling the "chacerapp.v1.Rooms/SetRoomState" RPC
+     Then I will receive an error with code "FAILED_PRECONDITION"
+    Given a JSON "chacerapp.v1.SetRoomStateRequest"
+      """
+        { "name": "accounts/default/locations/default/rooms/operatory-1" }
+      """
+     When calling the "chacerapp.v1.Rooms/SetRoomState" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | state | state is required |
+    Given a JSON "chacerapp.v1.SetRoomStateRequest"
+      """
+        { "name": "accounts/default/locations/default/rooms/does-not-exist", "state": "ROOM_STATE_OCCUPIED" }
+      """
+     When calling the "chacerapp.v1.Rooms/SetRoomState" RPC
+     Then I will receive an error with code "NOT_FOUND"
+
+  Scenario: Watching the state of the rooms in a location
+    Given a JSON "chacerapp.v1.WatchRoomStatesRequest"
+      """
+        { "parent": "accounts/default/locations/default" }
+      """
+     When watching the "chacerapp.v1.Rooms/WatchRoomStates" RPC
+      And receiving the next streamed response
+     Then the response value "rooms" will have a length of 2
+      And the response value "rooms[0].name" will be "accounts/default/locations/default/rooms/operatory-1"
+      And the response value "rooms[0].state" will be "ROOM_STATE_AVAILABLE"
+    Given a JSON "chacerapp.v1.SetRoomStateRequest"
+      """
+        { "name": "accounts/default/locations/default/rooms/operatory-2", "state": "ROOM_STATE_OUT_OF_SERVICE" }
+      """
+     When calling the "chacerapp.v1.Rooms/SetRoomState" RPC
+     Then I will receive a successful response
+     When receiving the next streamed response
+     Then the response value "rooms" will have a length of 1
+      And the response value "rooms[0].name" will be "accounts/default/locations/default/rooms/operatory-2"
+      And the response value "rooms[0].state" will be "ROOM_STATE_OUT_OF_SERVICE"
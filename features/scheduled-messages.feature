@@ -0,0 +1,166 @@
+Feature: Scheduled messages
+  In order to remind staff of the things that happen every day
+  As a manager of a location
+  I need messages that are sent at a later time or on a schedule
+
+  Background:
+    Given data loaded from the seed file "seed-data/rooms-background.json"
+
+  Scenario: A recurring message is sent first at its start time
+    Given a JSON "chacerapp.v1.CreateScheduledMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "scheduled_message_id": "morning-huddle",
+          "scheduled_message": {
+            "message": { "reason": "Morning huddle" },
+            "startTime": "2099-01-05T08:30:00Z",
+            "recurrence": "FREQ=WEEKLY;BYDAY=MO,WE,FR"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/CreateScheduledMessage" RPC
+     Then I will receive a successful response
+      And the response value "name" will be "accounts/default/locations/default/scheduledMessages/morning-huddle"
+      And the response value "nextSendTime" will be "2099-01-05T08:30:00Z"
+      And the response value "message.location" will be "accounts/default/locations/default"
+    Given a JSON "chacerapp.v1.ListScheduledMessagesRequest"
+      """
+        { "parent": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListScheduledMessages" RPC
+     Then I will receive a successful response
+      And the response value "scheduledMessages" will have a length of 1
+
+  Scenario: A scheduled message can be paused and resumed
+    Given a JSON "chacerapp.v1.CreateScheduledMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "scheduled_message_id": "morning-huddle",
+          "scheduled_message": {
+            "message": { "reason": "Morning huddle" },
+            "startTime": "2099-01-05T08:30:00Z",
+            "recurrence": "FREQ=DAILY"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/CreateScheduledMessage" RPC
+     Then I will receive a successful response
+    Given a JSON "chacerapp.v1.PauseScheduledMessageRequest"
+      """
+        { "name": "accounts/default/locations/default/scheduledMessages/morning-huddle" }
+      """
+     When calling the "chacerapp.v1.Messenger/PauseScheduledMessage" RPC
+     Then I will receive a successful response
+      And the response value "paused" will be "true"
+    Given a JSON "chacerapp.v1.ResumeScheduledMessageRequest"
+      """
+        { "name": "accounts/default/locations/default/scheduledMessages/morning-huddle" }
+      """
+     When calling the "chacerapp.v1.Messenger/ResumeScheduledMessage" RPC
+     Then I will receive a successful response
+      And the response value "nextSendTime" will be "2099-01-05T08:30:00Z"
+
+  Scenario: A message sent in the future is scheduled
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Lunch order" },
+          "sendTime": "2099-01-05T12:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "location" will be "accounts/default/locations/default"
+    Given a JSON "chacerapp.v1.ListScheduledMessagesRequest"
+      """
+        { "parent": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListScheduledMessages" RPC
+     Then I will receive a successful response
+      And the response value "scheduledMessages[0].startTime" will be "2099-01-05T12:00:00Z"
+      And the response value "scheduledMessages[0].recurrence" will be ""
+
+  Scenario: A recurrence rule must be supported
+    Given a JSON "chacerapp.v1.CreateScheduledMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "scheduled_message": {
+            "message": { "reason": "Morning huddle" },
+            "startTime": "2099-01-05T08:30:00Z",
+            "recurrence": "FREQ=HOURLY"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/CreateScheduledMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | scheduled_message.recurrence | FREQ must be DAILY, WEEKLY or MONTHLY |
+
+  Scenario: A message that is only sent once must start in the future
+    Given a JSON "chacerapp.v1.CreateScheduledMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "scheduled_message": {
+            "message": { "reason": "Morning huddle" },
+            "startTime": "2020-01-06T08:30:00Z"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/CreateScheduledMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | scheduled_message.start_time | start_time must be in the future for a message that is only sent once |
+
+  Scenario: A scheduled message can only be sent to a contact that exists
+    Given a JSON "chacerapp.v1.CreateScheduledMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "scheduled_message": {
+            "message": { "reason": "Morning huddle", "recipient": "accounts/default/contacts/does-not-exist" },
+            "recurrence": "FREQ=DAILY",
+            "startTime": "2099-01-05T08:30:00Z"
+          }
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/CreateScheduledMessage" RPC
+     Then I will receive an error with code "INVALID_ARGUMENT"
+      And the BadRequest error details will be for the following fields
+        | scheduled_message.message.recipient | contact does not exist |
+
+  Scenario: A due scheduled message is stored when it is sent
+    Given a JSON "chacerapp.v1.SendMessageRequest"
+      """
+        {
+          "parent": "accounts/default/locations/default",
+          "message": { "reason": "Lunch delivery" },
+          "sendTime": "2099-01-05T12:00:00Z"
+        }
+      """
+     When calling the "chacerapp.v1.Messenger/SendMessage" RPC
+     Then I will receive a successful response
+      And the response value "scheduledMessage" will match "^accounts/default/locations/default/scheduledMessages/lunch-delivery-[a-z0-9]+$"
+    Given the scheduled messages due within "876000h" are sent
+      And a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages" will have a length of 1
+      And the response value "messages[0].reason" will be "Lunch delivery"
+      And the response value "messages[0].state" will be "ACTIVE"
+      And the response value "messages[0].scheduledMessage" will match "^accounts/default/locations/default/scheduledMessages/lunch-delivery-[a-z0-9]+$"
+    Given the scheduled messages due within "876000h" are sent
+      And a JSON "chacerapp.v1.ListMessagesRequest"
+      """
+        { "parent": "accounts/default/locations/default" }
+      """
+     When calling the "chacerapp.v1.Messenger/ListMessages" RPC
+     Then I will receive a successful response
+      And the response value "messages" will have a length of 1
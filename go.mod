@@ -1,17 +1,31 @@
 module github.com/chacerapp/apiserver
 
-go 1.12
+go 1.16
 
 require (
 	github.com/DATA-DOG/go-txdb v0.1.3
+	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cockroachdb/cockroach-go v2.0.1+incompatible // indirect
 	github.com/cucumber/godog v0.10.0
 	github.com/cucumber/messages-go/v10 v10.0.3
+	github.com/desertbit/timer v0.0.0-20180107155436-c41aec40b27f // indirect
 	github.com/golang-migrate/migrate v3.5.4+incompatible
 	github.com/golang/protobuf v1.4.2
+	github.com/gorilla/websocket v1.4.2 // indirect
+	github.com/grpc-ecosystem/grpc-gateway v1.13.0
+	github.com/improbable-eng/grpc-web v0.13.0
 	github.com/lib/pq v1.8.0
 	github.com/mennanov/fieldmask-utils v0.3.2
-	github.com/stretchr/objx v0.1.0
+	github.com/prometheus/client_golang v0.9.2
+	github.com/prometheus/client_model v0.2.0 // indirect
+	github.com/rs/cors v1.7.0 // indirect
+	github.com/stretchr/objx v0.5.2
+	go.opentelemetry.io/otel v1.14.0
+	go.opentelemetry.io/otel/exporters/stdout/stdouttrace v1.14.0
+	go.opentelemetry.io/otel/sdk v1.14.0
+	go.opentelemetry.io/otel/trace v1.14.0
+	golang.org/x/sys v0.7.0 // indirect
+	golang.org/x/time v0.3.0
 	google.golang.org/genproto v0.0.0-20200731012542-8145dea6a485
 	google.golang.org/grpc v1.31.0
 	google.golang.org/protobuf v1.25.0
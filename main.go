@@ -3,70 +3,75 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"log"
-	"net"
+	"os"
 	"time"
 
-	"github.com/chacerapp/apiserver/server"
-	"github.com/chacerapp/apiserver/server/serverpb"
-	"github.com/chacerapp/apiserver/store"
+	_ "time/tzdata"
+
 	_ "github.com/lib/pq"
-	"google.golang.org/grpc"
 )
 
-func main() {
-	fmt.Println("Opening postgres database")
-	db, err := sql.Open("postgres", "postgres://root@localhost:26257/chacerapp_tests?sslmode=disable")
-	if err != nil {
-		log.Fatalf("failed to open new database connection: %v", err)
-	}
-
-	log.Print("Dialing TCP address for gRPC server")
-	listener, err := net.Listen("tcp", ":8080")
-	if err != nil {
-		log.Fatalf("failed to get TCP listener: %v", err)
-	}
+const usage = `Usage: apiserver <command> [flags]
 
-	log.Print("Creating a new gRPC server")
-	srv := server.NewGRPCServer(store.New(db, store.NewPaginator([]byte("my-super-secure-test-secret-3234"))))
+Commands:
+  serve    Run the gRPC, HTTP gateway, and gRPC-Web servers
+  migrate  Apply, revert, or show the status of the database migrations
+  check    Validate the configuration and the database schema version
 
-	go func() {
-		log.Print("Starting gRPC server")
-		err := srv.Serve(listener)
-		if err != nil {
-			log.Fatalf("error when running gRPC server: %v", err)
-		}
-	}()
+Every flag can also be set with a CHACERAPP_ prefixed environment variable,
+e.g. CHACERAPP_DATABASE_URL, or in the JSON file given with -config.
 
-	dialCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+Run "apiserver <command> -h" to see the flags for a command.
+`
 
-	log.Printf("Dialing API Server")
-	dial, err := grpc.DialContext(dialCtx, ":8080", grpc.WithInsecure())
-	if err != nil {
-		log.Fatalf("failed to dial gRPC server: %v", err)
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	var err error
+	switch os.Args[1] {
+	case "serve":
+		err = runServe(os.Args[2:])
+	case "migrate":
+		err = runMigrate(os.Args[2:])
+	case "check":
+		err = runCheck(os.Args[2:])
+	case "help", "-h", "-help", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
 
-	log.Printf("calling ListAccounts endpoint")
+	if err == flag.ErrHelp {
+		return
+	} else if err != nil {
+		log.Fatal(err)
+	}
+}
 
-	client := serverpb.NewAccountsClient(dial)
-	_, err = client.ListAccounts(ctx, &serverpb.ListAccountsRequest{
-		PageSize: 50,
-	})
+// openDatabase opens a connection pool to the database and verifies
+// that the database can be reached.
+func openDatabase(cfg *Config) (*sql.DB, error) {
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
 	if err != nil {
-		log.Fatalf("failed to list accounts: %v", err)
+		return nil, fmt.Errorf("failed to open new database connection: %v", err)
 	}
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
 
-	locationClient := serverpb.NewLocationsClient(dial)
-	_, err = locationClient.ListLocations(ctx, &serverpb.ListLocationsRequest{
-		Parent:   "accounts/my-account",
-		PageSize: 50,
-	})
-	if err != nil {
-		log.Fatalf("failed to list locations: %v", err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to the database: %v", err)
 	}
+	return db, nil
 }
@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// DevicesOnline is the number of devices within a location that are
+	// currently watching its messages on this server.
+	DevicesOnline = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "devices_online",
+		Help:      "Number of devices that are currently connected.",
+	}, []string{"account", "location"})
+
+	locationRoomsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "location_rooms"),
+		"Number of rooms within each location.",
+		[]string{"account", "location"}, nil,
+	)
+
+	locationMessagesOutstandingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "messages_outstanding"),
+		"Number of messages that have been sent but not completed, cancelled or expired.",
+		[]string{"account", "location"}, nil,
+	)
+)
+
+func init() {
+	Registry.MustRegister(DevicesOnline)
+}
+
+// LocationStats holds the number of resources within a location.
+type LocationStats struct {
+	Account  string
+	Location string
+	Rooms    int64
+	Messages int64
+}
+
+// LocationStatsSource provides the statistics of every location.
+type LocationStatsSource interface {
+	LocationStats(ctx context.Context) ([]LocationStats, error)
+}
+
+// RegisterLocationStats registers a collector that reports the statistics of
+// every location from the source each time the metrics are scraped.
+func RegisterLocationStats(source LocationStatsSource) error {
+	return Registry.Register(&locationStatsCollector{source})
+}
+
+type locationStatsCollector struct {
+	source LocationStatsSource
+}
+
+func (c *locationStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- locationRoomsDesc
+	ch <- locationMessagesOutstandingDesc
+}
+
+func (c *locationStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stats, err := c.source.LocationStats(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(locationRoomsDesc, err)
+		ch <- prometheus.NewInvalidMetric(locationMessagesOutstandingDesc, err)
+		return
+	}
+	for _, s := range stats {
+		ch <- prometheus.MustNewConstMetric(locationRoomsDesc, prometheus.GaugeValue, float64(s.Rooms), s.Account, s.Location)
+		ch <- prometheus.MustNewConstMetric(locationMessagesOutstandingDesc, prometheus.GaugeValue, float64(s.Messages), s.Account, s.Location)
+	}
+}
@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcStarted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "started_total",
+		Help:      "Total number of RPCs started on the server.",
+	}, []string{"grpc_service", "grpc_method", "grpc_type"})
+
+	rpcHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "Total number of RPCs completed on the server, regardless of success or failure.",
+	}, []string{"grpc_service", "grpc_method", "grpc_type", "grpc_code"})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "Latency of the RPCs handled by the server.",
+		Buckets:   latencyBuckets,
+	}, []string{"grpc_service", "grpc_method", "grpc_type"})
+
+	rpcRateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "rate_limited_total",
+		Help:      "Total number of RPCs rejected by a rate limit, by the kind of limit.",
+	}, []string{"grpc_service", "grpc_method", "limit"})
+)
+
+func init() {
+	Registry.MustRegister(rpcStarted, rpcHandled, rpcDuration, rpcRateLimited)
+}
+
+// RateLimited records that an RPC was rejected by a rate limit, where limit
+// is the kind of limit that was exceeded, e.g. account or caller.
+func RateLimited(fullMethod, limit string) {
+	service, method := splitMethodName(fullMethod)
+	rpcRateLimited.WithLabelValues(service, method, limit).Inc()
+}
+
+// UnaryServerInterceptor records the count and latency of every unary RPC.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		done := observeRPC(info.FullMethod, "unary")
+		resp, err := handler(ctx, req)
+		done(err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the count and latency of every streaming RPC.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		rpcType := "bidi_stream"
+		if info.IsServerStream && !info.IsClientStream {
+			rpcType = "server_stream"
+		} else if info.IsClientStream && !info.IsServerStream {
+			rpcType = "client_stream"
+		}
+
+		done := observeRPC(info.FullMethod, rpcType)
+		err := handler(srv, ss)
+		done(err)
+		return err
+	}
+}
+
+// observeRPC records that an RPC has started and returns a function that
+// records the result once the RPC has completed.
+func observeRPC(fullMethod, rpcType string) func(err error) {
+	service, method := splitMethodName(fullMethod)
+	rpcStarted.WithLabelValues(service, method, rpcType).Inc()
+
+	start := time.Now()
+	return func(err error) {
+		rpcHandled.WithLabelValues(service, method, rpcType, status.Code(err).String()).Inc()
+		rpcDuration.WithLabelValues(service, method, rpcType).Observe(since(start))
+	}
+}
+
+// splitMethodName splits a full method name, e.g. /chacerapp.v1.Rooms/GetRoom,
+// into its service and method.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", "unknown"
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import "testing"
+
+func TestSplitMethodName(t *testing.T) {
+	tests := []struct {
+		fullMethod string
+		service    string
+		method     string
+	}{
+		{"/chacerapp.v1.Rooms/GetRoom", "chacerapp.v1.Rooms", "GetRoom"},
+		{"/grpc.health.v1.Health/Watch", "grpc.health.v1.Health", "Watch"},
+		{"GetRoom", "unknown", "unknown"},
+	}
+
+	for _, test := range tests {
+		service, method := splitMethodName(test.fullMethod)
+		if service != test.service || method != test.method {
+			t.Errorf("splitMethodName(%q) = %q, %q; expected %q, %q", test.fullMethod, service, method, test.service, test.method)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Package metrics provides the Prometheus metrics recorded by the API server.
+//
+// Metrics are registered with Registry rather than the global Prometheus
+// registry so that only the metrics of the API server are exposed by Handler.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "chacerapp"
+
+// Registry holds every metric recorded by the API server.
+var Registry = prometheus.NewRegistry()
+
+func init() {
+	Registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler returns an HTTP handler that serves the metrics in the
+// Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// latencyBuckets are the histogram buckets, in seconds, used for RPC and
+// query latencies.
+var latencyBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// since returns the number of seconds that have elapsed since start.
+func since(start time.Time) float64 {
+	return time.Since(start).Seconds()
+}
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "query_duration_seconds",
+		Help:      "Latency of the queries and transactions run against the database.",
+		Buckets:   latencyBuckets,
+	}, []string{"query"})
+
+	queryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "query_errors_total",
+		Help:      "Total number of queries and transactions that failed.",
+	}, []string{"query"})
+
+	transactionRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "transaction_retries_total",
+		Help:      "Total number of transactions that were retried after a serialization conflict.",
+	}, []string{"query"})
+
+	pageTokens = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "pagination",
+		Name:      "page_tokens_total",
+		Help:      "Total number of page tokens generated and parsed, by result.",
+	}, []string{"operation", "result"})
+
+	pageOffset = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "pagination",
+		Name:      "offset",
+		Help:      "Number of results skipped by the page tokens of list requests.",
+		Buckets:   prometheus.ExponentialBuckets(25, 2, 10),
+	})
+)
+
+func init() {
+	Registry.MustRegister(queryDuration, queryErrors, transactionRetries, pageTokens, pageOffset)
+}
+
+// ObserveQuery records the latency of a query, or transaction, that started at
+// the given time. The query will be counted as an error unless the error is nil
+// or sql.ErrNoRows.
+func ObserveQuery(query string, start time.Time, err error) {
+	queryDuration.WithLabelValues(query).Observe(since(start))
+	if err != nil && err != sql.ErrNoRows && err != context.Canceled {
+		queryErrors.WithLabelValues(query).Inc()
+	}
+}
+
+// TransactionRetried records that a transaction had to be retried.
+func TransactionRetried(query string) {
+	transactionRetries.WithLabelValues(query).Inc()
+}
+
+// PageTokenGenerated records that a page token was generated.
+func PageTokenGenerated(err error) {
+	pageTokens.WithLabelValues("generate", result(err)).Inc()
+}
+
+// PageTokenParsed records that a page token was parsed along with the
+// number of results it will skip.
+func PageTokenParsed(offset int, err error) {
+	pageTokens.WithLabelValues("parse", result(err)).Inc()
+	if err == nil {
+		pageOffset.Observe(float64(offset))
+	}
+}
+
+func result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/chacerapp/apiserver/migrations"
+	"github.com/golang-migrate/migrate"
+)
+
+const migrateUsage = `Usage: apiserver migrate <command> [flags]
+
+Commands:
+  up [-steps N]          Apply all pending migrations, or the next N migrations
+  down [-steps N | -all] Revert the last N migrations, one by default, or all of them
+  status                 Show the current schema version and any pending migrations
+  force VERSION          Set the schema version without running any migrations,
+                         used to recover from a failed migration
+`
+
+func runMigrate(args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		fmt.Print(migrateUsage)
+		return fmt.Errorf("a migrate command is required")
+	}
+	command := args[0]
+
+	fs := flag.NewFlagSet("migrate "+command, flag.ContinueOnError)
+	steps := fs.Int("steps", 0, "number of migrations to apply or revert")
+	all := fs.Bool("all", false, "revert every migration when running down")
+	cfg, err := loadConfig(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	if cfg.DatabaseURL == "" {
+		return fmt.Errorf("invalid configuration: database-url is required")
+	}
+
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return err
+	}
+	// Closing the migration runner will also close the database
+	m, err := migrations.New(db)
+	if err != nil {
+		db.Close()
+		return err
+	}
+	defer m.Close()
+
+	switch command {
+	case "up":
+		if *steps > 0 {
+			err = m.Steps(*steps)
+		} else {
+			err = m.Up()
+		}
+	case "down":
+		if *all {
+			err = m.Down()
+		} else if *steps > 0 {
+			err = m.Steps(-*steps)
+		} else {
+			err = m.Steps(-1)
+		}
+	case "force":
+		if fs.NArg() != 1 {
+			return fmt.Errorf("force requires the version to set")
+		}
+		version, parseErr := strconv.Atoi(fs.Arg(0))
+		if parseErr != nil {
+			return fmt.Errorf("invalid version %q: %v", fs.Arg(0), parseErr)
+		}
+		err = m.Force(version)
+	case "status":
+	default:
+		fmt.Print(migrateUsage)
+		return fmt.Errorf("unknown migrate command %q", command)
+	}
+
+	if err == migrate.ErrNoChange {
+		log.Print("No migrations to run")
+	} else if err != nil {
+		return fmt.Errorf("failed to migrate database: %v", err)
+	}
+
+	status, err := migrations.GetStatus(m)
+	if err != nil {
+		return err
+	}
+	printStatus(status)
+	return nil
+}
+
+func printStatus(status migrations.Status) {
+	fmt.Printf("Schema version: %d", status.Version)
+	if status.Dirty {
+		fmt.Print(" (dirty)")
+	}
+	fmt.Printf("\nLatest version: %d\n", status.Latest)
+
+	if len(status.Pending) > 0 {
+		pending := make([]string, len(status.Pending))
+		for i := range status.Pending {
+			pending[i] = strconv.FormatUint(uint64(status.Pending[i]), 10)
+		}
+		fmt.Printf("Pending migrations: %s\n", strings.Join(pending, ", "))
+	}
+}
+
+// checkSchema returns an error when the database has not been migrated to
+// the latest version of the schema.
+func checkSchema(ctx context.Context, db *sql.DB) (migrations.Status, error) {
+	status, err := migrations.StatusOf(ctx, db)
+	if err != nil {
+		return status, err
+	}
+	return status, status.Err()
+}
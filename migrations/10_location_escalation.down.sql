@@ -0,0 +1 @@
+ALTER TABLE location DROP COLUMN IF EXISTS escalation_policy;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS scheduled_message (
+    id             UUID NOT NULL DEFAULT gen_random_uuid(),
+    name           STRING NOT NULL,
+    account        STRING NOT NULL,
+    location       STRING NOT NULL,
+    message        JSONB NOT NULL,
+    start_time     TIMESTAMP NOT NULL,
+    recurrence     STRING,
+    paused         BOOL NOT NULL DEFAULT false,
+    next_send_time TIMESTAMP,
+    last_send_time TIMESTAMP,
+    send_count     INT NOT NULL DEFAULT 0,
+    created_time   TIMESTAMP,
+    updated_time   TIMESTAMP,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    UNIQUE INDEX scheduled_message_account_location_name_key (account ASC, location ASC, name ASC),
+    INDEX (next_send_time ASC)
+);
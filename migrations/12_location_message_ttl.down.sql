@@ -0,0 +1 @@
+ALTER TABLE location DROP COLUMN IF EXISTS message_ttl;
@@ -0,0 +1 @@
+ALTER TABLE location ADD COLUMN IF NOT EXISTS message_ttl INT8;
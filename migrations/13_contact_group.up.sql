@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS contact_group (
+    id           UUID NOT NULL DEFAULT gen_random_uuid(),
+    name         STRING NOT NULL,
+    account      STRING NOT NULL,
+    display_name STRING,
+    description  STRING,
+    members      STRING[] NOT NULL DEFAULT ARRAY[],
+    created_time TIMESTAMP,
+    updated_time TIMESTAMP,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    UNIQUE INDEX contact_group_account_name_key (account ASC, name ASC)
+);
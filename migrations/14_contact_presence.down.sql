@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS contact_presence_change;
+
+ALTER TABLE location DROP COLUMN IF EXISTS presence_check;
@@ -0,0 +1,14 @@
+ALTER TABLE location ADD COLUMN IF NOT EXISTS presence_check STRING;
+
+CREATE TABLE IF NOT EXISTS contact_presence_change (
+    id             UUID NOT NULL DEFAULT gen_random_uuid(),
+    contact        STRING NOT NULL,
+    previous_state STRING,
+    state          STRING NOT NULL,
+    account        STRING,
+    location       STRING,
+    change_time    TIMESTAMP NOT NULL,
+    changed_by     STRING,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    INDEX (contact ASC, change_time DESC)
+);
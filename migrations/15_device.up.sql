@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS device (
+    id           UUID NOT NULL DEFAULT gen_random_uuid(),
+    name         STRING NOT NULL,
+    account      STRING NOT NULL,
+    location     STRING NOT NULL,
+    display_name STRING,
+    created_time TIMESTAMP,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    UNIQUE INDEX device_account_location_name_key (account ASC, location ASC, name ASC),
+    INDEX (account ASC)
+);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS message (
+    id           UUID NOT NULL DEFAULT gen_random_uuid(),
+    name         STRING NOT NULL,
+    account      STRING NOT NULL,
+    location     STRING NOT NULL,
+    message      JSONB NOT NULL,
+    state        STRING NOT NULL,
+    priority     INT NOT NULL DEFAULT 0,
+    expire_time  TIMESTAMP,
+    created_time TIMESTAMP NOT NULL,
+    updated_time TIMESTAMP NOT NULL,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    UNIQUE INDEX message_account_location_name_key (account ASC, location ASC, name ASC),
+    INDEX (account ASC, location ASC, updated_time ASC),
+    INDEX (state ASC, expire_time ASC)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS message@message_next_escalation_time_idx;
+
+ALTER TABLE message DROP COLUMN IF EXISTS next_escalation_time;
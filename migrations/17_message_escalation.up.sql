@@ -0,0 +1,3 @@
+ALTER TABLE message ADD COLUMN IF NOT EXISTS next_escalation_time TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS message_next_escalation_time_idx ON message (next_escalation_time ASC);
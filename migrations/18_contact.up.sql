@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS contact (
+    id           UUID NOT NULL DEFAULT gen_random_uuid(),
+    name         STRING NOT NULL,
+    account      STRING NOT NULL,
+    display_name STRING,
+    labels       JSONB,
+    annotations  JSONB,
+    created_time TIMESTAMP,
+    updated_time TIMESTAMP,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    UNIQUE INDEX contact_name_key (name ASC),
+    INDEX contact_account_idx (account ASC, display_name ASC)
+);
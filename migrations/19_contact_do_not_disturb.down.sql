@@ -0,0 +1 @@
+ALTER TABLE contact DROP COLUMN IF EXISTS do_not_disturb;
@@ -0,0 +1 @@
+ALTER TABLE contact ADD COLUMN IF NOT EXISTS do_not_disturb BOOL NOT NULL DEFAULT false;
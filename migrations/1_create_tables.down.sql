@@ -1 +1,3 @@
-DROP TABLE account;
+DROP TABLE IF EXISTS room;
+DROP TABLE IF EXISTS location;
+DROP TABLE IF EXISTS account;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS contact@contact_account_name_key CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS contact_name_key ON contact (name ASC);
@@ -0,0 +1 @@
+ALTER TABLE contact_presence_change DROP COLUMN IF EXISTS contact_account;
@@ -0,0 +1 @@
+ALTER TABLE contact_presence_change ADD COLUMN IF NOT EXISTS contact_account STRING;
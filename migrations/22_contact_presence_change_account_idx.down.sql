@@ -0,0 +1 @@
+DROP INDEX IF EXISTS contact_presence_change@contact_presence_change_contact_account_idx;
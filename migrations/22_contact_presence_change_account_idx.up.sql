@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS contact_presence_change_contact_account_idx ON contact_presence_change (contact_account ASC, contact ASC, change_time DESC);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS account@account_name_key CASCADE;
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS account_name_key ON account (name ASC);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS location@location_account_name_key CASCADE;
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS location_account_name_key ON location (account ASC, name ASC);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS room@room_account_location_name_key CASCADE;
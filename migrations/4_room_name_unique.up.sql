@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS room_account_location_name_key ON room (account ASC, location ASC, name ASC);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS account_suspension (
+    id           UUID NOT NULL DEFAULT gen_random_uuid(),
+    account      STRING NOT NULL,
+    reason       STRING NOT NULL,
+    message      STRING,
+    suspend_time TIMESTAMP NOT NULL,
+    expire_time  TIMESTAMP,
+    end_time     TIMESTAMP,
+    end_reason   STRING,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    INDEX (account ASC, suspend_time DESC),
+    INDEX (expire_time ASC)
+);
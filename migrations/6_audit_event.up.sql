@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS audit_event (
+    id         UUID NOT NULL DEFAULT gen_random_uuid(),
+    event_time TIMESTAMP NOT NULL,
+    actor      STRING NOT NULL,
+    method     STRING NOT NULL,
+    resource   STRING NOT NULL,
+    request_id STRING,
+    code       STRING NOT NULL,
+    changes    JSONB,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    INDEX (event_time DESC),
+    INDEX (resource ASC, event_time DESC),
+    INDEX (actor ASC, event_time DESC)
+);
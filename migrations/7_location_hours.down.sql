@@ -0,0 +1,2 @@
+ALTER TABLE location DROP COLUMN IF EXISTS hours;
+ALTER TABLE location DROP COLUMN IF EXISTS time_zone;
@@ -0,0 +1,2 @@
+ALTER TABLE location ADD COLUMN IF NOT EXISTS time_zone STRING;
+ALTER TABLE location ADD COLUMN IF NOT EXISTS hours JSONB;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS room_state_change;
+
+DROP INDEX IF EXISTS room@room_account_state_change_time_idx;
+
+ALTER TABLE room DROP COLUMN IF EXISTS state_changed_by;
+ALTER TABLE room DROP COLUMN IF EXISTS state_change_time;
+ALTER TABLE room DROP COLUMN IF EXISTS state;
@@ -0,0 +1,18 @@
+ALTER TABLE room ADD COLUMN IF NOT EXISTS state STRING;
+ALTER TABLE room ADD COLUMN IF NOT EXISTS state_change_time TIMESTAMP;
+ALTER TABLE room ADD COLUMN IF NOT EXISTS state_changed_by STRING;
+
+CREATE INDEX IF NOT EXISTS room_account_state_change_time_idx ON room (account ASC, state_change_time ASC);
+
+CREATE TABLE IF NOT EXISTS room_state_change (
+    id             UUID NOT NULL DEFAULT gen_random_uuid(),
+    account        STRING NOT NULL,
+    location       STRING NOT NULL,
+    room           STRING NOT NULL,
+    previous_state STRING,
+    state          STRING NOT NULL,
+    change_time    TIMESTAMP NOT NULL,
+    changed_by     STRING,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    INDEX (account ASC, location ASC, room ASC, change_time DESC)
+);
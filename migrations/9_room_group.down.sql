@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS room_group;
+
+ALTER TABLE room DROP COLUMN IF EXISTS sort_index;
@@ -0,0 +1,16 @@
+ALTER TABLE room ADD COLUMN IF NOT EXISTS sort_index INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS room_group (
+    id           UUID NOT NULL DEFAULT gen_random_uuid(),
+    name         STRING NOT NULL,
+    account      STRING NOT NULL,
+    location     STRING NOT NULL,
+    display_name STRING,
+    description  STRING,
+    rooms        STRING[] NOT NULL DEFAULT ARRAY[],
+    sort_index   INT NOT NULL DEFAULT 0,
+    created_time TIMESTAMP,
+    updated_time TIMESTAMP,
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    UNIQUE INDEX room_group_account_location_name_key (account ASC, location ASC, name ASC)
+);
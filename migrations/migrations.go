@@ -0,0 +1,146 @@
+// Package migrations embeds the database migrations so they can be applied
+// by the server binary.
+//
+// The server can be upgraded while the previous release is still serving
+// requests, so every migration must be compatible with the release before
+// it. New columns should be nullable or have a default, and columns or tables
+// should only be dropped once no release reads them. A migration can hold a
+// few related statements, but each one should use IF EXISTS or IF NOT EXISTS,
+// so a migration that fails part way through can be forced back to the
+// version before it with `force` and applied again. Every migration must have
+// a down script that reverses it.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+
+	"github.com/golang-migrate/migrate"
+	"github.com/golang-migrate/migrate/database/cockroachdb"
+	"github.com/golang-migrate/migrate/source"
+	bindata "github.com/golang-migrate/migrate/source/go_bindata"
+	"github.com/lib/pq"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// New creates a migration runner for the embedded migrations. Closing the
+// runner will also close the database.
+func New(db *sql.DB) (*migrate.Migrate, error) {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	sourceDriver, err := bindata.WithInstance(bindata.Resource(names, files.ReadFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %v", err)
+	}
+
+	databaseDriver, err := cockroachdb.WithInstance(db, &cockroachdb.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration driver: %v", err)
+	}
+
+	return migrate.NewWithInstance("embed", sourceDriver, "cockroachdb", databaseDriver)
+}
+
+// Versions returns the version of every embedded migration in ascending order.
+func Versions() []uint {
+	names, _ := fs.Glob(files, "*.sql")
+
+	seen := map[uint]bool{}
+	var versions []uint
+	for _, name := range names {
+		m, err := source.DefaultParse(name)
+		if err != nil || seen[m.Version] {
+			continue
+		}
+		seen[m.Version] = true
+		versions = append(versions, m.Version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+// Latest returns the version of the newest embedded migration.
+func Latest() uint {
+	versions := Versions()
+	if len(versions) == 0 {
+		return 0
+	}
+	return versions[len(versions)-1]
+}
+
+// Status describes the schema version of a database compared to the
+// embedded migrations.
+type Status struct {
+	// The version of the last migration applied to the database. This
+	// will be zero when no migrations have been applied.
+	Version uint
+	// Dirty is set when the last migration failed part way through and
+	// must be fixed by hand before migrations can be run again.
+	Dirty bool
+	// The version of the newest embedded migration.
+	Latest uint
+	// The versions of the embedded migrations that have not been applied.
+	Pending []uint
+}
+
+// Err returns an error describing why the database can not be used by this
+// release, or nil when it is up to date. A database that is ahead is allowed
+// since migrations are backwards compatible with the previous release.
+func (s Status) Err() error {
+	if s.Dirty {
+		return fmt.Errorf("database schema version %d is dirty, fix the failed migration and run `apiserver migrate force`", s.Version)
+	} else if len(s.Pending) > 0 {
+		return fmt.Errorf("database schema is at version %d but version %d is required, run `apiserver migrate up`", s.Version, s.Latest)
+	}
+	return nil
+}
+
+// GetStatus returns the schema version of the database using the migration runner.
+func GetStatus(m *migrate.Migrate) (Status, error) {
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		version, dirty = 0, false
+	} else if err != nil {
+		return Status{}, fmt.Errorf("failed to read schema version: %v", err)
+	}
+	return newStatus(version, dirty), nil
+}
+
+// StatusOf returns the schema version of the database. Unlike GetStatus it
+// does not create the migration tables, so it is safe to call from a server
+// that only needs to verify the schema.
+func StatusOf(ctx context.Context, db *sql.DB) (Status, error) {
+	var version int64
+	var dirty bool
+	query := fmt.Sprintf("SELECT version, dirty FROM %s LIMIT 1", cockroachdb.DefaultMigrationsTable)
+	err := db.QueryRowContext(ctx, query).Scan(&version, &dirty)
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "42P01" {
+		// The migrations table has not been created yet
+		return newStatus(0, false), nil
+	} else if err == sql.ErrNoRows {
+		return newStatus(0, false), nil
+	} else if err != nil {
+		return Status{}, fmt.Errorf("failed to read schema version: %v", err)
+	}
+	return newStatus(uint(version), dirty), nil
+}
+
+func newStatus(version uint, dirty bool) Status {
+	status := Status{Version: version, Dirty: dirty, Latest: Latest()}
+	for _, v := range Versions() {
+		if v > version {
+			status.Pending = append(status.Pending, v)
+		}
+	}
+	return status
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/golang-migrate/migrate/source"
+)
+
+func TestMigrationsHaveUpAndDown(t *testing.T) {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	directions := map[uint]map[source.Direction]bool{}
+	for _, name := range names {
+		m, err := source.DefaultParse(name)
+		if err != nil {
+			t.Fatalf("migration %s has an invalid name: %v", name, err)
+		}
+		if directions[m.Version] == nil {
+			directions[m.Version] = map[source.Direction]bool{}
+		}
+		directions[m.Version][m.Direction] = true
+	}
+
+	for i, version := range Versions() {
+		if version != uint(i+1) {
+			t.Errorf("expected migration version %d, got %d", i+1, version)
+		}
+		if !directions[version][source.Up] || !directions[version][source.Down] {
+			t.Errorf("migration %d must have both an up and a down script", version)
+		}
+	}
+	if Latest() != uint(len(directions)) {
+		t.Errorf("expected the latest version to be %d, got %d", len(directions), Latest())
+	}
+}
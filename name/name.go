@@ -21,23 +21,42 @@ const (
 
 	CollectionRooms = "rooms"
 
+	CollectionRoomGroups = "roomGroups"
+
 	CollectionMessage = "messages"
+
+	CollectionScheduledMessages = "scheduledMessages"
+
+	CollectionContactGroups = "contactGroups"
 )
 
 type parseOptions struct {
 	allowWildcard bool
 }
 
+// ParseOption configures how a resource name is parsed.
 type ParseOption func(*parseOptions)
 
+// AllowWildcard will allow the `-` wildcard to be used in place of any
+// resource ID in the name. This should only be used when parsing names
+// that may reference a collection across parents, such as the parent
+// of a List request.
 func AllowWildcard() ParseOption {
 	return func(opts *parseOptions) {
 		opts.allowWildcard = true
 	}
 }
 
-func invalidNameError(collectionIDs ...string) error {
-	return status.Error(codes.InvalidArgument, fmt.Sprintf("a valid name will be in the format of `%s/*`", strings.Join(collectionIDs, "/*/")))
+func getParseOptions(opts ...ParseOption) *parseOptions {
+	options := &parseOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+func invalidNameError(templates ...string) error {
+	return status.Error(codes.InvalidArgument, fmt.Sprintf("a valid name will be in the format of `%s`", strings.Join(templates, "` or `")))
 }
 
 func BuildRelativeName(parts ...string) string {
@@ -45,24 +64,24 @@ func BuildRelativeName(parts ...string) string {
 }
 
 func BuildAccount(account string) string {
-	return BuildRelativeName(CollectionAccounts, account)
+	return AccountName{Account: account}.String()
 }
 
 func BuildRoom(account, location, room string) string {
-	return BuildRelativeName(CollectionAccounts, account, CollectionLocations, location, CollectionRooms, room)
+	return RoomName{Account: account, Location: location, Room: room}.String()
 }
 
 func BuildLocation(account, location string) string {
-	return BuildRelativeName(CollectionAccounts, account, CollectionLocations, location)
+	return LocationName{Account: account, Location: location}.String()
 }
 
-func ParseAccount(name string) (accountName string, err error) {
-	parts, err := ParseRelativeName(name, CollectionAccounts)
+func ParseAccount(name string, opts ...ParseOption) (accountName string, err error) {
+	n, err := ParseAccountName(name, opts...)
 	if err != nil {
 		return "", err
 	}
 
-	return parts[0], nil
+	return n.Account, nil
 }
 
 func ParseDevice(name string) (accountName, locationName, deviceName string, err error) {
@@ -83,21 +102,21 @@ func ParseColor(name string) (accountName, colorName string, err error) {
 	return parts[0], parts[1], nil
 }
 
-func ParseLocation(name string) (accountName, locationName string, err error) {
-	parts, err := ParseRelativeName(name, CollectionAccounts, CollectionLocations)
+func ParseLocation(name string, opts ...ParseOption) (accountName, locationName string, err error) {
+	n, err := ParseLocationName(name, opts...)
 	if err != nil {
 		return "", "", err
 	}
 
-	return parts[0], parts[1], nil
+	return n.Account, n.Location, nil
 }
 
-func ParseRoom(name string) (accountName, locationName, roomName string, err error) {
-	parts, err := ParseRelativeName(name, CollectionAccounts, CollectionLocations, CollectionRooms)
+func ParseRoom(name string, opts ...ParseOption) (accountName, locationName, roomName string, err error) {
+	n, err := ParseRoomName(name, opts...)
 	if err != nil {
 		return "", "", "", err
 	}
-	return parts[0], parts[1], parts[2], nil
+	return n.Account, n.Location, n.Room, nil
 }
 
 // ValidResourceID will check if the provided ID can be used as a valid
@@ -134,45 +153,21 @@ func ValidResourceID(id string) bool {
 	return true
 }
 
+// ParseRelativeName will parse a name made up of alternating collection IDs
+// and resource IDs, such as `accounts/*/locations/*`, and return the resource
+// IDs in order. The `-` wildcard is accepted for any resource ID.
+//
+// Names for resources that are declared in the API definitions should be
+// parsed with the typed parsers or the Registry instead.
 func ParseRelativeName(name string, collectionIDs ...string) ([]string, error) {
-	numCollections := len(collectionIDs)
-
-	// convert to an array of strings
-	requiredCollections := make([]string, numCollections)
+	segments := make([]string, len(collectionIDs))
 	for i := range collectionIDs {
-		requiredCollections[i] = string(collectionIDs[i])
+		segments[i] = collectionIDs[i] + "/{" + collectionIDs[i] + "}"
 	}
 
-	parts := strings.Split(name, "/")
-	// A valid collection ID name MUST have twice the number
-	// of parts as the number of collections minus one since
-	// the last collection will not have a corresponding
-	// resource ID.
-	if len(parts) != (numCollections * 2) {
-		return nil, invalidNameError(requiredCollections...)
-	}
-
-	// Since the last collection ID will not have a corresponding resource ID
-	// we can make it one less than the length of the required collections.
-	resourceIDs := make([]string, numCollections)
-
-	// Check that each of the required collectionIDs are
-	// present in the correct location
-	for i := range requiredCollections {
-		if requiredCollections[i] != parts[i*2] {
-			return nil, invalidNameError(requiredCollections...)
-		}
-
-		// The last collection won't have a corresponding resource ID
-		if i != numCollections {
-			// validate the resource ID is valid
-			if parts[i*2+1] != "-" && !ValidResourceID(parts[i*2+1]) {
-				return nil, invalidNameError(requiredCollections...)
-			}
-
-			resourceIDs[i] = parts[i*2+1]
-		}
+	pattern, err := CompilePattern(strings.Join(segments, "/"))
+	if err != nil {
+		return nil, err
 	}
-
-	return resourceIDs, nil
+	return pattern.Parse(name, AllowWildcard())
 }
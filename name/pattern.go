@@ -0,0 +1,166 @@
+package name
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Wildcard is the resource ID that can be used in place of a resource ID
+// to reference all resources within a collection, for example
+// `accounts/-/locations/-` when listing across every account.
+const Wildcard = "-"
+
+// Pattern is a compiled resource name pattern as declared by the
+// `google.api.resource` annotation, for example
+// `accounts/{account}/locations/{location}`.
+//
+// Every segment of a pattern is either a literal collection ID or a
+// variable that will be substituted with a resource ID.
+type Pattern struct {
+	raw      string
+	segments []patternSegment
+	vars     []string
+}
+
+type patternSegment struct {
+	// The literal value of the segment. This will be empty when
+	// the segment is a variable.
+	literal string
+	// The name of the variable, without the enclosing braces.
+	variable string
+}
+
+// CompilePattern will compile a resource name pattern so that it can be used
+// to parse and build resource names. An error is returned when the pattern
+// is empty or contains a malformed or duplicated variable.
+func CompilePattern(pattern string) (*Pattern, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("resource name pattern must not be empty")
+	}
+
+	p := &Pattern{raw: pattern}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(pattern, "/") {
+		if part == "" {
+			return nil, fmt.Errorf("resource name pattern %q contains an empty segment", pattern)
+		}
+
+		if !strings.HasPrefix(part, "{") && !strings.HasSuffix(part, "}") {
+			p.segments = append(p.segments, patternSegment{literal: part})
+			continue
+		}
+
+		variable := strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}")
+		if len(variable)+2 != len(part) || variable == "" || strings.ContainsAny(variable, "{}=*") {
+			return nil, fmt.Errorf("resource name pattern %q contains an invalid variable %q", pattern, part)
+		}
+		if seen[variable] {
+			return nil, fmt.Errorf("resource name pattern %q contains the variable %q more than once", pattern, variable)
+		}
+		seen[variable] = true
+
+		p.segments = append(p.segments, patternSegment{variable: variable})
+		p.vars = append(p.vars, variable)
+	}
+
+	return p, nil
+}
+
+// MustCompilePattern is like CompilePattern but panics when the pattern
+// can not be compiled.
+func MustCompilePattern(pattern string) *Pattern {
+	p, err := CompilePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the pattern in the format it was compiled from.
+func (p *Pattern) String() string {
+	return p.raw
+}
+
+// Variables returns the names of the variables in the pattern in the
+// order they appear.
+func (p *Pattern) Variables() []string {
+	vars := make([]string, len(p.vars))
+	copy(vars, p.vars)
+	return vars
+}
+
+// Template returns the pattern with every variable replaced with `*`, which
+// is the format used when describing a valid name to API consumers.
+func (p *Pattern) Template() string {
+	parts := make([]string, len(p.segments))
+	for i, segment := range p.segments {
+		if segment.variable != "" {
+			parts[i] = "*"
+		} else {
+			parts[i] = segment.literal
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// Parse will parse a relative resource name and return the resource IDs in
+// the same order as the variables of the pattern. An InvalidArgument error
+// is returned when the name does not match the pattern or when a resource
+// ID is not valid.
+func (p *Pattern) Parse(name string, opts ...ParseOption) ([]string, error) {
+	options := getParseOptions(opts...)
+
+	parts := strings.Split(name, "/")
+	if len(parts) != len(p.segments) {
+		return nil, invalidNameError(p.Template())
+	}
+
+	resourceIDs := make([]string, 0, len(p.vars))
+	for i, segment := range p.segments {
+		if segment.variable == "" {
+			if parts[i] != segment.literal {
+				return nil, invalidNameError(p.Template())
+			}
+			continue
+		}
+
+		if parts[i] == Wildcard {
+			if !options.allowWildcard {
+				return nil, invalidNameError(p.Template())
+			}
+		} else if !ValidResourceID(parts[i]) {
+			return nil, invalidNameError(p.Template())
+		}
+		resourceIDs = append(resourceIDs, parts[i])
+	}
+
+	return resourceIDs, nil
+}
+
+// Match reports whether the name is valid for the pattern.
+func (p *Pattern) Match(name string, opts ...ParseOption) bool {
+	_, err := p.Parse(name, opts...)
+	return err == nil
+}
+
+// Build will build a relative resource name by substituting the provided
+// resource IDs, in order, for the variables of the pattern. An error is
+// returned when the number of resource IDs does not match the number of
+// variables in the pattern.
+func (p *Pattern) Build(resourceIDs ...string) (string, error) {
+	if len(resourceIDs) != len(p.vars) {
+		return "", fmt.Errorf("resource name pattern %q requires %d resource IDs, got %d", p.raw, len(p.vars), len(resourceIDs))
+	}
+
+	parts := make([]string, len(p.segments))
+	next := 0
+	for i, segment := range p.segments {
+		if segment.variable == "" {
+			parts[i] = segment.literal
+			continue
+		}
+		parts[i] = resourceIDs[next]
+		next++
+	}
+	return strings.Join(parts, "/"), nil
+}
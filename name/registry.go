@@ -0,0 +1,311 @@
+package name
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	// The API definitions must be registered with the global proto registry
+	// before the default registry can be populated from them.
+	_ "github.com/chacerapp/apiserver/server/serverpb"
+)
+
+// ResourceType describes a resource that has been registered with a Registry
+// along with the patterns its resource names must match.
+type ResourceType struct {
+	// The resource type, in the format `{Service Name}/{Type}`.
+	//
+	// Example: chacerappapis.com/Room
+	Type string
+	// The plural form of the resource, when declared.
+	Plural string
+	// The singular form of the resource, when declared.
+	Singular string
+	// The patterns that a resource name may match. The first pattern is
+	// used when building new resource names.
+	Patterns []*Pattern
+}
+
+// Service returns the service name portion of the resource type.
+func (t *ResourceType) Service() string {
+	return t.Type[:strings.LastIndex(t.Type, "/")]
+}
+
+// Kind returns the type portion of the resource type.
+func (t *ResourceType) Kind() string {
+	return t.Type[strings.LastIndex(t.Type, "/")+1:]
+}
+
+// Parse will parse a relative resource name against every pattern of the
+// resource type and return the resource IDs of the first pattern that matches.
+func (t *ResourceType) Parse(name string, opts ...ParseOption) ([]string, error) {
+	for _, pattern := range t.Patterns {
+		if ids, err := pattern.Parse(name, opts...); err == nil {
+			return ids, nil
+		}
+	}
+	return nil, invalidNameError(t.templates()...)
+}
+
+// Validate will return an InvalidArgument error when the name is not a
+// valid relative resource name for the resource type.
+func (t *ResourceType) Validate(name string, opts ...ParseOption) error {
+	_, err := t.Parse(name, opts...)
+	return err
+}
+
+// Build will build a relative resource name for the resource type using
+// its first pattern.
+func (t *ResourceType) Build(resourceIDs ...string) (string, error) {
+	return t.Patterns[0].Build(resourceIDs...)
+}
+
+// FullName will convert a relative resource name of this type to a full
+// resource name, which is prefixed with the service name of the resource.
+//
+// Example: //chacerappapis.com/accounts/default
+func (t *ResourceType) FullName(relativeName string) string {
+	return "//" + t.Service() + "/" + relativeName
+}
+
+// ParseFullName will parse a full resource name of this type and return the
+// relative resource name along with its resource IDs.
+func (t *ResourceType) ParseFullName(fullName string, opts ...ParseOption) (string, []string, error) {
+	prefix := "//" + t.Service() + "/"
+	if !strings.HasPrefix(fullName, prefix) {
+		return "", nil, status.Error(codes.InvalidArgument, fmt.Sprintf("a valid full name will be prefixed with `%s`", prefix))
+	}
+
+	relativeName := strings.TrimPrefix(fullName, prefix)
+	ids, err := t.Parse(relativeName, opts...)
+	if err != nil {
+		return "", nil, err
+	}
+	return relativeName, ids, nil
+}
+
+func (t *ResourceType) templates() []string {
+	templates := make([]string, len(t.Patterns))
+	for i := range t.Patterns {
+		templates[i] = t.Patterns[i].Template()
+	}
+	return templates
+}
+
+// Registry holds the resource types that resource names can be
+// parsed, built, and validated against.
+type Registry struct {
+	mu    sync.RWMutex
+	types map[string]*ResourceType
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{types: map[string]*ResourceType{}}
+}
+
+// Register will compile the provided patterns and register them for the
+// resource type. Registering a type that already exists will replace it.
+func (r *Registry) Register(resourceType string, patterns ...string) error {
+	return r.register(&annotations.ResourceDescriptor{Type: resourceType, Pattern: patterns})
+}
+
+// RegisterFile will register every message in the file that is annotated
+// with a `google.api.resource` option.
+func (r *Registry) RegisterFile(file protoreflect.FileDescriptor) error {
+	return r.registerMessages(file.Messages())
+}
+
+// Lookup will find the registered resource type with the given name.
+func (r *Registry) Lookup(resourceType string) (*ResourceType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.types[resourceType]
+	return t, ok
+}
+
+// Types returns the names of all registered resource types in sorted order.
+func (r *Registry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]string, 0, len(r.types))
+	for t := range r.types {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Parse will parse a relative resource name for the resource type and return
+// its resource IDs in the order they appear in the name.
+func (r *Registry) Parse(resourceType, name string, opts ...ParseOption) ([]string, error) {
+	t, err := r.mustLookup(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	return t.Parse(name, opts...)
+}
+
+// Validate will return an InvalidArgument error when the name is not a valid
+// relative resource name for the resource type.
+func (r *Registry) Validate(resourceType, name string, opts ...ParseOption) error {
+	_, err := r.Parse(resourceType, name, opts...)
+	return err
+}
+
+// Build will build a relative resource name for the resource type.
+func (r *Registry) Build(resourceType string, resourceIDs ...string) (string, error) {
+	t, err := r.mustLookup(resourceType)
+	if err != nil {
+		return "", err
+	}
+	return t.Build(resourceIDs...)
+}
+
+// FullName will convert a relative resource name to a full resource name
+// using the service name of the resource type.
+func (r *Registry) FullName(resourceType, relativeName string) (string, error) {
+	t, err := r.mustLookup(resourceType)
+	if err != nil {
+		return "", err
+	}
+	return t.FullName(relativeName), nil
+}
+
+// ParseFullName will determine the resource type of a full resource name and
+// return the type along with its relative resource name and resource IDs.
+func (r *Registry) ParseFullName(fullName string, opts ...ParseOption) (*ResourceType, string, []string, error) {
+	for _, resourceType := range r.Types() {
+		t, _ := r.Lookup(resourceType)
+		if relativeName, ids, err := t.ParseFullName(fullName, opts...); err == nil {
+			return t, relativeName, ids, nil
+		}
+	}
+	return nil, "", nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%q is not a valid full resource name", fullName))
+}
+
+// Match will find the resource type, and the pattern of that type, that a
+// relative resource name matches.
+func (r *Registry) Match(name string, opts ...ParseOption) (*ResourceType, *Pattern, bool) {
+	for _, resourceType := range r.Types() {
+		t, _ := r.Lookup(resourceType)
+		for _, pattern := range t.Patterns {
+			if pattern.Match(name, opts...) {
+				return t, pattern, true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+func (r *Registry) mustLookup(resourceType string) (*ResourceType, error) {
+	t, ok := r.Lookup(resourceType)
+	if !ok {
+		return nil, fmt.Errorf("resource type %q has not been registered", resourceType)
+	}
+	return t, nil
+}
+
+func (r *Registry) registerMessages(messages protoreflect.MessageDescriptors) error {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if proto.HasExtension(message.Options(), annotations.E_Resource) {
+			descriptor := proto.GetExtension(message.Options(), annotations.E_Resource).(*annotations.ResourceDescriptor)
+			if err := r.register(descriptor); err != nil {
+				return fmt.Errorf("failed to register resource for message %v: %v", message.FullName(), err)
+			}
+		}
+		// Nested messages may also declare resources
+		if err := r.registerMessages(message.Messages()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Registry) register(descriptor *annotations.ResourceDescriptor) error {
+	if strings.Count(descriptor.Type, "/") != 1 || strings.HasPrefix(descriptor.Type, "/") || strings.HasSuffix(descriptor.Type, "/") {
+		return fmt.Errorf("resource type %q must be in the format `{Service Name}/{Type}`", descriptor.Type)
+	}
+	if len(descriptor.Pattern) == 0 {
+		return fmt.Errorf("resource type %q must have at least one pattern", descriptor.Type)
+	}
+
+	t := &ResourceType{
+		Type:     descriptor.Type,
+		Plural:   descriptor.Plural,
+		Singular: descriptor.Singular,
+	}
+	for _, raw := range descriptor.Pattern {
+		pattern, err := CompilePattern(raw)
+		if err != nil {
+			return err
+		}
+		t.Patterns = append(t.Patterns, pattern)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.types[t.Type] = t
+	return nil
+}
+
+var (
+	defaultRegistry     *Registry
+	defaultRegistryOnce sync.Once
+)
+
+// Default returns the Registry populated with every resource declared in
+// the files of the global proto registry.
+func Default() *Registry {
+	defaultRegistryOnce.Do(func() {
+		defaultRegistry = NewRegistry()
+		protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+			if err := defaultRegistry.RegisterFile(file); err != nil {
+				panic(err)
+			}
+			return true
+		})
+	})
+	return defaultRegistry
+}
+
+// Parse will parse a relative resource name using the default registry.
+func Parse(resourceType, name string, opts ...ParseOption) ([]string, error) {
+	return Default().Parse(resourceType, name, opts...)
+}
+
+// Validate will validate a relative resource name using the default registry.
+func Validate(resourceType, name string, opts ...ParseOption) error {
+	return Default().Validate(resourceType, name, opts...)
+}
+
+// Build will build a relative resource name using the default registry.
+func Build(resourceType string, resourceIDs ...string) (string, error) {
+	return Default().Build(resourceType, resourceIDs...)
+}
+
+// FullName will convert a relative resource name to a full resource name
+// using the default registry.
+func FullName(resourceType, relativeName string) (string, error) {
+	return Default().FullName(resourceType, relativeName)
+}
+
+// ParseFullName will parse a full resource name using the default registry.
+func ParseFullName(fullName string, opts ...ParseOption) (*ResourceType, string, []string, error) {
+	return Default().ParseFullName(fullName, opts...)
+}
+
+// Match will find the resource type and pattern that a relative resource
+// name matches using the default registry.
+func Match(name string, opts ...ParseOption) (*ResourceType, *Pattern, bool) {
+	return Default().Match(name, opts...)
+}
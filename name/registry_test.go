@@ -0,0 +1,97 @@
+package name_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chacerapp/apiserver/name"
+)
+
+func TestRegistryParse(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		name         string
+		opts         []name.ParseOption
+		expected     []string
+		valid        bool
+	}{
+		{name.TypeAccount, "accounts/default", nil, []string{"default"}, true},
+		{name.TypeAccount, "accounts/-", nil, nil, false},
+		{name.TypeAccount, "accounts/-", []name.ParseOption{name.AllowWildcard()}, []string{"-"}, true},
+		{name.TypeAccountQuotas, "accounts/default/quotas", nil, []string{"default"}, true},
+		{name.TypeAccountQuotas, "accounts/default/status", nil, nil, false},
+		{name.TypeAccountSuspension, "accounts/default/suspensions/3f1c1a9e-5b8e-4c1d-9a57-0d8f6f0c2b11", nil, []string{"default", "3f1c1a9e-5b8e-4c1d-9a57-0d8f6f0c2b11"}, true},
+		{name.TypeLocation, "accounts/default/locations/main", nil, []string{"default", "main"}, true},
+		{name.TypeLocation, "accounts/default/locations", nil, nil, false},
+		{name.TypeRoom, "accounts/default/locations/main/rooms/op2", nil, nil, false},
+		{name.TypeRoom, "accounts/default/locations/main/rooms/exam-2", nil, []string{"default", "main", "exam-2"}, true},
+		{name.TypeRoom, "accounts/default/locations/main/rooms/exam--2", nil, nil, false},
+		{name.TypeRoomStateChange, "accounts/default/locations/main/rooms/exam-2/stateChanges/3f1c1a9e-5b8e-4c1d-9a57-0d8f6f0c2b11", nil, []string{"default", "main", "exam-2", "3f1c1a9e-5b8e-4c1d-9a57-0d8f6f0c2b11"}, true},
+		{name.TypeRoomGroup, "accounts/default/locations/main/roomGroups/east-wing", nil, []string{"default", "main", "east-wing"}, true},
+		{name.TypeDevice, "accounts/default/locations/main/devices/front-desk", nil, []string{"default", "main", "front-desk"}, true},
+		{name.TypeScheduledMessage, "accounts/default/locations/main/scheduledMessages/lunch-coverage", nil, []string{"default", "main", "lunch-coverage"}, true},
+		{name.TypeContactGroup, "accounts/default/contactGroups/hygienists", nil, []string{"default", "hygienists"}, true},
+		{name.TypeContactGroup, "accounts/default/contacts/hygienists", nil, nil, false},
+		{name.TypeContactPresenceChange, "accounts/default/contacts/jane-doe/presenceChanges/7d4b2f0e-1c3a-4e8b-9f61-2a5c8d0e4b73", nil, []string{"default", "jane-doe", "7d4b2f0e-1c3a-4e8b-9f61-2a5c8d0e4b73"}, true},
+		{name.TypeMessage, "accounts/default/locations/-/messages/-", []name.ParseOption{name.AllowWildcard()}, []string{"default", "-", "-"}, true},
+	}
+
+	for _, test := range tests {
+		ids, err := name.Parse(test.resourceType, test.name, test.opts...)
+		if test.valid && err != nil {
+			t.Errorf("%s: expected %q to be valid: %v", test.resourceType, test.name, err)
+		} else if !test.valid && err == nil {
+			t.Errorf("%s: expected %q to be invalid", test.resourceType, test.name)
+		} else if !reflect.DeepEqual(ids, test.expected) {
+			t.Errorf("%s: expected %q to parse to %v, got %v", test.resourceType, test.name, test.expected, ids)
+		}
+	}
+}
+
+func TestRegistryFullName(t *testing.T) {
+	room := name.RoomName{Account: "default", Location: "main", Room: "exam-2"}
+
+	fullName, err := name.FullName(name.TypeRoom, room.String())
+	if err != nil {
+		t.Fatal(err)
+	} else if fullName != "//chacerappapis.com/accounts/default/locations/main/rooms/exam-2" {
+		t.Fatalf("unexpected full name %q", fullName)
+	}
+
+	resourceType, relativeName, _, err := name.ParseFullName(fullName)
+	if err != nil {
+		t.Fatal(err)
+	} else if resourceType.Type != name.TypeRoom || relativeName != room.String() {
+		t.Fatalf("expected %s %q, got %s %q", name.TypeRoom, room.String(), resourceType.Type, relativeName)
+	}
+
+	if _, _, _, err := name.ParseFullName("//example.com/accounts/default"); err == nil {
+		t.Fatal("expected a full name with an unknown service to be invalid")
+	}
+}
+
+func TestRegistryMatch(t *testing.T) {
+	resourceType, pattern, ok := name.Match("accounts/default/locations/-", name.AllowWildcard())
+	if !ok {
+		t.Fatal("expected the location name to match")
+	} else if resourceType.Type != name.TypeLocation || pattern.Template() != "accounts/*/locations/*" {
+		t.Fatalf("unexpected match %s %q", resourceType.Type, pattern.Template())
+	}
+
+	if _, _, ok := name.Match("accounts/default/widgets/main"); ok {
+		t.Fatal("expected a name of an unknown type not to match")
+	}
+}
+
+func TestPatternBuild(t *testing.T) {
+	pattern := name.MustCompilePattern("accounts/{account}/locations/{location}")
+	if built, err := pattern.Build("default", "main"); err != nil || built != "accounts/default/locations/main" {
+		t.Fatalf("unexpected result %q: %v", built, err)
+	}
+	if _, err := pattern.Build("default"); err == nil {
+		t.Fatal("expected an error when too few resource IDs are provided")
+	}
+	if _, err := name.CompilePattern("accounts/{account}/locations/{account}"); err == nil {
+		t.Fatal("expected an error for a duplicated variable")
+	}
+}
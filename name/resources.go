@@ -0,0 +1,439 @@
+package name
+
+// The resource types that are declared by the API definitions.
+const (
+	TypeAccount               = "chacerappapis.com/Account"
+	TypeAccountQuotas         = "chacerappapis.com/AccountQuotas"
+	TypeAccountStatus         = "chacerappapis.com/AccountStatus"
+	TypeAccountSuspension     = "chacerappapis.com/AccountSuspension"
+	TypeAuditEvent            = "chacerappapis.com/AuditEvent"
+	TypeContact               = "chacerappapis.com/Contact"
+	TypeContactGroup          = "chacerappapis.com/ContactGroup"
+	TypeContactPresenceChange = "chacerappapis.com/ContactPresenceChange"
+	TypeDevice                = "chacerappapis.com/Device"
+	TypeLocation              = "chacerappapis.com/Location"
+	TypeMessage               = "messenger.chacerappapis.com/Message"
+	TypeRoom                  = "chacerappapis.com/Room"
+	TypeRoomGroup             = "chacerappapis.com/RoomGroup"
+	TypeRoomStateChange       = "chacerappapis.com/RoomStateChange"
+	TypeScheduledMessage      = "messenger.chacerappapis.com/ScheduledMessage"
+	TypeTemplate              = "chacerappapis.com/Template"
+	TypeUser                  = "chacerappapis.com/User"
+)
+
+// mustBuild builds a resource name for one of the typed names below. The
+// number of resource IDs is fixed by each type so an error can only occur
+// when the API definitions and this file disagree.
+func mustBuild(resourceType string, resourceIDs ...string) string {
+	name, err := Build(resourceType, resourceIDs...)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+// AccountName is the resource name of an account.
+type AccountName struct {
+	Account string
+}
+
+// ParseAccountName parses a name in the format `accounts/*`.
+func ParseAccountName(name string, opts ...ParseOption) (AccountName, error) {
+	ids, err := Parse(TypeAccount, name, opts...)
+	if err != nil {
+		return AccountName{}, err
+	}
+	return AccountName{Account: ids[0]}, nil
+}
+
+func (n AccountName) String() string {
+	return mustBuild(TypeAccount, n.Account)
+}
+
+// AccountQuotasName is the resource name of the quotas of an account.
+type AccountQuotasName struct {
+	Account string
+}
+
+// ParseAccountQuotasName parses a name in the format `accounts/*/quotas`.
+func ParseAccountQuotasName(name string, opts ...ParseOption) (AccountQuotasName, error) {
+	ids, err := Parse(TypeAccountQuotas, name, opts...)
+	if err != nil {
+		return AccountQuotasName{}, err
+	}
+	return AccountQuotasName{Account: ids[0]}, nil
+}
+
+func (n AccountQuotasName) String() string {
+	return mustBuild(TypeAccountQuotas, n.Account)
+}
+
+// Parent returns the name of the account the quotas belong to.
+func (n AccountQuotasName) Parent() AccountName {
+	return AccountName{Account: n.Account}
+}
+
+// AccountStatusName is the resource name of the status of an account.
+type AccountStatusName struct {
+	Account string
+}
+
+// ParseAccountStatusName parses a name in the format `accounts/*/status`.
+func ParseAccountStatusName(name string, opts ...ParseOption) (AccountStatusName, error) {
+	ids, err := Parse(TypeAccountStatus, name, opts...)
+	if err != nil {
+		return AccountStatusName{}, err
+	}
+	return AccountStatusName{Account: ids[0]}, nil
+}
+
+func (n AccountStatusName) String() string {
+	return mustBuild(TypeAccountStatus, n.Account)
+}
+
+// Parent returns the name of the account the status belongs to.
+func (n AccountStatusName) Parent() AccountName {
+	return AccountName{Account: n.Account}
+}
+
+// AccountSuspensionName is the resource name of a suspension of an account.
+type AccountSuspensionName struct {
+	Account    string
+	Suspension string
+}
+
+// ParseAccountSuspensionName parses a name in the format `accounts/*/suspensions/*`.
+func ParseAccountSuspensionName(name string, opts ...ParseOption) (AccountSuspensionName, error) {
+	ids, err := Parse(TypeAccountSuspension, name, opts...)
+	if err != nil {
+		return AccountSuspensionName{}, err
+	}
+	return AccountSuspensionName{Account: ids[0], Suspension: ids[1]}, nil
+}
+
+func (n AccountSuspensionName) String() string {
+	return mustBuild(TypeAccountSuspension, n.Account, n.Suspension)
+}
+
+// Parent returns the name of the account that was suspended.
+func (n AccountSuspensionName) Parent() AccountName {
+	return AccountName{Account: n.Account}
+}
+
+// AuditEventName is the resource name of an audit event.
+type AuditEventName struct {
+	AuditEvent string
+}
+
+// ParseAuditEventName parses a name in the format `auditEvents/*`.
+func ParseAuditEventName(name string, opts ...ParseOption) (AuditEventName, error) {
+	ids, err := Parse(TypeAuditEvent, name, opts...)
+	if err != nil {
+		return AuditEventName{}, err
+	}
+	return AuditEventName{AuditEvent: ids[0]}, nil
+}
+
+func (n AuditEventName) String() string {
+	return mustBuild(TypeAuditEvent, n.AuditEvent)
+}
+
+// LocationName is the resource name of a location within an account.
+type LocationName struct {
+	Account  string
+	Location string
+}
+
+// ParseLocationName parses a name in the format `accounts/*/locations/*`.
+func ParseLocationName(name string, opts ...ParseOption) (LocationName, error) {
+	ids, err := Parse(TypeLocation, name, opts...)
+	if err != nil {
+		return LocationName{}, err
+	}
+	return LocationName{Account: ids[0], Location: ids[1]}, nil
+}
+
+func (n LocationName) String() string {
+	return mustBuild(TypeLocation, n.Account, n.Location)
+}
+
+// Parent returns the name of the account the location is in.
+func (n LocationName) Parent() AccountName {
+	return AccountName{Account: n.Account}
+}
+
+// RoomName is the resource name of a room within a location.
+type RoomName struct {
+	Account  string
+	Location string
+	Room     string
+}
+
+// ParseRoomName parses a name in the format `accounts/*/locations/*/rooms/*`.
+func ParseRoomName(name string, opts ...ParseOption) (RoomName, error) {
+	ids, err := Parse(TypeRoom, name, opts...)
+	if err != nil {
+		return RoomName{}, err
+	}
+	return RoomName{Account: ids[0], Location: ids[1], Room: ids[2]}, nil
+}
+
+func (n RoomName) String() string {
+	return mustBuild(TypeRoom, n.Account, n.Location, n.Room)
+}
+
+// Parent returns the name of the location the room is in.
+func (n RoomName) Parent() LocationName {
+	return LocationName{Account: n.Account, Location: n.Location}
+}
+
+// RoomStateChangeName is the resource name of a change to the state of a room.
+type RoomStateChangeName struct {
+	Account     string
+	Location    string
+	Room        string
+	StateChange string
+}
+
+// ParseRoomStateChangeName parses a name in the format `accounts/*/locations/*/rooms/*/stateChanges/*`.
+func ParseRoomStateChangeName(name string, opts ...ParseOption) (RoomStateChangeName, error) {
+	ids, err := Parse(TypeRoomStateChange, name, opts...)
+	if err != nil {
+		return RoomStateChangeName{}, err
+	}
+	return RoomStateChangeName{Account: ids[0], Location: ids[1], Room: ids[2], StateChange: ids[3]}, nil
+}
+
+func (n RoomStateChangeName) String() string {
+	return mustBuild(TypeRoomStateChange, n.Account, n.Location, n.Room, n.StateChange)
+}
+
+// Parent returns the name of the room whose state changed.
+func (n RoomStateChangeName) Parent() RoomName {
+	return RoomName{Account: n.Account, Location: n.Location, Room: n.Room}
+}
+
+// RoomGroupName is the resource name of a group of rooms within a location.
+type RoomGroupName struct {
+	Account   string
+	Location  string
+	RoomGroup string
+}
+
+// ParseRoomGroupName parses a name in the format `accounts/*/locations/*/roomGroups/*`.
+func ParseRoomGroupName(name string, opts ...ParseOption) (RoomGroupName, error) {
+	ids, err := Parse(TypeRoomGroup, name, opts...)
+	if err != nil {
+		return RoomGroupName{}, err
+	}
+	return RoomGroupName{Account: ids[0], Location: ids[1], RoomGroup: ids[2]}, nil
+}
+
+func (n RoomGroupName) String() string {
+	return mustBuild(TypeRoomGroup, n.Account, n.Location, n.RoomGroup)
+}
+
+// Parent returns the name of the location the room group is in.
+func (n RoomGroupName) Parent() LocationName {
+	return LocationName{Account: n.Account, Location: n.Location}
+}
+
+// DeviceName is the resource name of a device registered in a location.
+type DeviceName struct {
+	Account  string
+	Location string
+	Device   string
+}
+
+// ParseDeviceName parses a name in the format `accounts/*/locations/*/devices/*`.
+func ParseDeviceName(name string, opts ...ParseOption) (DeviceName, error) {
+	ids, err := Parse(TypeDevice, name, opts...)
+	if err != nil {
+		return DeviceName{}, err
+	}
+	return DeviceName{Account: ids[0], Location: ids[1], Device: ids[2]}, nil
+}
+
+func (n DeviceName) String() string {
+	return mustBuild(TypeDevice, n.Account, n.Location, n.Device)
+}
+
+// Parent returns the name of the location the device is registered in.
+func (n DeviceName) Parent() LocationName {
+	return LocationName{Account: n.Account, Location: n.Location}
+}
+
+// MessageName is the resource name of a message sent within a location.
+type MessageName struct {
+	Account  string
+	Location string
+	Message  string
+}
+
+// ParseMessageName parses a name in the format `accounts/*/locations/*/messages/*`.
+func ParseMessageName(name string, opts ...ParseOption) (MessageName, error) {
+	ids, err := Parse(TypeMessage, name, opts...)
+	if err != nil {
+		return MessageName{}, err
+	}
+	return MessageName{Account: ids[0], Location: ids[1], Message: ids[2]}, nil
+}
+
+func (n MessageName) String() string {
+	return mustBuild(TypeMessage, n.Account, n.Location, n.Message)
+}
+
+// Parent returns the name of the location the message was sent in.
+func (n MessageName) Parent() LocationName {
+	return LocationName{Account: n.Account, Location: n.Location}
+}
+
+// ScheduledMessageName is the resource name of a message scheduled to be sent
+// within a location.
+type ScheduledMessageName struct {
+	Account          string
+	Location         string
+	ScheduledMessage string
+}
+
+// ParseScheduledMessageName parses a name in the format `accounts/*/locations/*/scheduledMessages/*`.
+func ParseScheduledMessageName(name string, opts ...ParseOption) (ScheduledMessageName, error) {
+	ids, err := Parse(TypeScheduledMessage, name, opts...)
+	if err != nil {
+		return ScheduledMessageName{}, err
+	}
+	return ScheduledMessageName{Account: ids[0], Location: ids[1], ScheduledMessage: ids[2]}, nil
+}
+
+func (n ScheduledMessageName) String() string {
+	return mustBuild(TypeScheduledMessage, n.Account, n.Location, n.ScheduledMessage)
+}
+
+// Parent returns the name of the location the message is sent in.
+func (n ScheduledMessageName) Parent() LocationName {
+	return LocationName{Account: n.Account, Location: n.Location}
+}
+
+// TemplateName is the resource name of a message template within a location.
+type TemplateName struct {
+	Account  string
+	Location string
+	Template string
+}
+
+// ParseTemplateName parses a name in the format `accounts/*/locations/*/templates/*`.
+func ParseTemplateName(name string, opts ...ParseOption) (TemplateName, error) {
+	ids, err := Parse(TypeTemplate, name, opts...)
+	if err != nil {
+		return TemplateName{}, err
+	}
+	return TemplateName{Account: ids[0], Location: ids[1], Template: ids[2]}, nil
+}
+
+func (n TemplateName) String() string {
+	return mustBuild(TypeTemplate, n.Account, n.Location, n.Template)
+}
+
+// Parent returns the name of the location the template is in.
+func (n TemplateName) Parent() LocationName {
+	return LocationName{Account: n.Account, Location: n.Location}
+}
+
+// UserName is the resource name of a user within an account.
+type UserName struct {
+	Account string
+	User    string
+}
+
+// ParseUserName parses a name in the format `accounts/*/users/*`.
+func ParseUserName(name string, opts ...ParseOption) (UserName, error) {
+	ids, err := Parse(TypeUser, name, opts...)
+	if err != nil {
+		return UserName{}, err
+	}
+	return UserName{Account: ids[0], User: ids[1]}, nil
+}
+
+func (n UserName) String() string {
+	return mustBuild(TypeUser, n.Account, n.User)
+}
+
+// Parent returns the name of the account the user is in.
+func (n UserName) Parent() AccountName {
+	return AccountName{Account: n.Account}
+}
+
+// ContactName is the resource name of a contact within an account.
+type ContactName struct {
+	Account string
+	Contact string
+}
+
+// ParseContactName parses a name in the format `accounts/*/contacts/*`.
+func ParseContactName(name string, opts ...ParseOption) (ContactName, error) {
+	ids, err := Parse(TypeContact, name, opts...)
+	if err != nil {
+		return ContactName{}, err
+	}
+	return ContactName{Account: ids[0], Contact: ids[1]}, nil
+}
+
+func (n ContactName) String() string {
+	return mustBuild(TypeContact, n.Account, n.Contact)
+}
+
+// Parent returns the name of the account the contact is in.
+func (n ContactName) Parent() AccountName {
+	return AccountName{Account: n.Account}
+}
+
+// ContactPresenceChangeName is the resource name of a change to the presence
+// of a contact.
+type ContactPresenceChangeName struct {
+	Account        string
+	Contact        string
+	PresenceChange string
+}
+
+// ParseContactPresenceChangeName parses a name in the format `accounts/*/contacts/*/presenceChanges/*`.
+func ParseContactPresenceChangeName(name string, opts ...ParseOption) (ContactPresenceChangeName, error) {
+	ids, err := Parse(TypeContactPresenceChange, name, opts...)
+	if err != nil {
+		return ContactPresenceChangeName{}, err
+	}
+	return ContactPresenceChangeName{Account: ids[0], Contact: ids[1], PresenceChange: ids[2]}, nil
+}
+
+func (n ContactPresenceChangeName) String() string {
+	return mustBuild(TypeContactPresenceChange, n.Account, n.Contact, n.PresenceChange)
+}
+
+// Parent returns the name of the contact whose presence changed.
+func (n ContactPresenceChangeName) Parent() ContactName {
+	return ContactName{Account: n.Account, Contact: n.Contact}
+}
+
+// ContactGroupName is the resource name of a group of contacts within an
+// account.
+type ContactGroupName struct {
+	Account      string
+	ContactGroup string
+}
+
+// ParseContactGroupName parses a name in the format `accounts/*/contactGroups/*`.
+func ParseContactGroupName(name string, opts ...ParseOption) (ContactGroupName, error) {
+	ids, err := Parse(TypeContactGroup, name, opts...)
+	if err != nil {
+		return ContactGroupName{}, err
+	}
+	return ContactGroupName{Account: ids[0], ContactGroup: ids[1]}, nil
+}
+
+func (n ContactGroupName) String() string {
+	return mustBuild(TypeContactGroup, n.Account, n.ContactGroup)
+}
+
+// Parent returns the name of the account the contact group is in.
+func (n ContactGroupName) Parent() AccountName {
+	return AccountName{Account: n.Account}
+}
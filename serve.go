@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/chacerapp/apiserver/metrics"
+	"github.com/chacerapp/apiserver/server"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/chacerapp/apiserver/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func runServe(args []string) error {
+	cfg, err := loadConfig(flag.NewFlagSet("serve", flag.ContinueOnError), args)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	if cfg.TraceExporter != "" {
+		exporter, err := tracing.NewExporter(cfg.TraceExporter)
+		if err != nil {
+			return err
+		}
+		stopTracing := tracing.Start(exporter, cfg.TraceSampleRatio)
+		defer func() {
+			// Flush the spans of the requests that were drained
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := stopTracing(ctx); err != nil {
+				log.Printf("Failed to flush traces: %v", err)
+			}
+		}()
+		log.Printf("Exporting traces to %s", cfg.TraceExporter)
+	}
+
+	log.Print("Opening database connection")
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	log.Print("Checking database schema version")
+	status, err := checkSchema(context.Background(), db)
+	if err != nil {
+		return err
+	}
+	log.Printf("Database schema is at version %d", status.Version)
+
+	var tlsConfig *tls.Config
+	var grpcOpts []grpc.ServerOption
+	if cfg.TLSEnabled() {
+		cert, err := cfg.LoadTLSCertificate()
+		if err != nil {
+			return err
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+		// Clients that connect with a certificate signed by the client CA are
+		// identified by it, while clients without one can still connect
+		if cfg.TLSClientCAFile != "" {
+			if tlsConfig.ClientCAs, err = cfg.LoadClientCAs(); err != nil {
+				return err
+			}
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	storage := store.New(db, store.NewPaginator([]byte(cfg.PaginationSecret)))
+	// The public server handles native gRPC clients, while the internal
+	// server handles requests from the HTTP gateway over an in-memory
+	// connection and gRPC-Web requests, so TLS is only terminated once.
+	health := server.NewHealth(storage, func(ctx context.Context) error {
+		_, err := checkSchema(ctx, db)
+		return err
+	})
+	// The Messenger service can't send scheduled messages while the
+	// dispatcher is failing to find them
+	dispatcher := server.NewDispatcher(storage)
+	health.AddServiceCheck("chacerapp.v1.Messenger", dispatcher.Check)
+	// Both servers share the rate limits so a caller can't get around them
+	// by switching between gRPC and HTTP
+	limiter := server.NewRateLimiter(storage)
+	watchInterval := server.WithWatchInterval(cfg.WatchInterval)
+	grants, err := cfg.LoadPermissions()
+	if err != nil {
+		return err
+	}
+	permissions := server.WithPermissions(grants)
+	public := server.NewGRPCServer(storage, server.WithGRPCOptions(grpcOpts...), server.WithHealth(health), server.WithRateLimiter(limiter), watchInterval, permissions)
+	// Only the gateway connects over the in-memory listener, so the client
+	// addresses it forwards are trusted
+	internalListener := bufconn.Listen(1024 * 1024)
+	internal := server.NewGRPCServer(storage, server.WithGatewayAddr(internalListener.Addr()), server.WithHealth(health), server.WithRateLimiter(limiter), watchInterval, permissions)
+
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	go health.Watch(healthCtx, cfg.HealthCheckInterval)
+
+	// Accounts with temporary suspensions are activated once they expire
+	reactivateCtx, stopReactivate := context.WithCancel(context.Background())
+	defer stopReactivate()
+	go server.NewReactivator(storage).Run(reactivateCtx, cfg.ReactivationInterval)
+
+	// Scheduled messages are sent once they are due
+	dispatchCtx, stopDispatch := context.WithCancel(context.Background())
+	defer stopDispatch()
+	go dispatcher.Run(dispatchCtx, cfg.DispatchInterval)
+
+	// Messages that aren't completed by their expire time are expired
+	expiryCtx, stopExpiry := context.WithCancel(context.Background())
+	defer stopExpiry()
+	go server.NewSweeper(storage).Run(expiryCtx, cfg.ExpiryInterval)
+
+	// Messages that aren't acknowledged in time are escalated
+	escalateCtx, stopEscalate := context.WithCancel(context.Background())
+	defer stopEscalate()
+	go server.NewEscalator(storage).Run(escalateCtx, cfg.EscalationInterval)
+
+	grpcListener, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		return err
+	}
+
+	errs := make(chan error, 4)
+	go func() {
+		log.Printf("Starting gRPC server on %s", grpcListener.Addr())
+		errs <- public.Serve(grpcListener)
+	}()
+	go func() {
+		errs <- internal.Serve(internalListener)
+	}()
+
+	var httpServers []*http.Server
+	if cfg.HTTPAddr != "" {
+		conn, err := grpc.Dial("internal",
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return internalListener.Dial()
+			}),
+			grpc.WithInsecure(),
+		)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		gateway, err := server.NewHTTPHandler(context.Background(), conn)
+		if err != nil {
+			return err
+		}
+
+		httpListener, err := net.Listen("tcp", cfg.HTTPAddr)
+		if err != nil {
+			return err
+		}
+		httpServer := &http.Server{
+			Handler:   server.NewGRPCWebHandler(internal, gateway, cfg.AllowedOrigins),
+			TLSConfig: tlsConfig,
+		}
+		httpServers = append(httpServers, httpServer)
+		go func() {
+			log.Printf("Starting HTTP gateway and gRPC-Web server on %s", httpListener.Addr())
+			var err error
+			if tlsConfig != nil {
+				err = httpServer.ServeTLS(httpListener, "", "")
+			} else {
+				err = httpServer.Serve(httpListener)
+			}
+			if err != http.ErrServerClosed {
+				errs <- err
+			}
+		}()
+	}
+
+	if cfg.MetricsAddr != "" {
+		if err := metrics.RegisterLocationStats(storage); err != nil {
+			return err
+		}
+
+		metricsListener, err := net.Listen("tcp", cfg.MetricsAddr)
+		if err != nil {
+			return err
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		metricsServer := &http.Server{Handler: mux}
+		httpServers = append(httpServers, metricsServer)
+		go func() {
+			log.Printf("Starting metrics server on %s", metricsListener.Addr())
+			if err := metricsServer.Serve(metricsListener); err != http.ErrServerClosed {
+				errs <- err
+			}
+		}()
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	defer signal.Stop(signals)
+
+	select {
+	case sig := <-signals:
+		log.Printf("Received %v, shutting down", sig)
+	case err = <-errs:
+		log.Printf("Server stopped unexpectedly, shutting down: %v", err)
+	}
+
+	// Report that the services are not serving before the servers stop
+	// accepting requests so load balancers can drain them
+	stopHealth()
+	stopReactivate()
+	stopDispatch()
+	stopExpiry()
+	stopEscalate()
+	health.Shutdown()
+	shutdown(cfg, httpServers, public, internal)
+	return err
+}
+
+// shutdown stops accepting new requests and waits for in-flight requests to
+// complete. Any requests that are still running when the shutdown timeout
+// elapses are cancelled.
+func shutdown(cfg *Config, httpServers []*http.Server, grpcServers ...*grpc.Server) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, srv := range httpServers {
+		wg.Add(1)
+		go func(srv *http.Server) {
+			defer wg.Done()
+			if err := srv.Shutdown(ctx); err != nil {
+				srv.Close()
+			}
+		}(srv)
+	}
+	for _, srv := range grpcServers {
+		wg.Add(1)
+		go func(srv *grpc.Server) {
+			defer wg.Done()
+			srv.GracefulStop()
+		}(srv)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		log.Print("All requests drained")
+	case <-ctx.Done():
+		log.Printf("Requests did not drain within %v, closing remaining connections", cfg.ShutdownTimeout)
+		for _, srv := range grpcServers {
+			srv.Stop()
+		}
+	}
+}
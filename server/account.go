@@ -2,19 +2,17 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/chacerapp/apiserver/name"
 	"github.com/chacerapp/apiserver/server/serverpb"
 	"github.com/chacerapp/apiserver/store"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
-var validAccountSuspendedReasons = []string{
-	"Billing", "Fraud",
-}
-
 func (s *server) ListAccounts(ctx context.Context, req *serverpb.ListAccountsRequest) (*serverpb.ListAccountsResponse, error) {
 	// Validate the pagination request
 	pageInfo, err := s.validatePageableRequest(req)
@@ -51,6 +49,11 @@ func (s *server) CreateAccount(ctx context.Context, req *serverpb.CreateAccountR
 
 	account := proto.Clone(req.Account).(*serverpb.Account)
 	account.Name = name.BuildAccount(req.AccountId)
+	account.Status = nil
+	if req.SelfService {
+		// Self-service accounts must be approved before they can be used
+		account.Status = &serverpb.AccountStatus{Phase: serverpb.AccountPhase_ACCOUNT_PHASE_PENDING}
+	}
 
 	if account, err := s.store.CreateAccount(ctx, account); err != nil {
 		return nil, err
@@ -94,17 +97,16 @@ func (s *server) ActivateAccount(ctx context.Context, req *serverpb.ActivateAcco
 		return nil, err
 	}
 
-	if account, err := s.store.GetAccount(ctx, req.Name); err != nil {
-		return nil, err
-	} else if account == nil {
-		return nil, errNotFound
-	} else if account.Status.Phase == serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE {
-		return nil, errFailedPrecondition("account is already active")
+	transition := accountTransition(serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE)
+	end := serverpb.SuspensionEnd_SUSPENSION_END_ACTIVATED
+	if req.SelfService {
+		transition = accountTransition(serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE, selfReactivation)
+		end = serverpb.SuspensionEnd_SUSPENSION_END_SELF_ACTIVATED
 	}
 
 	status, err := s.store.UpdateAccountStatus(ctx, req.Name, &serverpb.AccountStatus{
 		Phase: serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE,
-	})
+	}, transition, store.WithSuspensionEnd(end))
 	if err != nil {
 		return nil, err
 	} else if status == nil {
@@ -118,19 +120,38 @@ func (s *server) SuspendAccount(ctx context.Context, req *serverpb.SuspendAccoun
 		return nil, err
 	}
 
-	if account, err := s.store.GetAccount(ctx, req.Name); err != nil {
+	account, err := s.store.GetAccount(ctx, req.Name)
+	if err != nil {
 		return nil, err
 	} else if account == nil {
 		return nil, errNotFound
-	} else if account.Status.Phase == serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED {
-		return nil, errFailedPrecondition("account is already suspended")
+	}
+
+	// An account that is already suspending had its teardown interrupted, so
+	// the teardown is resumed rather than the suspension being started again
+	if account.Status.Phase != serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING {
+		status, err := s.store.UpdateAccountStatus(ctx, req.Name, &serverpb.AccountStatus{
+			Phase:      serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING,
+			Reason:     req.Reason,
+			Message:    req.Message,
+			ExpireTime: suspensionExpireTime(req, time.Now()),
+		}, accountTransition(serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING))
+		if err != nil {
+			return nil, err
+		} else if status == nil {
+			return nil, errNotFound
+		}
+	}
+
+	if err := s.teardownAccount(ctx, req.Name); err != nil {
+		return nil, err
 	}
 
 	status, err := s.store.UpdateAccountStatus(ctx, req.Name, &serverpb.AccountStatus{
 		Phase:   serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED,
 		Reason:  req.Reason,
 		Message: req.Message,
-	})
+	}, accountTransition(serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED))
 	if err != nil {
 		return nil, err
 	} else if status == nil {
@@ -139,6 +160,26 @@ func (s *server) SuspendAccount(ctx context.Context, req *serverpb.SuspendAccoun
 	return &serverpb.SuspendAccountResponse{}, nil
 }
 
+func (s *server) DeactivateAccount(ctx context.Context, req *serverpb.DeactivateAccountRequest) (*serverpb.DeactivateAccountResponse, error) {
+	if err := validateDeactivateAccount(req); err != nil {
+		return nil, err
+	}
+
+	status, err := s.store.UpdateAccountStatus(ctx, req.Name, &serverpb.AccountStatus{
+		Phase:   serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE,
+		Message: req.Message,
+	}, accountTransition(serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE))
+	if err != nil {
+		return nil, err
+	} else if status == nil {
+		return nil, errNotFound
+	}
+
+	// Disconnect the devices of the account
+	accountChanges.notify()
+	return &serverpb.DeactivateAccountResponse{}, nil
+}
+
 func (s *server) DeleteAccount(ctx context.Context, req *serverpb.DeleteAccountRequest) (*serverpb.Account, error) {
 	if _, err := name.ParseAccount(req.Name); err != nil {
 		return nil, err
@@ -172,7 +213,13 @@ func (s *server) UpdateAccountStatus(ctx context.Context, req *serverpb.UpdateAc
 		return nil, err
 	}
 
-	if updatedStatus, err := s.store.UpdateAccountStatus(ctx, req.AccountStatus.Name, req.AccountStatus); err != nil {
+	if err := validateUpdateAccountStatus(req); err != nil {
+		return nil, err
+	}
+
+	// The phase can only be changed by following the lifecycle of an account
+	update := accountStatusUpdate(req.AccountStatus)
+	if updatedStatus, err := s.store.UpdateAccountStatus(ctx, req.AccountStatus.Name, req.AccountStatus, update); err != nil {
 		return nil, err
 	} else if updatedStatus == nil {
 		return nil, errNotFound
@@ -186,12 +233,12 @@ func (s *server) GetAccountQuotas(ctx context.Context, req *serverpb.GetAccountQ
 		return nil, err
 	}
 
-	if account, err := s.store.GetAccount(ctx, req.Name); err != nil {
+	if quotas, err := s.store.GetAccountQuotas(ctx, req.Name); err != nil {
 		return nil, err
-	} else if account == nil {
+	} else if quotas == nil {
 		return nil, errNotFound
 	} else {
-		return account.GetQuotas(), nil
+		return quotas, nil
 	}
 }
 
@@ -199,14 +246,60 @@ func (s *server) UpdateAccountQuotas(ctx context.Context, req *serverpb.UpdateAc
 	if _, err := name.ParseAccount(req.AccountQuotas.Name); err != nil {
 		return nil, err
 	}
+	if err := validateUpdateAccountQuotas(req); err != nil {
+		return nil, err
+	}
 
 	if updatedQuotas, err := s.store.UpdateAccountQuotas(ctx, req.AccountQuotas.Name, req.AccountQuotas); err != nil {
 		return nil, err
 	} else if updatedQuotas == nil {
 		return nil, errNotFound
 	} else {
-		return updatedQuotas, nil
+		// Apply the new rate limits to the next request for the account
+		s.rateLimiter.invalidate(req.AccountQuotas.Name)
+		return s.GetAccountQuotas(ctx, &serverpb.GetAccountQuotasRequest{Name: req.AccountQuotas.Name})
+	}
+}
+
+func validateUpdateAccountQuotas(req *serverpb.UpdateAccountQuotasRequest) error {
+	var errs field.ErrorList
+	quotasPath := field.NewPath("account_quotas")
+
+	if _, ok := serverpb.RateLimitTier_name[int32(req.AccountQuotas.RateLimitTier)]; !ok {
+		errs = append(errs, field.Invalid(quotasPath.Child("rate_limit_tier"), req.AccountQuotas.RateLimitTier, "invalid rate limit tier"))
+	}
+
+	if req.AccountQuotas.GetLocations() < 0 {
+		errs = append(errs, field.Invalid(quotasPath.Child("locations"), req.AccountQuotas.GetLocations(), "locations must not be negative"))
+	}
+	if req.AccountQuotas.GetDevices() < 0 {
+		errs = append(errs, field.Invalid(quotasPath.Child("devices"), req.AccountQuotas.GetDevices(), "devices must not be negative"))
+	}
+	if req.AccountQuotas.GetRooms() < 0 {
+		errs = append(errs, field.Invalid(quotasPath.Child("rooms"), req.AccountQuotas.GetRooms(), "rooms must not be negative"))
+	}
+
+	methods := map[string]bool{}
+	for i, limit := range req.AccountQuotas.RateLimits {
+		limitPath := quotasPath.Child("rate_limits").Index(i)
+		if limit.Method == "" {
+			errs = append(errs, field.Required(limitPath.Child("method"), "method is required"))
+		} else if limit.Method != "*" && !validMethodName(limit.Method) {
+			errs = append(errs, field.Invalid(limitPath.Child("method"), limit.Method, "method must be the full name of a method or *"))
+		} else if methods[limit.Method] {
+			errs = append(errs, field.Duplicate(limitPath.Child("method"), limit.Method))
+		}
+		methods[limit.Method] = true
+
+		if limit.RequestsPerSecond <= 0 {
+			errs = append(errs, field.Invalid(limitPath.Child("requests_per_second"), limit.RequestsPerSecond, "requests_per_second must be greater than 0"))
+		}
+		if limit.Burst < 1 {
+			errs = append(errs, field.Invalid(limitPath.Child("burst"), limit.Burst, "burst must be at least 1"))
+		}
 	}
+
+	return convertErrorList(errs)
 }
 
 func validateSuspendAccount(req *serverpb.SuspendAccountRequest) error {
@@ -219,15 +312,48 @@ func validateSuspendAccount(req *serverpb.SuspendAccountRequest) error {
 		}
 	}
 
-	validReason := false
-	for i := range validAccountSuspendedReasons {
-		if req.Reason == validAccountSuspendedReasons[i] {
-			validReason = true
-			break
+	if findSuspensionReason(req.Reason) == nil {
+		errs = append(errs, field.NotSupported(field.NewPath("reason"), req.Reason, suspensionReasonCodes()))
+	}
+	if len(req.Message) > 1024 {
+		errs = append(errs, field.Invalid(field.NewPath("message"), req.Message, "message must be between 0 and 1024 characters"))
+	}
+	if req.ExpireTime != nil {
+		if expires, err := ptypes.Timestamp(req.ExpireTime); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("expire_time"), ptypes.TimestampString(req.ExpireTime), err.Error()))
+		} else if !expires.After(time.Now()) {
+			errs = append(errs, field.Invalid(field.NewPath("expire_time"), ptypes.TimestampString(req.ExpireTime), "expire_time must be in the future"))
 		}
 	}
-	if !validReason {
-		errs = append(errs, field.NotSupported(field.NewPath("reason"), req.Reason, validAccountSuspendedReasons))
+
+	return convertErrorList(errs)
+}
+
+func validateUpdateAccountStatus(req *serverpb.UpdateAccountStatusRequest) error {
+	var errs field.ErrorList
+	path := field.NewPath("account_status")
+
+	if _, ok := serverpb.AccountPhase_name[int32(req.AccountStatus.Phase)]; !ok || req.AccountStatus.Phase == serverpb.AccountPhase_ACCOUNT_PHASE_UNSPECIFIED {
+		errs = append(errs, field.Invalid(path.Child("phase"), req.AccountStatus.Phase.String(), "invalid account phase"))
+	}
+	if req.AccountStatus.Reason != "" && findSuspensionReason(req.AccountStatus.Reason) == nil {
+		errs = append(errs, field.NotSupported(path.Child("reason"), req.AccountStatus.Reason, suspensionReasonCodes()))
+	}
+	if len(req.AccountStatus.Message) > 1024 {
+		errs = append(errs, field.Invalid(path.Child("message"), req.AccountStatus.Message, "message must be between 0 and 1024 characters"))
+	}
+
+	return convertErrorList(errs)
+}
+
+func validateDeactivateAccount(req *serverpb.DeactivateAccountRequest) error {
+	var errs field.ErrorList
+	if _, err := name.ParseAccount(req.Name); err != nil {
+		if s, ok := status.FromError(err); ok {
+			errs = append(errs, field.Invalid(field.NewPath("name"), req.Name, s.Message()))
+		} else {
+			return err
+		}
 	}
 	if len(req.Message) > 1024 {
 		errs = append(errs, field.Invalid(field.NewPath("message"), req.Message, "message must be between 0 and 1024 characters"))
@@ -0,0 +1,378 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// auditInterceptor records an audit event for every request that could change
+// a resource. The resource is read before and after the request so the event
+// includes the fields that were changed. A failure to record the event is
+// logged rather than failing a request that has already been handled.
+func auditInterceptor(storage store.Storage, logger *requestLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isWriteMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		resource := requestResourceName(req)
+		var before proto.Message
+		supported := false
+		if resource != "" {
+			before, supported = auditSnapshot(ctx, storage, resource)
+		}
+
+		resp, err := handler(ctx, req)
+
+		event := &serverpb.AuditEvent{
+			Actor:     requestActor(ctx),
+			Method:    info.FullMethod,
+			Resource:  resource,
+			RequestId: RequestID(ctx),
+			Code:      status.Code(err).String(),
+		}
+		if resource == "" {
+			// Requests that create a resource are for the parent until the
+			// resource has been named
+			event.Resource = requestResource(req)
+			if created, ok := resp.(interface{ GetName() string }); ok && err == nil && created.GetName() != "" {
+				event.Resource = created.GetName()
+				supported = true
+			}
+		}
+
+		if err == nil && supported {
+			after, _ := auditSnapshot(ctx, storage, event.Resource)
+			if after == nil && resource == "" {
+				after, _ = resp.(proto.Message)
+			}
+			changes, diffErr := diffResources(before, after)
+			if diffErr != nil {
+				logger.write(auditErrorEntry(ctx, info.FullMethod, diffErr))
+			}
+			event.Changes = changes
+		}
+
+		if _, recordErr := storage.CreateAuditEvent(ctx, event); recordErr != nil {
+			logger.write(auditErrorEntry(ctx, info.FullMethod, recordErr))
+		}
+		return resp, err
+	}
+}
+
+func auditErrorEntry(ctx context.Context, method string, err error) *logEntry {
+	entry := newLogEntry(ctx, "error", "failed to record audit event", method)
+	entry.Error = err.Error()
+	return entry
+}
+
+// requestResourceName returns the name of the resource a request changes,
+// which is the name of the request or the name of the resource in the request
+// body. An empty string is returned when the resource doesn't have a name yet.
+func requestResourceName(req interface{}) string {
+	if r, ok := req.(interface{ GetName() string }); ok && r.GetName() != "" {
+		return r.GetName()
+	}
+
+	// Update requests hold the resource being updated, e.g. UpdateRoomRequest.room
+	msg, ok := req.(protoreflect.ProtoMessage)
+	if !ok {
+		return ""
+	}
+	reflected := msg.ProtoReflect()
+	fields := reflected.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !reflected.Has(fd) {
+			continue
+		}
+		nested := reflected.Get(fd).Message()
+		if nameField := nested.Descriptor().Fields().ByName("name"); nameField != nil && nameField.Kind() == protoreflect.StringKind {
+			if resourceName := nested.Get(nameField).String(); resourceName != "" {
+				return resourceName
+			}
+		}
+	}
+	return ""
+}
+
+// auditSnapshot reads the current state of the resource. False is returned
+// when the state of the resource type can't be read, rather than the resource
+// not existing, so the changes to it won't be recorded.
+func auditSnapshot(ctx context.Context, storage store.Storage, resource string) (proto.Message, bool) {
+	resourceType, _, ok := name.Match(resource)
+	if !ok {
+		return nil, false
+	}
+
+	var snapshot proto.Message
+	var err error
+	switch resourceType.Type {
+	case name.TypeAccount, name.TypeAccountStatus, name.TypeAccountQuotas:
+		var account *serverpb.Account
+		if account, err = storage.GetAccount(ctx, resourceAccount(resource)); account == nil {
+			break
+		}
+		// The status and quotas are updated through the account name
+		snapshot = account
+		switch resourceType.Type {
+		case name.TypeAccountStatus:
+			snapshot = account.Status
+		case name.TypeAccountQuotas:
+			snapshot = account.Quotas
+		}
+	case name.TypeContact:
+		// The contact includes its presence, so check-ins are recorded as
+		// changes to it
+		var contact *serverpb.Contact
+		if contact, err = storage.GetContact(ctx, resource); contact != nil {
+			snapshot = contact
+		}
+	case name.TypeContactGroup:
+		var group *serverpb.ContactGroup
+		if group, err = storage.GetContactGroup(ctx, resource); group != nil {
+			snapshot = group
+		}
+	case name.TypeDevice:
+		var device *serverpb.Device
+		if device, err = storage.GetDevice(ctx, resource); device != nil {
+			snapshot = device
+		}
+	case name.TypeLocation:
+		var location *serverpb.Location
+		if location, err = storage.GetLocation(ctx, resource); location != nil {
+			snapshot = location
+		}
+	case name.TypeMessage:
+		var message *serverpb.Message
+		if message, err = storage.GetMessage(ctx, resource); message != nil {
+			snapshot = message
+		}
+	case name.TypeRoom:
+		var room *serverpb.Room
+		if room, err = storage.GetRoom(ctx, resource); room != nil {
+			snapshot = room
+		}
+	case name.TypeRoomGroup:
+		var group *serverpb.RoomGroup
+		if group, err = storage.GetRoomGroup(ctx, resource); group != nil {
+			snapshot = group
+		}
+	case name.TypeScheduledMessage:
+		var scheduled *serverpb.ScheduledMessage
+		if scheduled, err = storage.GetScheduledMessage(ctx, resource); scheduled != nil {
+			snapshot = scheduled
+		}
+	default:
+		return nil, false
+	}
+	return snapshot, err == nil
+}
+
+// diffResources returns the fields that are different between two versions of
+// a resource. Either version can be nil for a resource that was created or
+// deleted. Repeated fields are compared as a whole.
+func diffResources(before, after proto.Message) ([]*serverpb.AuditFieldChange, error) {
+	beforeFields, err := resourceFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := resourceFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*serverpb.AuditFieldChange
+	err = diffFields("", beforeFields, afterFields, &changes)
+	return changes, err
+}
+
+// resourceFields converts the resource to a map of its fields, keyed by the
+// names of the fields in the proto definitions.
+func resourceFields(msg proto.Message) (map[string]interface{}, error) {
+	if msg == nil || reflect.ValueOf(msg).IsNil() {
+		return nil, nil
+	}
+	raw, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(msg)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	err = json.Unmarshal([]byte(raw), &fields)
+	return fields, err
+}
+
+func diffFields(prefix string, before, after map[string]interface{}, changes *[]*serverpb.AuditFieldChange) error {
+	keys := map[string]bool{}
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		beforeValue, hadBefore := before[key]
+		afterValue, hasAfter := after[key]
+
+		// Nested messages are compared field by field
+		beforeMessage, beforeIsMessage := beforeValue.(map[string]interface{})
+		afterMessage, afterIsMessage := afterValue.(map[string]interface{})
+		if (beforeIsMessage || !hadBefore) && (afterIsMessage || !hasAfter) {
+			if err := diffFields(path, beforeMessage, afterMessage, changes); err != nil {
+				return err
+			}
+			continue
+		}
+		if reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+
+		change := &serverpb.AuditFieldChange{Path: path}
+		var err error
+		if hadBefore {
+			if change.Before, err = structpb.NewValue(beforeValue); err != nil {
+				return err
+			}
+		}
+		if hasAfter {
+			if change.After, err = structpb.NewValue(afterValue); err != nil {
+				return err
+			}
+		}
+		*changes = append(*changes, change)
+	}
+	return nil
+}
+
+// certificateIdentity returns the common name of the verified client
+// certificate the caller connected with, or an empty string when they didn't
+// connect with one.
+func certificateIdentity(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if chains := info.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+				return chains[0][0].Subject.CommonName
+			}
+		}
+	}
+	return ""
+}
+
+// requestActor returns the caller that made the request. The common name of
+// a verified client certificate is used when the caller has one, otherwise the
+// address of the caller is used. The address is only taken from the
+// x-forwarded-for metadata for requests made through the HTTP gateway, so a
+// client can't record a different actor by sending it.
+func requestActor(ctx context.Context) string {
+	if identity := certificateIdentity(ctx); identity != "" {
+		return identity
+	}
+
+	caller := requestCaller(ctx)
+	if host, _, err := net.SplitHostPort(caller); err == nil {
+		caller = host
+	}
+	return caller
+}
+
+func (s *server) ListAuditEvents(ctx context.Context, req *serverpb.ListAuditEventsRequest) (*serverpb.ListAuditEventsResponse, error) {
+	filter, err := validateListAuditEvents(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(auditEventsPage{req})
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.store.ListAuditEvents(ctx, filter, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(events) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListAuditEventsResponse{
+		AuditEvents:   events,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// auditEventsPage exposes the filters of a ListAuditEventsRequest as a single
+// filter so they can't be changed between pages.
+type auditEventsPage struct {
+	*serverpb.ListAuditEventsRequest
+}
+
+func (p auditEventsPage) GetFilter() string {
+	return fmt.Sprintf("%q %q %s %s",
+		p.ResourcePrefix,
+		p.Actor,
+		ptypes.TimestampString(p.StartTime),
+		ptypes.TimestampString(p.EndTime),
+	)
+}
+
+func validateListAuditEvents(req *serverpb.ListAuditEventsRequest) (store.AuditEventFilter, error) {
+	var errs field.ErrorList
+	filter := store.AuditEventFilter{
+		ResourcePrefix: req.ResourcePrefix,
+		Actor:          req.Actor,
+	}
+
+	var err error
+	if req.StartTime != nil {
+		if filter.StartTime, err = ptypes.Timestamp(req.StartTime); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("start_time"), ptypes.TimestampString(req.StartTime), err.Error()))
+		}
+	}
+	if req.EndTime != nil {
+		if filter.EndTime, err = ptypes.Timestamp(req.EndTime); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("end_time"), ptypes.TimestampString(req.EndTime), err.Error()))
+		}
+	}
+	if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() && !filter.EndTime.After(filter.StartTime) {
+		errs = append(errs, field.Invalid(field.NewPath("end_time"), ptypes.TimestampString(req.EndTime), "end_time must be after start_time"))
+	}
+
+	return filter, convertErrorList(errs)
+}
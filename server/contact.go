@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *server) ListContacts(ctx context.Context, req *serverpb.ListContactsRequest) (*serverpb.ListContactsResponse, error) {
+	if _, err := name.ParseAccountName(req.Parent); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := s.store.ListContacts(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(contacts) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListContactsResponse{
+		Contacts:      contacts,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *server) CreateContact(ctx context.Context, req *serverpb.CreateContactRequest) (*serverpb.Contact, error) {
+	if err := validateCreateContact(req); err != nil {
+		return nil, err
+	}
+
+	accountName, err := name.ParseAccountName(req.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the parent is accurate by looking up the account
+	if account, err := s.store.GetAccount(ctx, req.Parent); err != nil {
+		return nil, err
+	} else if account == nil {
+		return nil, errNotFound
+	}
+
+	// Use the requested contact ID, otherwise generate one from the display
+	// name. A generated ID is retried when it collides with an existing contact
+	// since the caller didn't ask for that ID.
+	for attempt := 0; attempt < generatedIDAttempts; attempt++ {
+		contactID := req.ContactId
+		if contactID == "" {
+			var err error
+			if contactID, err = generateResourceID(req.Contact.DisplayName, "contact"); err != nil {
+				return nil, err
+			}
+		}
+		req.Contact.Name = name.ContactName{Account: accountName.Account, Contact: contactID}.String()
+
+		contact, err := s.store.CreateContact(ctx, req.Contact)
+		if err != nil {
+			return nil, err
+		} else if contact != nil {
+			return contact, nil
+		} else if req.ContactId != "" {
+			break
+		}
+	}
+
+	return nil, errAlreadyExists
+}
+
+func (s *server) GetContact(ctx context.Context, req *serverpb.GetContactRequest) (*serverpb.Contact, error) {
+	if _, err := name.ParseContactName(req.Name); err != nil {
+		return nil, err
+	}
+
+	contact, err := s.store.GetContact(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	} else if contact == nil {
+		return nil, errNotFound
+	}
+
+	return contact, nil
+}
+
+func (s *server) UpdateContact(ctx context.Context, req *serverpb.UpdateContactRequest) (*serverpb.Contact, error) {
+	if err := validateUpdateContact(req); err != nil {
+		return nil, err
+	}
+
+	if contact, err := s.store.UpdateContact(ctx, req.Contact, store.WithUpdateMask(req.UpdateMask)); err != nil {
+		return nil, err
+	} else if contact == nil {
+		return nil, errNotFound
+	} else {
+		return contact, nil
+	}
+}
+
+func (s *server) DeleteContact(ctx context.Context, req *serverpb.DeleteContactRequest) (*serverpb.Contact, error) {
+	if _, err := name.ParseContactName(req.Name); err != nil {
+		return nil, err
+	}
+
+	if contact, err := s.store.DeleteContact(ctx, req.Name); err != nil {
+		return nil, err
+	} else if contact == nil {
+		return nil, errNotFound
+	} else {
+		return contact, nil
+	}
+}
+
+func validateCreateContact(req *serverpb.CreateContactRequest) error {
+	var errs field.ErrorList
+	if _, err := name.ParseAccountName(req.Parent); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("parent"), req.Parent, err.Error()))
+	}
+
+	// The contact ID is optional, one is generated when it isn't provided
+	if req.ContactId != "" && !name.ValidResourceID(req.ContactId) {
+		errs = append(errs, field.Invalid(field.NewPath("contact_id"), req.ContactId, "invalid contact ID"))
+	}
+	if req.Contact == nil {
+		errs = append(errs, field.Required(field.NewPath("contact"), "contact is required"))
+	} else {
+		if req.Contact.DisplayName == "" {
+			errs = append(errs, field.Required(field.NewPath("contact", "display_name"), "display_name is required"))
+		}
+		errs = append(errs, validateContact(req.Contact)...)
+	}
+	return convertErrorList(errs)
+}
+
+func validateUpdateContact(req *serverpb.UpdateContactRequest) error {
+	path := field.NewPath("contact")
+	if req.Contact == nil {
+		return convertErrorList(field.ErrorList{field.Required(path, "contact is required")})
+	}
+
+	if _, err := name.ParseContactName(req.Contact.Name); err != nil {
+		return convertErrorList(field.ErrorList{field.Invalid(path.Child("name"), req.Contact.Name, err.Error())})
+	}
+	return convertErrorList(validateContact(req.Contact))
+}
+
+// validateContact validates the settable fields of a contact.
+func validateContact(contact *serverpb.Contact) field.ErrorList {
+	path := field.NewPath("contact")
+
+	var errs field.ErrorList
+	errs = append(errs, validateDisplayName(path, contact)...)
+	errs = append(errs, validateLabels(path, contact)...)
+	errs = append(errs, validateAnnotations(path, contact)...)
+	return errs
+}
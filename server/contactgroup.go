@@ -0,0 +1,267 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/ptypes/empty"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The most contacts a contact group can have.
+const maxContactGroupMembers = 100
+
+func (s *server) ListContactGroups(ctx context.Context, req *serverpb.ListContactGroupsRequest) (*serverpb.ListContactGroupsResponse, error) {
+	if _, err := name.ParseAccountName(req.Parent); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.store.ListContactGroups(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(groups) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListContactGroupsResponse{
+		ContactGroups: groups,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *server) CreateContactGroup(ctx context.Context, req *serverpb.CreateContactGroupRequest) (*serverpb.ContactGroup, error) {
+	if err := validateCreateContactGroup(req); err != nil {
+		return nil, err
+	}
+
+	// Validate the parent is accurate by looking up the account
+	if account, err := s.store.GetAccount(ctx, req.Parent); err != nil {
+		return nil, err
+	} else if account == nil {
+		return nil, errNotFound
+	}
+
+	// Set the name of the group based on the provided ID
+	req.ContactGroup.Name = name.BuildRelativeName(req.Parent, name.CollectionContactGroups, req.ContactGroupId)
+
+	group, err := s.store.CreateContactGroup(ctx, req.ContactGroup)
+	if err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errAlreadyExists
+	}
+
+	return group, nil
+}
+
+func (s *server) GetContactGroup(ctx context.Context, req *serverpb.GetContactGroupRequest) (*serverpb.ContactGroup, error) {
+	if _, err := name.ParseContactGroupName(req.Name); err != nil {
+		return nil, err
+	}
+
+	group, err := s.store.GetContactGroup(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errNotFound
+	}
+
+	return group, nil
+}
+
+func (s *server) UpdateContactGroup(ctx context.Context, req *serverpb.UpdateContactGroupRequest) (*serverpb.ContactGroup, error) {
+	if err := validateUpdateContactGroup(req); err != nil {
+		return nil, err
+	}
+
+	if group, err := s.store.UpdateContactGroup(ctx, req.ContactGroup, store.WithUpdateMask(req.UpdateMask)); err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errNotFound
+	} else {
+		return group, nil
+	}
+}
+
+func (s *server) DeleteContactGroup(ctx context.Context, req *serverpb.DeleteContactGroupRequest) (*empty.Empty, error) {
+	if _, err := name.ParseContactGroupName(req.Name); err != nil {
+		return nil, err
+	}
+
+	if group, err := s.store.DeleteContactGroup(ctx, req.Name); err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errNotFound
+	} else {
+		return &empty.Empty{}, nil
+	}
+}
+
+func (s *server) AddContactGroupMembers(ctx context.Context, req *serverpb.AddContactGroupMembersRequest) (*serverpb.ContactGroup, error) {
+	if err := validateContactGroupMembers(req.Name, req.Members); err != nil {
+		return nil, err
+	}
+
+	group, err := s.store.AddContactGroupMembers(ctx, req.Name, req.Members, maxContactGroupMembers)
+	var fullErr *store.ContactGroupFullError
+	if errors.As(err, &fullErr) {
+		return nil, errFailedPrecondition(fmt.Sprintf("a contact group can not have more than %d members", fullErr.Limit))
+	} else if err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errNotFound
+	}
+
+	return group, nil
+}
+
+func (s *server) RemoveContactGroupMembers(ctx context.Context, req *serverpb.RemoveContactGroupMembersRequest) (*serverpb.ContactGroup, error) {
+	if err := validateContactGroupMembers(req.Name, req.Members); err != nil {
+		return nil, err
+	}
+
+	group, err := s.store.RemoveContactGroupMembers(ctx, req.Name, req.Members)
+	if err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errNotFound
+	}
+
+	return group, nil
+}
+
+// checkMessageRecipient returns an InvalidArgument error when the recipient
+// of a message is a contact or contact group that does not exist.
+func (s *server) checkMessageRecipient(ctx context.Context, path *field.Path, message *serverpb.Message) error {
+	if message.Recipient == "" {
+		return nil
+	}
+
+	if _, err := name.ParseContactGroupName(message.Recipient); err == nil {
+		group, err := s.store.GetContactGroup(ctx, message.Recipient)
+		if err != nil {
+			return err
+		} else if group == nil {
+			return convertErrorList(field.ErrorList{field.Invalid(path.Child("recipient"), message.Recipient, "contact group does not exist")})
+		}
+		return nil
+	}
+
+	contact, err := s.store.GetContact(ctx, message.Recipient)
+	if err != nil {
+		return err
+	} else if contact == nil {
+		return convertErrorList(field.ErrorList{field.Invalid(path.Child("recipient"), message.Recipient, "contact does not exist")})
+	}
+	return nil
+}
+
+// messageRecipients returns the contacts a message is delivered to, which are
+// the members of the contact group it is sent to at the time it is sent. A
+// NotFound error is returned when the contact group does not exist.
+func (s *server) messageRecipients(ctx context.Context, message *serverpb.Message) ([]string, error) {
+	if message.Recipient == "" {
+		return nil, nil
+	} else if _, err := name.ParseContactGroupName(message.Recipient); err != nil {
+		return []string{message.Recipient}, nil
+	}
+
+	group, err := s.store.GetContactGroup(ctx, message.Recipient)
+	if err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errNotFound
+	}
+	return group.Members, nil
+}
+
+func validateCreateContactGroup(req *serverpb.CreateContactGroupRequest) error {
+	var errs field.ErrorList
+	if _, err := name.ParseAccountName(req.Parent); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("parent"), req.Parent, err.Error()))
+	}
+	if !name.ValidResourceID(req.ContactGroupId) {
+		errs = append(errs, field.Invalid(field.NewPath("contact_group_id"), req.ContactGroupId, "invalid contact group ID"))
+	}
+	if req.ContactGroup == nil {
+		errs = append(errs, field.Required(field.NewPath("contact_group"), "contact_group is required"))
+	} else {
+		if req.ContactGroup.DisplayName == "" {
+			errs = append(errs, field.Required(field.NewPath("contact_group", "display_name"), "display_name is required"))
+		}
+		errs = append(errs, validateContactGroup(req.ContactGroup)...)
+	}
+	return convertErrorList(errs)
+}
+
+func validateUpdateContactGroup(req *serverpb.UpdateContactGroupRequest) error {
+	path := field.NewPath("contact_group")
+	if req.ContactGroup == nil {
+		return convertErrorList(field.ErrorList{field.Required(path, "contact_group is required")})
+	}
+
+	if _, err := name.ParseContactGroupName(req.ContactGroup.Name); err != nil {
+		return convertErrorList(field.ErrorList{field.Invalid(path.Child("name"), req.ContactGroup.Name, err.Error())})
+	}
+	return convertErrorList(validateContactGroup(req.ContactGroup))
+}
+
+// validateContactGroup validates the settable fields of a contact group.
+func validateContactGroup(group *serverpb.ContactGroup) field.ErrorList {
+	path := field.NewPath("contact_group")
+
+	var errs field.ErrorList
+	errs = append(errs, validateDisplayName(path, group)...)
+	errs = append(errs, validateDescription(path, group)...)
+	return errs
+}
+
+// validateContactGroupMembers validates the contacts being added to or
+// removed from a group. Each contact can only be listed once and must be in
+// the account of the group.
+func validateContactGroupMembers(group string, members []string) error {
+	var errs field.ErrorList
+	groupName, err := name.ParseContactGroupName(group)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("name"), group, err.Error()))
+	}
+
+	path := field.NewPath("members")
+	if len(members) == 0 {
+		errs = append(errs, field.Required(path, "members is required"))
+	} else if len(members) > maxContactGroupMembers {
+		errs = append(errs, field.TooMany(path, len(members), maxContactGroupMembers))
+	}
+
+	seen := map[string]bool{}
+	for i, member := range members {
+		if contactName, err := name.ParseContactName(member); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), member, err.Error()))
+		} else if groupName.Account != "" && contactName.Account != groupName.Account {
+			errs = append(errs, field.Invalid(path.Index(i), member, "contact must be in the account of the group"))
+		} else if seen[member] {
+			errs = append(errs, field.Invalid(path.Index(i), member, "contact is listed more than once"))
+		}
+		seen[member] = true
+	}
+	return convertErrorList(errs)
+}
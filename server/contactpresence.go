@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *server) CheckIn(ctx context.Context, req *serverpb.CheckInRequest) (*serverpb.ContactPresence, error) {
+	if err := validateCheckIn(req); err != nil {
+		return nil, err
+	}
+
+	if location, err := s.store.GetLocation(ctx, req.Location); err != nil {
+		return nil, err
+	} else if location == nil {
+		return nil, convertErrorList(field.ErrorList{field.Invalid(field.NewPath("location"), req.Location, "location does not exist")})
+	}
+	if err := s.authorizePresenceChange(ctx, req.Name, req.Location); err != nil {
+		return nil, err
+	}
+
+	return s.setContactPresence(ctx, req.Name, serverpb.PresenceState_PRESENCE_STATE_CHECKED_IN, req.Location)
+}
+
+func (s *server) CheckOut(ctx context.Context, req *serverpb.CheckOutRequest) (*serverpb.ContactPresence, error) {
+	if err := validateCheckOut(req); err != nil {
+		return nil, err
+	}
+
+	// A device can only check out the contacts that are checked in at its
+	// location
+	presence, err := s.store.GetContactPresence(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	var location string
+	if presence.GetState() == serverpb.PresenceState_PRESENCE_STATE_CHECKED_IN {
+		location = presence.Location
+	}
+	if err := s.authorizePresenceChange(ctx, req.Name, location); err != nil {
+		return nil, err
+	}
+
+	state := req.State
+	if state == serverpb.PresenceState_PRESENCE_STATE_UNSPECIFIED {
+		state = serverpb.PresenceState_PRESENCE_STATE_AWAY
+	}
+	return s.setContactPresence(ctx, req.Name, state, "")
+}
+
+// authorizePresenceChange returns a PermissionDenied error unless the caller
+// is the contact or a device registered at the location. Callers are
+// identified by the common name of their client certificate, which is the
+// resource name of the contact or device it was issued to.
+func (s *server) authorizePresenceChange(ctx context.Context, contact, location string) error {
+	identity := certificateIdentity(ctx)
+	if identity != "" && identity == contact {
+		return nil
+	}
+
+	if device, err := name.ParseDeviceName(identity); err == nil && location != "" && device.Parent().String() == location {
+		if existing, err := s.store.GetDevice(ctx, identity); err != nil {
+			return err
+		} else if existing != nil {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "the presence of %s can only be changed by the contact or a device at its location", contact)
+}
+
+// setContactPresence changes the presence of a contact on behalf of the
+// caller, returning NotFound when the contact does not exist.
+func (s *server) setContactPresence(ctx context.Context, contact string, state serverpb.PresenceState, location string) (*serverpb.ContactPresence, error) {
+	presence, err := s.store.SetContactPresence(ctx, contact, state, location, requestActor(ctx))
+	if err != nil {
+		return nil, err
+	} else if presence == nil {
+		return nil, errNotFound
+	}
+	return presence, nil
+}
+
+func (s *server) ListContactPresenceChanges(ctx context.Context, req *serverpb.ListContactPresenceChangesRequest) (*serverpb.ListContactPresenceChangesResponse, error) {
+	if _, err := name.ParseContactName(req.Parent); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.store.ListContactPresenceChanges(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(changes) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListContactPresenceChangesResponse{
+		ContactPresenceChanges: changes,
+		NextPageToken:          nextPageToken,
+	}, nil
+}
+
+// checkRecipientPresence checks that the recipient of a message is checked in
+// at the location it's sent to, or for a contact group that at least one of
+// its members is. Depending on the presence check of the location, a warning
+// for the message or a FailedPrecondition error is returned when they aren't.
+func (s *server) checkRecipientPresence(ctx context.Context, locationName string, message *serverpb.Message) ([]string, error) {
+	if message.Recipient == "" {
+		return nil, nil
+	}
+
+	location, err := s.store.GetLocation(ctx, locationName)
+	if err != nil {
+		return nil, err
+	} else if location == nil {
+		return nil, errNotFound
+	} else if location.PresenceCheck == serverpb.PresenceCheck_PRESENCE_CHECK_NONE {
+		return nil, nil
+	}
+
+	contacts, err := s.messageRecipients(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contact := range contacts {
+		presence, err := s.store.GetContactPresence(ctx, contact)
+		if err != nil {
+			return nil, err
+		}
+		if presence != nil && presence.State == serverpb.PresenceState_PRESENCE_STATE_CHECKED_IN && presence.Location == locationName {
+			return nil, nil
+		}
+	}
+
+	msg := fmt.Sprintf("%s is not checked in at %s", message.Recipient, locationName)
+	if len(contacts) != 1 || contacts[0] != message.Recipient {
+		msg = fmt.Sprintf("no member of %s is checked in at %s", message.Recipient, locationName)
+	}
+	if location.PresenceCheck == serverpb.PresenceCheck_PRESENCE_CHECK_REQUIRE {
+		return nil, errFailedPrecondition(msg)
+	}
+	return []string{msg}, nil
+}
+
+func validateCheckIn(req *serverpb.CheckInRequest) error {
+	var errs field.ErrorList
+	contactName, err := name.ParseContactName(req.Name)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("name"), req.Name, err.Error()))
+	}
+	if req.Location == "" {
+		errs = append(errs, field.Required(field.NewPath("location"), "location is required"))
+	} else if locationName, err := name.ParseLocationName(req.Location); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("location"), req.Location, err.Error()))
+	} else if contactName.Account != "" && locationName.Account != contactName.Account {
+		errs = append(errs, field.Invalid(field.NewPath("location"), req.Location, "location must be in the account of the contact"))
+	}
+	return convertErrorList(errs)
+}
+
+func validateCheckOut(req *serverpb.CheckOutRequest) error {
+	var errs field.ErrorList
+	if _, err := name.ParseContactName(req.Name); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("name"), req.Name, err.Error()))
+	}
+	switch req.State {
+	case serverpb.PresenceState_PRESENCE_STATE_UNSPECIFIED, serverpb.PresenceState_PRESENCE_STATE_AWAY, serverpb.PresenceState_PRESENCE_STATE_OFF_DUTY:
+	default:
+		errs = append(errs, field.NotSupported(field.NewPath("state"), req.State.String(), []string{
+			serverpb.PresenceState_PRESENCE_STATE_AWAY.String(),
+			serverpb.PresenceState_PRESENCE_STATE_OFF_DUTY.String(),
+		}))
+	}
+	return convertErrorList(errs)
+}
+
+// validatePresenceCheck validates the presence check of a location.
+func validatePresenceCheck(path *field.Path, check serverpb.PresenceCheck) field.ErrorList {
+	if _, ok := serverpb.PresenceCheck_name[int32(check)]; !ok {
+		return field.ErrorList{field.NotSupported(path, check.String(), []string{
+			serverpb.PresenceCheck_PRESENCE_CHECK_NONE.String(),
+			serverpb.PresenceCheck_PRESENCE_CHECK_WARN.String(),
+			serverpb.PresenceCheck_PRESENCE_CHECK_REQUIRE.String(),
+		})}
+	}
+	return nil
+}
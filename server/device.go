@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/ptypes/empty"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *server) ListDevices(ctx context.Context, req *serverpb.ListDevicesRequest) (*serverpb.ListDevicesResponse, error) {
+	if _, _, err := name.ParseLocation(req.Parent); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := s.store.ListDevices(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(devices) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListDevicesResponse{
+		Devices:       devices,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *server) RegisterDevice(ctx context.Context, req *serverpb.RegisterDeviceRequest) (*serverpb.Device, error) {
+	if err := validateRegisterDevice(req); err != nil {
+		return nil, err
+	}
+
+	// Validate the parent is accurate by looking up the location
+	if location, err := s.store.GetLocation(ctx, req.Parent); err != nil {
+		return nil, err
+	} else if location == nil {
+		return nil, errNotFound
+	}
+
+	// Set the name of the device based on the provided ID
+	req.Device.Name = name.BuildRelativeName(req.Parent, name.CollectionDevices, req.DeviceId)
+
+	device, err := s.store.CreateDevice(ctx, req.Device)
+	if err != nil {
+		return nil, convertQuotaError(err)
+	} else if device == nil {
+		return nil, errAlreadyExists
+	}
+
+	return device, nil
+}
+
+func (s *server) GetDevice(ctx context.Context, req *serverpb.GetDeviceRequest) (*serverpb.Device, error) {
+	if _, err := name.ParseDeviceName(req.Name); err != nil {
+		return nil, err
+	}
+
+	device, err := s.store.GetDevice(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	} else if device == nil {
+		return nil, errNotFound
+	}
+
+	return device, nil
+}
+
+func (s *server) UnregisterDevice(ctx context.Context, req *serverpb.UnregisterDeviceRequest) (*empty.Empty, error) {
+	if _, err := name.ParseDeviceName(req.Name); err != nil {
+		return nil, err
+	}
+
+	if device, err := s.store.DeleteDevice(ctx, req.Name); err != nil {
+		return nil, err
+	} else if device == nil {
+		return nil, errNotFound
+	} else {
+		return &empty.Empty{}, nil
+	}
+}
+
+func validateRegisterDevice(req *serverpb.RegisterDeviceRequest) error {
+	var errs field.ErrorList
+	if _, err := name.ParseLocationName(req.Parent); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("parent"), req.Parent, err.Error()))
+	}
+	if !name.ValidResourceID(req.DeviceId) {
+		errs = append(errs, field.Invalid(field.NewPath("device_id"), req.DeviceId, "invalid device ID"))
+	}
+	if req.Device == nil {
+		errs = append(errs, field.Required(field.NewPath("device"), "device is required"))
+	} else {
+		errs = append(errs, validateDisplayName(field.NewPath("device"), req.Device)...)
+	}
+	return convertErrorList(errs)
+}
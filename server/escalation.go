@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The most steps an escalation policy can have.
+const maxEscalationSteps = 10
+
+// validateEscalationPolicy validates the steps of an escalation policy. Each
+// step must wait longer than the step before it and have the fields its
+// action needs, and messages can only be redirected to contacts in the
+// account.
+func validateEscalationPolicy(path *field.Path, account string, policy *serverpb.EscalationPolicy) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	stepsPath := path.Child("steps")
+	if len(policy.Steps) > maxEscalationSteps {
+		errs = append(errs, field.TooMany(stepsPath, len(policy.Steps), maxEscalationSteps))
+	}
+
+	// The delay of the last step with a valid delay
+	var previous time.Duration
+	for i, step := range policy.Steps {
+		stepPath := stepsPath.Index(i)
+		if step.Delay == nil {
+			errs = append(errs, field.Required(stepPath.Child("delay"), "delay is required"))
+		} else if delay, err := ptypes.Duration(step.Delay); err != nil || delay <= 0 {
+			errs = append(errs, field.Invalid(stepPath.Child("delay"), step.Delay.String(), "delay must be positive"))
+		} else if delay <= previous {
+			errs = append(errs, field.Invalid(stepPath.Child("delay"), delay.String(), "delay must be longer than the delay of the previous step"))
+		} else {
+			previous = delay
+		}
+
+		switch step.Action {
+		case serverpb.EscalationAction_ESCALATION_ACTION_UNSPECIFIED:
+			errs = append(errs, field.Required(stepPath.Child("action"), "action is required"))
+		case serverpb.EscalationAction_ESCALATION_ACTION_CHANGE_SOUND:
+			if step.Sound == "" {
+				errs = append(errs, field.Required(stepPath.Child("sound"), "sound is required to change the sound"))
+			}
+		case serverpb.EscalationAction_ESCALATION_ACTION_REDIRECT:
+			if step.Contact == "" {
+				errs = append(errs, field.Required(stepPath.Child("contact"), "contact is required to redirect a message"))
+			} else if contactName, err := name.ParseContactName(step.Contact); err != nil {
+				errs = append(errs, field.Invalid(stepPath.Child("contact"), step.Contact, err.Error()))
+			} else if account != "" && contactName.Account != account {
+				errs = append(errs, field.Invalid(stepPath.Child("contact"), step.Contact, "contact must be in the account of the location"))
+			}
+		case serverpb.EscalationAction_ESCALATION_ACTION_RESEND, serverpb.EscalationAction_ESCALATION_ACTION_BROADCAST:
+		default:
+			errs = append(errs, field.NotSupported(stepPath.Child("action"), step.Action.String(), escalationActionNames()))
+		}
+	}
+	return errs
+}
+
+// escalationActionNames returns the names of the actions an escalation step
+// can take.
+func escalationActionNames() []string {
+	var names []string
+	for value := int32(1); value < int32(len(serverpb.EscalationAction_name)); value++ {
+		names = append(names, serverpb.EscalationAction(value).String())
+	}
+	return names
+}
+
+// scheduleEscalation sets when the next step of the escalation policy of a
+// message is taken, counting from when the message was sent. A message is
+// only escalated while nobody has acknowledged it and it hasn't ended.
+func scheduleEscalation(message *serverpb.Message) error {
+	message.NextEscalationTime = nil
+	steps := message.GetEscalationPolicy().GetSteps()
+	if len(message.Escalations) >= len(steps) {
+		return nil
+	}
+	switch message.State {
+	case serverpb.Message_ACTIVE, serverpb.Message_DECLINED:
+	default:
+		return nil
+	}
+
+	sendTime := message.CreateTime
+	if len(message.StateChanges) > 0 {
+		sendTime = message.StateChanges[0].ChangeTime
+	}
+	sent, err := ptypes.Timestamp(sendTime)
+	if err != nil {
+		return err
+	}
+	delay, err := ptypes.Duration(steps[len(message.Escalations)].Delay)
+	if err != nil {
+		return err
+	}
+	message.NextEscalationTime, err = ptypes.TimestampProto(sent.Add(delay))
+	return err
+}
+
+// escalateMessage takes every step of the escalation policy of a message that
+// is due by the time, recording each step on the message.
+func escalateMessage(message *serverpb.Message, now time.Time) error {
+	for message.NextEscalationTime != nil {
+		next, err := ptypes.Timestamp(message.NextEscalationTime)
+		if err != nil {
+			return err
+		} else if next.After(now) {
+			return nil
+		}
+
+		index := len(message.Escalations)
+		step := message.EscalationPolicy.Steps[index]
+		escalation := &serverpb.MessageEscalation{
+			Step:         int32(index),
+			Action:       step.Action,
+			EscalateTime: ptypes.TimestampNow(),
+		}
+		switch step.Action {
+		case serverpb.EscalationAction_ESCALATION_ACTION_RESEND:
+			resendMessage(message)
+		case serverpb.EscalationAction_ESCALATION_ACTION_CHANGE_SOUND:
+			if message.DisplayConfig == nil {
+				message.DisplayConfig = &serverpb.Message_DisplayConfig{}
+			}
+			message.DisplayConfig.Sound = step.Sound
+			escalation.Sound = step.Sound
+			resendMessage(message)
+		case serverpb.EscalationAction_ESCALATION_ACTION_REDIRECT:
+			addMessageDelivery(message, step.Contact)
+			escalation.Contact = step.Contact
+		case serverpb.EscalationAction_ESCALATION_ACTION_BROADCAST:
+			message.Broadcast = true
+			resendMessage(message)
+		}
+		message.Escalations = append(message.Escalations, escalation)
+
+		if err := scheduleEscalation(message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resendMessage delivers a message again to every contact it hasn't been
+// withdrawn or held from.
+func resendMessage(message *serverpb.Message) {
+	for _, delivery := range message.Deliveries {
+		switch delivery.State {
+		case serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_WITHDRAWN, serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_HELD:
+			continue
+		}
+		delivery.State = serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_PENDING
+		delivery.UpdateTime = ptypes.TimestampNow()
+	}
+}
+
+// holdMessageDelivery holds a message from a contact that doesn't want to be
+// disturbed.
+func holdMessageDelivery(message *serverpb.Message, contact string) {
+	for _, delivery := range message.Deliveries {
+		if delivery.Recipient == contact {
+			delivery.State = serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_HELD
+		}
+	}
+}
+
+// addMessageDelivery delivers a message to a contact, unless it has already
+// been delivered to them, so they can respond to it.
+func addMessageDelivery(message *serverpb.Message, contact string) {
+	for _, delivery := range message.Deliveries {
+		if delivery.Recipient == contact {
+			delivery.State = serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_PENDING
+			delivery.UpdateTime = ptypes.TimestampNow()
+			return
+		}
+	}
+	message.Deliveries = append(message.Deliveries, &serverpb.MessageDelivery{
+		Recipient:  contact,
+		State:      serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_PENDING,
+		UpdateTime: ptypes.TimestampNow(),
+	})
+}
+
+// Escalator takes the steps of the escalation policies of the messages that
+// aren't acknowledged in time.
+type Escalator struct {
+	store store.Storage
+}
+
+// NewEscalator creates an Escalator that finds the messages to escalate in
+// the storage.
+func NewEscalator(storage store.Storage) *Escalator {
+	return &Escalator{store: storage}
+}
+
+// Run will escalate the messages whose next step is due at the interval
+// until the context is done. Every server can run an Escalator since the
+// time of the next step is stored with the message, so each step is only
+// taken once.
+func (e *Escalator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		runCtx, cancel := context.WithTimeout(ctx, interval)
+		if err := e.Escalate(runCtx, time.Now()); err != nil && ctx.Err() == nil {
+			workerLogger.logWork("Escalator", "failed to escalate messages", "", err)
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Escalate takes the steps that are due by the given time for every message
+// that hasn't been acknowledged, completed, cancelled or expired. The first
+// error will be returned after every message has been attempted.
+func (e *Escalator) Escalate(ctx context.Context, now time.Time) error {
+	messages, err := e.store.ListEscalatingMessages(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, message := range messages {
+		// The message may have been acknowledged or escalated by another
+		// server since it was listed
+		_, err := e.store.UpdateMessage(ctx, message.Name, func(existing *serverpb.Message) error {
+			next, err := ptypes.Timestamp(existing.NextEscalationTime)
+			if existing.NextEscalationTime == nil || err != nil || next.After(now) {
+				return errFailedPrecondition("message is not due to be escalated")
+			}
+			return escalateMessage(existing, now)
+		})
+		if err != nil && status.Code(err) != codes.FailedPrecondition && firstErr == nil {
+			firstErr = fmt.Errorf("failed to escalate %s: %v", message.Name, err)
+		} else if err == nil {
+			messageChanges.notify()
+			workerLogger.logWork("Escalator", "message escalated", message.Name, nil)
+		}
+	}
+	return firstErr
+}
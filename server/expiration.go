@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sweeper expires the messages that reach their expire time without being
+// completed or cancelled.
+type Sweeper struct {
+	store store.Storage
+}
+
+// NewSweeper creates a Sweeper that finds expired messages in the storage.
+func NewSweeper(storage store.Storage) *Sweeper {
+	return &Sweeper{store: storage}
+}
+
+// Run will expire the messages that have reached their expire time at the
+// interval until the context is done. Every server can run a Sweeper since a
+// message will only be expired once.
+func (s *Sweeper) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		runCtx, cancel := context.WithTimeout(ctx, interval)
+		if err := s.Sweep(runCtx, time.Now()); err != nil && ctx.Err() == nil {
+			workerLogger.logWork("Sweeper", "failed to expire messages", "", err)
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep moves every message that has expired by the given time to EXPIRED,
+// which removes it from the devices watching its location, and records the
+// expiry in the history of the message. The first error will be returned
+// after every message has been attempted.
+func (s *Sweeper) Sweep(ctx context.Context, now time.Time) error {
+	messages, err := s.store.ListExpiredMessages(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, message := range messages {
+		// The message may have been completed, cancelled or given more time
+		// since it was listed
+		_, err := s.store.UpdateMessage(ctx, message.Name, func(existing *serverpb.Message) error {
+			expires, err := ptypes.Timestamp(existing.GetExpireTime())
+			if err != nil || expires.After(now) {
+				return errFailedPrecondition("message has not expired")
+			}
+			return transitionMessage(existing, serverpb.Message_EXPIRED, "")
+		})
+		if err != nil && status.Code(err) != codes.FailedPrecondition && firstErr == nil {
+			firstErr = fmt.Errorf("failed to expire %s: %v", message.Name, err)
+		} else if err == nil {
+			messageChanges.notify()
+			workerLogger.logWork("Sweeper", "message expired", message.Name, nil)
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+// NewHTTPHandler will create an HTTP handler that transcodes RESTful JSON
+// requests into gRPC calls on the provided connection. Requests are routed
+// using the google.api.http annotations declared on each RPC so the
+// handler will expose the same API surface as the gRPC server.
+func NewHTTPHandler(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}),
+		runtime.WithProtoErrorHandler(httpErrorHandler),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
+
+	// Register all of the services for this server
+	registrations := []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		serverpb.RegisterAccountsHandler,
+		serverpb.RegisterAuditLogHandler,
+		serverpb.RegisterContactsHandler,
+		serverpb.RegisterIAMCredentialsHandler,
+		serverpb.RegisterLocationsHandler,
+		serverpb.RegisterMessengerHandler,
+		serverpb.RegisterRoomsHandler,
+		serverpb.RegisterTemplatesHandler,
+		serverpb.RegisterUserManagerHandler,
+	}
+	for _, register := range registrations {
+		if err := register(ctx, mux, conn); err != nil {
+			return nil, err
+		}
+	}
+
+	return mux, nil
+}
+
+// incomingHeaderMatcher forwards the W3C trace context headers unchanged so
+// the spans of the gRPC server will continue the trace of the HTTP client,
+// along with the request ID provided by the client.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch key = strings.ToLower(key); key {
+	case "traceparent", "tracestate", "baggage", requestIDHeader:
+		return key, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher returns the request ID in the X-Request-Id header,
+// while all other response metadata is prefixed with Grpc-Metadata-.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == requestIDHeader {
+		return "X-Request-Id", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// httpError is the JSON representation of an error returned from the
+// HTTP gateway. It follows the error model used by Google APIs so that
+// clients can rely on the same format for every endpoint.
+type httpError struct {
+	Error httpErrorBody `json:"error"`
+}
+
+type httpErrorBody struct {
+	// The HTTP status code of the response.
+	Code int `json:"code"`
+	// A developer facing error message.
+	Message string `json:"message"`
+	// The name of the gRPC status code, such as INVALID_ARGUMENT.
+	Status string `json:"status"`
+	// The error details, such as BadRequest field violations, encoded
+	// with their `@type`.
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+// httpErrorHandler writes a gRPC error as an HTTP response, converting the
+// gRPC status code to the matching HTTP status code.
+func httpErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	const fallback = `{"error": {"code": 500, "message": "failed to marshal error message", "status": "INTERNAL"}}`
+
+	s := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(s.Code())
+
+	body := httpError{Error: httpErrorBody{
+		Code:    httpStatus,
+		Message: s.Message(),
+		Status:  codeName(s.Code()),
+	}}
+	for _, detail := range s.Proto().GetDetails() {
+		encoded, err := marshaler.Marshal(detail)
+		if err != nil {
+			grpclog.Infof("Failed to marshal error detail %q: %v", detail.GetTypeUrl(), err)
+			continue
+		}
+		body.Error.Details = append(body.Error.Details, encoded)
+	}
+
+	// Return the request ID of the failed request so it can be found in the logs
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		if id := md.HeaderMD.Get(requestIDHeader); len(id) > 0 {
+			w.Header().Set("X-Request-Id", id[0])
+		}
+	}
+	w.Header().Del("Trailer")
+	w.Header().Set("Content-Type", "application/json")
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		grpclog.Infof("Failed to marshal error message %q: %v", s.Message(), err)
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, fallback)
+		return
+	}
+
+	w.WriteHeader(httpStatus)
+	if _, err := w.Write(buf); err != nil {
+		grpclog.Infof("Failed to write response: %v", err)
+	}
+}
+
+// codeNames maps each gRPC code to its canonical name.
+var codeNames = map[codes.Code]string{
+	codes.OK:                 "OK",
+	codes.Canceled:           "CANCELLED",
+	codes.Unknown:            "UNKNOWN",
+	codes.InvalidArgument:    "INVALID_ARGUMENT",
+	codes.DeadlineExceeded:   "DEADLINE_EXCEEDED",
+	codes.NotFound:           "NOT_FOUND",
+	codes.AlreadyExists:      "ALREADY_EXISTS",
+	codes.PermissionDenied:   "PERMISSION_DENIED",
+	codes.ResourceExhausted:  "RESOURCE_EXHAUSTED",
+	codes.FailedPrecondition: "FAILED_PRECONDITION",
+	codes.Aborted:            "ABORTED",
+	codes.OutOfRange:         "OUT_OF_RANGE",
+	codes.Unimplemented:      "UNIMPLEMENTED",
+	codes.Internal:           "INTERNAL",
+	codes.Unavailable:        "UNAVAILABLE",
+	codes.DataLoss:           "DATA_LOSS",
+	codes.Unauthenticated:    "UNAUTHENTICATED",
+}
+
+// codeName returns the canonical name of a gRPC code, such as NOT_FOUND.
+func codeName(code codes.Code) string {
+	if name, ok := codeNames[code]; ok {
+		return name
+	}
+	return codeNames[codes.Unknown]
+}
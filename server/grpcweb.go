@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+)
+
+// NewGRPCWebHandler will wrap the gRPC server with an HTTP handler that accepts
+// gRPC-Web requests so browser clients can call the API without a separate proxy.
+// Unary and server-streaming RPCs are supported over HTTP/1.1 and HTTP/2, and
+// streams can also be opened over websockets.
+//
+// Cross-origin requests are only accepted from the allowed origins, where an
+// origin of "*" will allow requests from any origin. Requests that are not
+// gRPC-Web requests will be passed on to the next handler.
+func NewGRPCWebHandler(srv *grpc.Server, next http.Handler, allowedOrigins []string) http.Handler {
+	allowOrigin := originMatcher(allowedOrigins)
+
+	wrapped := grpcweb.WrapServer(srv,
+		grpcweb.WithOriginFunc(allowOrigin),
+		grpcweb.WithWebsockets(true),
+		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool {
+			return allowOrigin(req.Header.Get("Origin"))
+		}),
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrapped.IsGrpcWebRequest(r) || wrapped.IsAcceptableGrpcCorsRequest(r) || wrapped.IsGrpcWebSocketRequest(r) {
+			wrapped.ServeHTTP(w, r)
+			return
+		}
+		if next == nil {
+			http.NotFound(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// originMatcher returns a function that reports whether a request from the
+// origin is allowed.
+func originMatcher(allowedOrigins []string) func(origin string) bool {
+	allowed := map[string]bool{}
+	for _, origin := range allowedOrigins {
+		allowed[origin] = true
+	}
+	return func(origin string) bool {
+		return allowed["*"] || allowed[origin]
+	}
+}
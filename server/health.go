@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/chacerapp/apiserver/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthCheck verifies that a dependency of the API services is available.
+type HealthCheck func(ctx context.Context) error
+
+// Health reports the serving status of the API services through the standard
+// grpc.health.v1.Health service. Every service will report NOT_SERVING while
+// a health check is failing and once the server has started shutting down. A
+// service with its own checks will also report NOT_SERVING while one of them
+// is failing, without affecting the other services.
+type Health struct {
+	server        *health.Server
+	checks        []HealthCheck
+	serviceChecks map[string][]HealthCheck
+
+	mu       sync.Mutex
+	services map[string]bool
+	failing  map[string]error
+	serving  bool
+}
+
+// NewHealth creates a Health that verifies the storage can be reached along
+// with any additional checks. The services will report SERVING until a check
+// has failed.
+func NewHealth(storage store.Storage, checks ...HealthCheck) *Health {
+	return &Health{
+		server:        health.NewServer(),
+		checks:        append([]HealthCheck{storage.Ping}, checks...),
+		serviceChecks: map[string][]HealthCheck{},
+		services:      map[string]bool{},
+		failing:       map[string]error{},
+		serving:       true,
+	}
+}
+
+// AddServiceCheck adds a check for a dependency of a single service, e.g.
+// `chacerapp.v1.Messenger`. Checks should be added before the health checks
+// are watched.
+func (h *Health) AddServiceCheck(service string, check HealthCheck) {
+	h.serviceChecks[service] = append(h.serviceChecks[service], check)
+}
+
+// Check runs every health check and updates the serving status of the
+// services. The error from the first failing check will be returned.
+func (h *Health) Check(ctx context.Context) error {
+	err := runHealthChecks(ctx, h.checks)
+	firstErr := err
+	failing := map[string]error{}
+	for service, checks := range h.serviceChecks {
+		if serviceErr := runHealthChecks(ctx, checks); serviceErr != nil {
+			failing[service] = serviceErr
+			if firstErr == nil {
+				firstErr = serviceErr
+			}
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.serving && err != nil {
+		log.Printf("Health check failed, services are not serving: %v", err)
+	} else if !h.serving && err == nil {
+		log.Print("Health checks passed, services are serving")
+	}
+	for service, serviceErr := range failing {
+		if h.failing[service] == nil {
+			log.Printf("Health check of %s failed, the service is not serving: %v", service, serviceErr)
+		}
+	}
+	for service := range h.failing {
+		if failing[service] == nil {
+			log.Printf("Health checks of %s passed, the service is serving", service)
+		}
+	}
+	h.serving = err == nil
+	h.failing = failing
+	h.updateLocked()
+	return firstErr
+}
+
+func runHealthChecks(ctx context.Context, checks []HealthCheck) error {
+	for _, check := range checks {
+		if err := check(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Watch will run the health checks at the interval until the context is done.
+func (h *Health) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		h.Check(checkCtx)
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks every service as NOT_SERVING so load balancers will stop
+// sending new requests while in-flight requests drain. The status will no
+// longer be updated by the health checks.
+func (h *Health) Shutdown() {
+	h.server.Shutdown()
+}
+
+// register adds every service of the gRPC server to the health service.
+func (h *Health) register(srv *grpc.Server) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for service := range srv.GetServiceInfo() {
+		h.services[service] = true
+	}
+	h.updateLocked()
+	healthpb.RegisterHealthServer(srv, h.server)
+}
+
+func (h *Health) updateLocked() {
+	// The empty service name is the status of the server as a whole
+	h.server.SetServingStatus("", servingStatus(h.serving))
+	for service := range h.services {
+		h.server.SetServingStatus(service, servingStatus(h.serving && h.failing[service] == nil))
+	}
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,267 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/dayofweek"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The number of days ahead that are checked for the next time a location
+// opens or closes.
+const openStateHorizonDays = 366
+
+// openPeriod is a period a location is open, in the time zone of the location.
+type openPeriod struct {
+	start, end time.Time
+}
+
+// loadTimeZone loads the time zone of a location, which is UTC when the
+// location doesn't have one.
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	} else if name == "Local" {
+		// The local time zone of the server isn't a real time zone
+		return nil, fmt.Errorf("unknown time zone %s", name)
+	}
+	return time.LoadLocation(name)
+}
+
+// locationOpenState checks whether the location is open at the time, and when
+// it next opens or closes.
+func locationOpenState(location *serverpb.Location, now time.Time) (*serverpb.LocationOpenState, error) {
+	tz, err := loadTimeZone(location.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	checkTime, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, err
+	}
+	state := &serverpb.LocationOpenState{
+		Name:      location.Name,
+		CheckTime: checkTime,
+	}
+
+	local := now.In(tz)
+	if holiday := findHoliday(location, local); holiday != nil {
+		state.Holiday = holiday.DisplayName
+	}
+
+	// Walk through the periods from the start of the day until the location
+	// changes between open and closed. Periods that touch are treated as one
+	// so a location that is open past midnight isn't closed at midnight.
+	var openUntil time.Time
+	horizon := time.Date(local.Year(), local.Month(), local.Day()+openStateHorizonDays+1, 0, 0, 0, 0, tz)
+	for day := 0; day <= openStateHorizonDays; day++ {
+		for _, period := range dayOpenPeriods(location, time.Date(local.Year(), local.Month(), local.Day()+day, 0, 0, 0, 0, tz)) {
+			switch {
+			case state.Open && period.start.After(openUntil):
+				state.NextCloseTime, err = ptypes.TimestampProto(openUntil)
+				return state, err
+			case state.Open:
+				if period.end.After(openUntil) {
+					openUntil = period.end
+				}
+			case !period.end.After(now):
+				continue
+			case !period.start.After(now):
+				state.Open = true
+				openUntil = period.end
+			default:
+				state.NextOpenTime, err = ptypes.TimestampProto(period.start)
+				return state, err
+			}
+		}
+	}
+
+	// The location doesn't close within the horizon when it's open until the
+	// end of it
+	if state.Open && openUntil.Before(horizon) {
+		state.NextCloseTime, err = ptypes.TimestampProto(openUntil)
+	}
+	return state, err
+}
+
+// dayOpenPeriods returns the periods the location is open on the day, in order.
+// The holiday hours of the day are used instead of the business hours when the
+// location has a holiday exception on the day.
+func dayOpenPeriods(location *serverpb.Location, day time.Time) []openPeriod {
+	var periods []openPeriod
+	addPeriod := func(open, close *timeofday.TimeOfDay) {
+		periods = append(periods, openPeriod{
+			start: timeOnDay(day, open),
+			end:   timeOnDay(day, close),
+		})
+	}
+
+	if holiday := findHoliday(location, day); holiday != nil {
+		for _, hours := range holiday.Hours {
+			addPeriod(hours.OpenTime, hours.CloseTime)
+		}
+	} else {
+		weekday := goWeekday(day.Weekday())
+		for _, hours := range location.BusinessHours {
+			if hours.Day == weekday {
+				addPeriod(hours.OpenTime, hours.CloseTime)
+			}
+		}
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].start.Before(periods[j].start)
+	})
+	return periods
+}
+
+// findHoliday returns the holiday exception of the location on the day, or
+// nil when the location keeps its business hours on the day.
+func findHoliday(location *serverpb.Location, day time.Time) *serverpb.HolidayException {
+	for _, holiday := range location.Holidays {
+		if d := holiday.Date; d != nil && int(d.Year) == day.Year() && time.Month(d.Month) == day.Month() && int(d.Day) == day.Day() {
+			return holiday
+		}
+	}
+	return nil
+}
+
+// timeOnDay returns the time of day on the day. A time of 24:00 is midnight at
+// the end of the day.
+func timeOnDay(day time.Time, t *timeofday.TimeOfDay) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(t.Hours), int(t.Minutes), int(t.Seconds), int(t.Nanos), day.Location())
+}
+
+// goWeekday converts a Go weekday to the day of the week used by the API.
+func goWeekday(weekday time.Weekday) dayofweek.DayOfWeek {
+	if weekday == time.Sunday {
+		return dayofweek.DayOfWeek_SUNDAY
+	}
+	return dayofweek.DayOfWeek(weekday)
+}
+
+func validateBusinessHours(path *field.Path, businessHours []*serverpb.BusinessHours) field.ErrorList {
+	var errs field.ErrorList
+	days := map[dayofweek.DayOfWeek][]openPeriod{}
+	for i, hours := range businessHours {
+		hoursPath := path.Index(i)
+		if hours.Day == dayofweek.DayOfWeek_DAY_OF_WEEK_UNSPECIFIED {
+			errs = append(errs, field.Required(hoursPath.Child("day"), "day is required"))
+		} else if _, ok := dayofweek.DayOfWeek_name[int32(hours.Day)]; !ok {
+			errs = append(errs, field.Invalid(hoursPath.Child("day"), hours.Day.String(), "invalid day of the week"))
+		}
+
+		periodErrs := validateOpenPeriod(hoursPath, hours.OpenTime, hours.CloseTime)
+		errs = append(errs, periodErrs...)
+		if len(periodErrs) == 0 && hours.Day != dayofweek.DayOfWeek_DAY_OF_WEEK_UNSPECIFIED {
+			period := dayPeriod(hours.OpenTime, hours.CloseTime)
+			if overlapsPeriods(period, days[hours.Day]) {
+				errs = append(errs, field.Invalid(hoursPath, hours.Day.String(), "business hours must not overlap on the same day"))
+			}
+			days[hours.Day] = append(days[hours.Day], period)
+		}
+	}
+	return errs
+}
+
+func validateHolidays(path *field.Path, holidays []*serverpb.HolidayException) field.ErrorList {
+	var errs field.ErrorList
+	seen := map[string]bool{}
+	for i, holiday := range holidays {
+		holidayPath := path.Index(i)
+		if holiday.Date == nil {
+			errs = append(errs, field.Required(holidayPath.Child("date"), "date is required"))
+		} else if !validDate(holiday.Date) {
+			errs = append(errs, field.Invalid(holidayPath.Child("date"), formatDate(holiday.Date), "date must be a valid calendar date"))
+		} else if seen[formatDate(holiday.Date)] {
+			errs = append(errs, field.Duplicate(holidayPath.Child("date"), formatDate(holiday.Date)))
+		} else {
+			seen[formatDate(holiday.Date)] = true
+		}
+
+		var periods []openPeriod
+		for j, hours := range holiday.Hours {
+			hoursPath := holidayPath.Child("hours").Index(j)
+			periodErrs := validateOpenPeriod(hoursPath, hours.OpenTime, hours.CloseTime)
+			errs = append(errs, periodErrs...)
+			if len(periodErrs) == 0 {
+				period := dayPeriod(hours.OpenTime, hours.CloseTime)
+				if overlapsPeriods(period, periods) {
+					errs = append(errs, field.Invalid(hoursPath, formatTimeOfDay(hours.OpenTime), "holiday hours must not overlap"))
+				}
+				periods = append(periods, period)
+			}
+		}
+	}
+	return errs
+}
+
+// validateOpenPeriod validates the open and close times of a period a location
+// is open. Only the close time can be 24:00.
+func validateOpenPeriod(path *field.Path, open, close *timeofday.TimeOfDay) field.ErrorList {
+	var errs field.ErrorList
+	if open == nil {
+		errs = append(errs, field.Required(path.Child("open_time"), "open_time is required"))
+	} else if !validTimeOfDay(open, false) {
+		errs = append(errs, field.Invalid(path.Child("open_time"), formatTimeOfDay(open), "open_time must be a valid time of day"))
+	}
+	if close == nil {
+		errs = append(errs, field.Required(path.Child("close_time"), "close_time is required"))
+	} else if !validTimeOfDay(close, true) {
+		errs = append(errs, field.Invalid(path.Child("close_time"), formatTimeOfDay(close), "close_time must be a valid time of day"))
+	}
+	if len(errs) == 0 && !dayPeriod(open, close).end.After(dayPeriod(open, close).start) {
+		errs = append(errs, field.Invalid(path.Child("close_time"), formatTimeOfDay(close), "close_time must be after open_time"))
+	}
+	return errs
+}
+
+func validTimeOfDay(t *timeofday.TimeOfDay, endOfDay bool) bool {
+	if endOfDay && t.Hours == 24 {
+		return t.Minutes == 0 && t.Seconds == 0 && t.Nanos == 0
+	}
+	return t.Hours >= 0 && t.Hours <= 23 &&
+		t.Minutes >= 0 && t.Minutes <= 59 &&
+		t.Seconds >= 0 && t.Seconds <= 59 &&
+		t.Nanos >= 0 && t.Nanos <= 999999999
+}
+
+func validDate(d *date.Date) bool {
+	if d.Year < 1 || d.Year > 9999 || d.Month < 1 || d.Month > 12 || d.Day < 1 {
+		return false
+	}
+	// A day past the end of the month is normalized into the next month
+	return time.Date(int(d.Year), time.Month(d.Month), int(d.Day), 0, 0, 0, 0, time.UTC).Day() == int(d.Day)
+}
+
+// dayPeriod returns the period as an offset from the start of a day, which is
+// used to compare periods that are on the same day.
+func dayPeriod(open, close *timeofday.TimeOfDay) openPeriod {
+	return openPeriod{
+		start: timeOnDay(time.Time{}, open),
+		end:   timeOnDay(time.Time{}, close),
+	}
+}
+
+func overlapsPeriods(period openPeriod, periods []openPeriod) bool {
+	for _, other := range periods {
+		if period.start.Before(other.end) && other.start.Before(period.end) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatTimeOfDay(t *timeofday.TimeOfDay) string {
+	return fmt.Sprintf("%02d:%02d:%02d", t.Hours, t.Minutes, t.Seconds)
+}
+
+func formatDate(d *date.Date) string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
@@ -12,7 +12,9 @@ import (
 	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
-func authInterceptor() grpc.UnaryServerInterceptor {
+// authInterceptor grants the caller the additional permissions of the
+// endpoint they have been given, which are checked by hasPermission.
+func authInterceptor(permissions map[string][]string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		name := strings.Split(info.FullMethod, "/")
 		descr, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name[1]))
@@ -33,6 +35,36 @@ func authInterceptor() grpc.UnaryServerInterceptor {
 			// Add shit
 		}
 
+		// The caller is given the additional permissions of the endpoint that
+		// were granted to the identity of their client certificate
+		var additionalPermissions []string
+		if proto.HasExtension(methodDesc.Options(), serverpb.E_AdditionalPermissions) {
+			additionalPermissions = proto.GetExtension(methodDesc.Options(), serverpb.E_AdditionalPermissions).([]string)
+		}
+		callerPermissions := map[string]bool{}
+		if identity := certificateIdentity(ctx); identity != "" {
+			for _, permission := range permissions[identity] {
+				callerPermissions[permission] = true
+			}
+		}
+		granted := make(map[string]bool, len(additionalPermissions))
+		for _, permission := range additionalPermissions {
+			if callerPermissions[permission] {
+				granted[permission] = true
+			}
+		}
+		ctx = context.WithValue(ctx, grantedPermissionsKey{}, granted)
+
 		return handler(ctx, req)
 	}
 }
+
+type grantedPermissionsKey struct{}
+
+// hasPermission reports whether the caller was granted one of the additional
+// permissions of the endpoint. A call that wasn't made through an endpoint has
+// no permissions.
+func hasPermission(ctx context.Context, permission string) bool {
+	granted, _ := ctx.Value(grantedPermissionsKey{}).(map[string]bool)
+	return granted[permission]
+}
@@ -0,0 +1,351 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// accountTransitions are the phases an account is allowed to move to from
+// each phase.
+//
+//	PENDING    -> ACTIVE, INACTIVE
+//	ACTIVE     -> SUSPENDING, INACTIVE
+//	SUSPENDING -> SUSPENDED
+//	SUSPENDED  -> ACTIVE, INACTIVE
+//	INACTIVE   -> ACTIVE
+var accountTransitions = map[serverpb.AccountPhase][]serverpb.AccountPhase{
+	serverpb.AccountPhase_ACCOUNT_PHASE_PENDING: {
+		serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE,
+		serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE,
+	},
+	serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE: {
+		serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING,
+		serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE,
+	},
+	serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING: {
+		serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED,
+	},
+	serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED: {
+		serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE,
+		serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE,
+	},
+	serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE: {
+		serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE,
+	},
+}
+
+// canTransitionAccount reports whether an account can move between the phases.
+func canTransitionAccount(from, to serverpb.AccountPhase) bool {
+	for _, allowed := range accountTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// accountTransition is a precondition for updating the status of an account
+// that fails when the account can't move to the phase from its current phase.
+// The check is made within the update so concurrent requests can't both move
+// the account out of the same phase. Any additional checks are run against the
+// existing account once the transition is allowed.
+func accountTransition(to serverpb.AccountPhase, checks ...func(existing *serverpb.Account) error) store.UpdateOption {
+	return store.WithPrecondition(func(existing proto.Message) error {
+		account := existing.(*serverpb.Account)
+		from := account.GetStatus().GetPhase()
+		if !canTransitionAccount(from, to) {
+			return errAccountTransition(from, to)
+		}
+		for _, check := range checks {
+			if err := check(account); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// accountStatusUpdate is a precondition like accountTransition that also
+// allows the status to be updated without changing the phase. An account can't
+// be moved into or out of a suspension this way, since suspending an account
+// tears it down and activating it checks why it was suspended, and the reason
+// of a suspended account can't be changed.
+func accountStatusUpdate(status *serverpb.AccountStatus) store.UpdateOption {
+	return store.WithPrecondition(func(existing proto.Message) error {
+		current := existing.(*serverpb.Account).GetStatus()
+		from, to := current.GetPhase(), status.Phase
+		switch {
+		case from == to && isSuspendedPhase(from) && status.Reason != current.GetReason():
+			return errFailedPrecondition("the reason of a suspended account can not be changed")
+		case from == to:
+			return nil
+		case isSuspendedPhase(to):
+			return errFailedPrecondition("accounts can only be suspended with SuspendAccount")
+		case isSuspendedPhase(from) && to == serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE:
+			return errFailedPrecondition("suspended accounts can only be activated with ActivateAccount")
+		case !canTransitionAccount(from, to):
+			return errAccountTransition(from, to)
+		}
+		return nil
+	})
+}
+
+// isSuspendedPhase reports whether an account in the phase is being or has
+// been suspended.
+func isSuspendedPhase(phase serverpb.AccountPhase) bool {
+	return phase == serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING || phase == serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED
+}
+
+func errAccountTransition(from, to serverpb.AccountPhase) error {
+	return errFailedPrecondition(fmt.Sprintf(
+		"account can not move from %s to %s",
+		strings.TrimPrefix(from.String(), "ACCOUNT_PHASE_"),
+		strings.TrimPrefix(to.String(), "ACCOUNT_PHASE_"),
+	))
+}
+
+// writableAccountPhase reports whether the resources of an account in the
+// phase can be changed.
+func writableAccountPhase(phase serverpb.AccountPhase) bool {
+	switch phase {
+	case serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING,
+		serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED,
+		serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE:
+		return false
+	}
+	return true
+}
+
+// accountChanges is notified when the phase of an account changes so the
+// watches of its devices can be ended.
+var accountChanges = &changeNotifier{}
+
+// teardownAccount disconnects the devices and cancels the outstanding
+// messages of an account that is being suspended. The devices watching the
+// account through this server are disconnected straight away, and those
+// watching through other servers are disconnected when they next check the
+// account. It is safe to tear down an account again when a previous teardown
+// was interrupted.
+func (s *server) teardownAccount(ctx context.Context, account string) error {
+	accountChanges.notify()
+
+	messages, err := s.store.ListOutstandingMessages(ctx, name.BuildRelativeName(account, name.CollectionLocations, name.Wildcard))
+	if err != nil {
+		return err
+	}
+	for _, message := range messages {
+		// The message may have been completed or expired since it was listed
+		_, err := s.store.UpdateMessage(ctx, message.Name, func(existing *serverpb.Message) error {
+			return transitionMessage(existing, serverpb.Message_CANCELLED, "")
+		})
+		if err != nil && status.Code(err) != codes.FailedPrecondition {
+			return fmt.Errorf("failed to cancel %s: %v", message.Name, err)
+		}
+	}
+	messageChanges.notify()
+	return nil
+}
+
+// accountPhaseInterceptor rejects requests that would change the resources
+// within an account that is suspended or inactive. The account itself can
+// still be managed through the Accounts service so it can be reactivated.
+func accountPhaseInterceptor(storage store.Storage) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isChildWrite(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if account := childResourceAccount(req); account != "" {
+			if err := checkAccountPhase(ctx, storage, account, "changed"); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamAccountPhaseInterceptor rejects watches of the resources within an
+// account that is suspended or inactive, and ends the watches of an account
+// once it is suspended or deactivated so its devices are disconnected. The
+// account is checked again when its phase is changed through this server, or
+// otherwise at the interval.
+func streamAccountPhaseInterceptor(storage store.Storage, interval time.Duration) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(info.FullMethod, "/chacerapp.") {
+			return handler(srv, ss)
+		}
+
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+		stream := &accountPhaseStream{
+			wrappedStream: wrappedStream{ServerStream: ss, ctx: ctx},
+			storage:       storage,
+			interval:      interval,
+			cancel:        cancel,
+		}
+		err := handler(srv, stream)
+		if locked := stream.lockedErr(); locked != nil {
+			return locked
+		}
+		return err
+	}
+}
+
+// accountPhaseStream checks the phase of the account a watch is for once its
+// request is received, and watches the account until the stream ends.
+type accountPhaseStream struct {
+	wrappedStream
+	storage  store.Storage
+	interval time.Duration
+	cancel   context.CancelFunc
+
+	mu      sync.Mutex
+	account string
+	locked  error
+}
+
+func (s *accountPhaseStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.account != "" {
+		return nil
+	}
+	if s.account = childResourceAccount(m); s.account == "" {
+		return nil
+	}
+	if err := checkAccountPhase(s.ctx, s.storage, s.account, "watched"); err != nil {
+		return err
+	}
+	go s.watchAccount()
+	return nil
+}
+
+// watchAccount ends the stream once its account can't be watched.
+func (s *accountPhaseStream) watchAccount() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		changed := accountChanges.wait()
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-changed:
+		}
+
+		err := checkAccountPhase(s.ctx, s.storage, s.account, "watched")
+		if status.Code(err) == codes.FailedPrecondition {
+			s.mu.Lock()
+			s.locked = err
+			s.mu.Unlock()
+			s.cancel()
+			return
+		}
+	}
+}
+
+// lockedErr returns the error the stream was ended with because its account
+// could no longer be watched.
+func (s *accountPhaseStream) lockedErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.locked
+}
+
+// checkAccountPhase returns a FailedPrecondition error when the resources of
+// the account can't be changed or watched in its current phase. A missing
+// account is left to the handler to report.
+func checkAccountPhase(ctx context.Context, storage store.Storage, account, action string) error {
+	existing, err := storage.GetAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+	if existing != nil && !writableAccountPhase(existing.GetStatus().GetPhase()) {
+		return errFailedPrecondition(fmt.Sprintf(
+			"%s is %s and its resources can not be %s",
+			account,
+			strings.ToLower(strings.TrimPrefix(existing.GetStatus().GetPhase().String(), "ACCOUNT_PHASE_")),
+			action,
+		))
+	}
+	return nil
+}
+
+// isChildWrite reports whether the method changes resources within an
+// account, which is any write method outside of the Accounts service.
+func isChildWrite(fullMethod string) bool {
+	return isWriteMethod(fullMethod) && !strings.HasPrefix(fullMethod, "/chacerapp.v1.Accounts/")
+}
+
+// isWriteMethod reports whether the method could change a resource, which is
+// any method of the API services that isn't mapped to an HTTP GET.
+func isWriteMethod(fullMethod string) bool {
+	parts := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "chacerapp.") {
+		return false
+	}
+
+	descr, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(parts[0]))
+	if err != nil {
+		return false
+	}
+	service, ok := descr.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return false
+	}
+	method := service.Methods().ByName(protoreflect.Name(parts[1]))
+	if method == nil || !protov2.HasExtension(method.Options(), annotations.E_Http) {
+		return false
+	}
+	rule := protov2.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	return rule.GetGet() == ""
+}
+
+// childResourceAccount returns the name of the account that a request is
+// changing resources within. The account is taken from the name or parent of
+// the request, or from the name of the resource in the request body.
+func childResourceAccount(req interface{}) string {
+	if account := resourceAccount(requestResource(req)); account != "" {
+		return account
+	}
+
+	// Update requests hold the resource being updated, e.g. UpdateRoomRequest.room
+	msg, ok := req.(protoreflect.ProtoMessage)
+	if !ok {
+		return ""
+	}
+	reflected := msg.ProtoReflect()
+	fields := reflected.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !reflected.Has(fd) {
+			continue
+		}
+		nested := reflected.Get(fd).Message()
+		if nameField := nested.Descriptor().Fields().ByName("name"); nameField != nil && nameField.Kind() == protoreflect.StringKind {
+			if account := resourceAccount(nested.Get(nameField).String()); account != "" {
+				return account
+			}
+		}
+	}
+	return ""
+}
@@ -2,10 +2,12 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/chacerapp/apiserver/name"
 	"github.com/chacerapp/apiserver/server/serverpb"
 	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -41,7 +43,7 @@ func (s *server) ListLocations(ctx context.Context, req *serverpb.ListLocationsR
 
 func (s *server) CreateLocation(ctx context.Context, req *serverpb.CreateLocationRequest) (*serverpb.Location, error) {
 	// Check that the provided location configuration is valid
-	if err := validateCreateLocation(req.Location); err != nil {
+	if err := validateCreateLocation(req); err != nil {
 		return nil, err
 	}
 
@@ -53,17 +55,29 @@ func (s *server) CreateLocation(ctx context.Context, req *serverpb.CreateLocatio
 		return nil, errNotFound
 	}
 
-	// Set the name of the location based on the display name
-	req.Location.Name = name.BuildRelativeName(req.Parent, name.CollectionLocations, slugify(req.Location.DisplayName))
+	// Use the requested location ID, otherwise generate one from the display
+	// name. A generated ID is retried when it collides with an existing location
+	// since the caller didn't ask for that ID.
+	for attempt := 0; attempt < generatedIDAttempts; attempt++ {
+		locationID := req.LocationId
+		if locationID == "" {
+			if locationID, err = generateResourceID(req.Location.DisplayName, "location"); err != nil {
+				return nil, err
+			}
+		}
+		req.Location.Name = name.BuildRelativeName(req.Parent, name.CollectionLocations, locationID)
 
-	location, err := s.store.CreateLocation(ctx, req.Location)
-	if err != nil {
-		return nil, err
-	} else if location == nil {
-		return nil, errAlreadyExists
+		location, err := s.store.CreateLocation(ctx, req.Location)
+		if err != nil {
+			return nil, convertQuotaError(err)
+		} else if location != nil {
+			return location, nil
+		} else if req.LocationId != "" {
+			break
+		}
 	}
 
-	return location, nil
+	return nil, errAlreadyExists
 }
 
 func (s *server) GetLocation(ctx context.Context, req *serverpb.GetLocationRequest) (*serverpb.Location, error) {
@@ -82,7 +96,7 @@ func (s *server) GetLocation(ctx context.Context, req *serverpb.GetLocationReque
 }
 
 func (s *server) UpdateLocation(ctx context.Context, req *serverpb.UpdateLocationRequest) (*serverpb.Location, error) {
-	if err := validateCreateLocation(req.Location); err != nil {
+	if err := validateUpdateLocation(req); err != nil {
 		return nil, err
 	}
 
@@ -109,12 +123,59 @@ func (s *server) DeleteLocation(ctx context.Context, req *serverpb.DeleteLocatio
 	}
 }
 
-func validateCreateLocation(location *serverpb.Location) error {
+func (s *server) GetLocationOpenState(ctx context.Context, req *serverpb.GetLocationOpenStateRequest) (*serverpb.LocationOpenState, error) {
+	if _, _, err := name.ParseLocation(req.Name); err != nil {
+		return nil, err
+	}
+
+	checkTime := time.Now()
+	if req.CheckTime != nil {
+		var err error
+		if checkTime, err = ptypes.Timestamp(req.CheckTime); err != nil {
+			return nil, convertErrorList(field.ErrorList{
+				field.Invalid(field.NewPath("check_time"), ptypes.TimestampString(req.CheckTime), err.Error()),
+			})
+		}
+	}
+
+	location, err := s.store.GetLocation(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	} else if location == nil {
+		return nil, errNotFound
+	}
+
+	return locationOpenState(location, checkTime)
+}
+
+func validateCreateLocation(req *serverpb.CreateLocationRequest) error {
+	var errs field.ErrorList
+
+	// The location ID is optional, one is generated when it isn't provided
+	if req.LocationId != "" && !name.ValidResourceID(req.LocationId) {
+		errs = append(errs, field.Invalid(field.NewPath("location_id"), req.LocationId, "invalid location ID"))
+	}
+
+	accountName, _ := name.ParseAccountName(req.Parent)
+	errs = append(errs, validateLocation(accountName.Account, req.Location)...)
+
+	return convertErrorList(errs)
+}
+
+func validateUpdateLocation(req *serverpb.UpdateLocationRequest) error {
+	account, _, _ := name.ParseLocation(req.GetLocation().GetName())
+	return convertErrorList(validateLocation(account, req.Location))
+}
+
+// Validates the location has all of its values set correctly. This will return
+// a list of errors for each field that is invalid. The contacts the location
+// refers to must be in its account.
+func validateLocation(account string, location *serverpb.Location) field.ErrorList {
 	path := field.NewPath("location")
 	if location == nil {
-		return convertErrorList(field.ErrorList{
+		return field.ErrorList{
 			field.Required(path, "location is required"),
-		})
+		}
 	}
 
 	// create a new list for our errors
@@ -122,6 +183,14 @@ func validateCreateLocation(location *serverpb.Location) error {
 	if len(location.Description) > 255 {
 		errs = append(errs, field.Invalid(path.Child("description"), location.Description, "description must not be longer than 255 characters"))
 	}
+	if _, err := loadTimeZone(location.TimeZone); err != nil {
+		errs = append(errs, field.Invalid(path.Child("time_zone"), location.TimeZone, "time_zone must be an IANA time zone, e.g. America/New_York"))
+	}
+	errs = append(errs, validateBusinessHours(path.Child("business_hours"), location.BusinessHours)...)
+	errs = append(errs, validateHolidays(path.Child("holidays"), location.Holidays)...)
+	errs = append(errs, validateEscalationPolicy(path.Child("escalation_policy"), account, location.EscalationPolicy)...)
+	errs = append(errs, validateMessageTTL(path.Child("message_ttl"), location.MessageTtl)...)
+	errs = append(errs, validatePresenceCheck(path.Child("presence_check"), location.PresenceCheck)...)
 
-	return convertErrorList(errs)
+	return errs
 }
@@ -0,0 +1,314 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDHeader is the metadata key that request IDs are read from and
+// returned in. The HTTP gateway maps it to the X-Request-Id header.
+const requestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestID returns the ID of the request being handled with the context.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestIDInterceptor assigns an ID to every unary request. The ID provided
+// by the caller will be used when it is valid, otherwise a new ID will be
+// generated. The ID is returned to the caller in the response headers.
+func requestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := incomingRequestID(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+		return handler(context.WithValue(ctx, requestIDKey{}, id), req)
+	}
+}
+
+// streamRequestIDInterceptor assigns an ID to every streaming request.
+func streamRequestIDInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id := incomingRequestID(ss.Context())
+		ss.SetHeader(metadata.Pairs(requestIDHeader, id))
+		return handler(srv, &wrappedStream{ss, context.WithValue(ss.Context(), requestIDKey{}, id)})
+	}
+}
+
+// incomingRequestID returns the request ID from the incoming metadata, or a
+// new ID when one was not provided or is not valid.
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(requestIDHeader); len(values) > 0 && validRequestID(values[0]) {
+		return values[0]
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		panic(fmt.Sprintf("failed to generate request ID: %v", err))
+	}
+	return hex.EncodeToString(id)
+}
+
+// validRequestID reports whether a request ID provided by a caller is safe to
+// log and return, so it can't be used to inject content into the logs.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for _, char := range id {
+		switch {
+		case char >= 'a' && char <= 'z', char >= 'A' && char <= 'Z', char >= '0' && char <= '9':
+		case char == '-', char == '_', char == '.', char == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// requestLogger writes a JSON record for every request handled by the server
+// and for every panic that is recovered.
+type requestLogger struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+type logEntry struct {
+	Time      string  `json:"time"`
+	Level     string  `json:"level"`
+	Message   string  `json:"msg"`
+	RequestID string  `json:"request_id,omitempty"`
+	TraceID   string  `json:"trace_id,omitempty"`
+	Method    string  `json:"method"`
+	Caller    string  `json:"caller,omitempty"`
+	UserAgent string  `json:"user_agent,omitempty"`
+	Resource  string  `json:"resource,omitempty"`
+	Code      string  `json:"code,omitempty"`
+	LatencyMS float64 `json:"latency_ms,omitempty"`
+	Error     string  `json:"error,omitempty"`
+	Stack     string  `json:"stack,omitempty"`
+}
+
+// newLogEntry creates an entry with the details of the request being handled.
+func newLogEntry(ctx context.Context, level, msg, method string) *logEntry {
+	entry := &logEntry{
+		Time:      time.Now().UTC().Format(time.RFC3339Nano),
+		Level:     level,
+		Message:   msg,
+		RequestID: RequestID(ctx),
+		Method:    method,
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		entry.TraceID = spanContext.TraceID().String()
+	}
+
+	entry.Caller = requestCaller(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+	if userAgent := md.Get("user-agent"); len(userAgent) > 0 {
+		entry.UserAgent = userAgent[0]
+	}
+	return entry
+}
+
+// requestCaller returns the address of the client that made the request.
+// The x-forwarded-for metadata is only used for requests made by the HTTP
+// gateway, since any other client could set it to whatever it wants.
+func requestCaller(ctx context.Context) string {
+	if fromGateway, _ := ctx.Value(gatewayRequestKey{}).(bool); fromGateway {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			// The gateway adds the address of its client after any addresses
+			// the client sent itself, so only the last address is trusted
+			addrs := strings.Split(forwarded[len(forwarded)-1], ",")
+			return strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+type gatewayRequestKey struct{}
+
+// gatewayInterceptor marks the requests made over the connection of the HTTP
+// gateway, so the client address it forwards can be trusted.
+func gatewayInterceptor(gateway net.Addr) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(markGatewayRequest(ctx, gateway), req)
+	}
+}
+
+// streamGatewayInterceptor marks the streams opened over the connection of
+// the HTTP gateway.
+func streamGatewayInterceptor(gateway net.Addr) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedStream{ss, markGatewayRequest(ss.Context(), gateway)})
+	}
+}
+
+func markGatewayRequest(ctx context.Context, gateway net.Addr) context.Context {
+	if gateway == nil {
+		return ctx
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr.Network() == gateway.Network() && p.Addr.String() == gateway.String() {
+		return context.WithValue(ctx, gatewayRequestKey{}, true)
+	}
+	return ctx
+}
+
+// workerLogger writes the JSON records of the background workers, which
+// don't handle requests, in the same format as the request logs.
+var workerLogger = &requestLogger{out: os.Stderr}
+
+// logWork logs something a background worker did outside of a request, such
+// as escalating a message. The worker is recorded as the method.
+func (l *requestLogger) logWork(worker, msg, resource string, err error) {
+	level := "info"
+	if err != nil {
+		level = "error"
+	}
+
+	entry := newLogEntry(context.Background(), level, msg, worker)
+	entry.Resource = resource
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	l.write(entry)
+}
+
+func (l *requestLogger) write(entry *logEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	json.NewEncoder(l.out).Encode(entry)
+}
+
+// accessLogInterceptor logs the result and latency of every unary request.
+// The name, or parent, of the requested resource is included when the
+// request has one.
+func accessLogInterceptor(logger *requestLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logger.logRequest(ctx, info.FullMethod, requestResource(req), start, err)
+		return resp, err
+	}
+}
+
+// streamAccessLogInterceptor logs the result and latency of every streaming
+// request once the stream has completed.
+func streamAccessLogInterceptor(logger *requestLogger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logger.logRequest(ss.Context(), info.FullMethod, "", start, err)
+		return err
+	}
+}
+
+func (l *requestLogger) logRequest(ctx context.Context, method, resource string, start time.Time, err error) {
+	code := status.Code(err)
+	level := "info"
+	if isServerError(code) {
+		level = "error"
+	}
+
+	entry := newLogEntry(ctx, level, "request completed", method)
+	entry.Resource = resource
+	entry.Code = code.String()
+	entry.LatencyMS = float64(time.Since(start)) / float64(time.Millisecond)
+	if err != nil {
+		entry.Error = status.Convert(err).Message()
+	}
+	l.write(entry)
+}
+
+// recoveryInterceptor converts a panic in a unary handler into an Internal
+// error so a single bad request can't crash the server. The panic is logged
+// along with the stack of the handler.
+func recoveryInterceptor(logger *requestLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = logger.logPanic(ctx, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// streamRecoveryInterceptor converts a panic in a streaming handler into an
+// Internal error.
+func streamRecoveryInterceptor(logger *requestLogger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = logger.logPanic(ss.Context(), info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func (l *requestLogger) logPanic(ctx context.Context, method string, recovered interface{}) error {
+	entry := newLogEntry(ctx, "error", "recovered from panic", method)
+	entry.Error = fmt.Sprint(recovered)
+	entry.Stack = string(debug.Stack())
+	l.write(entry)
+
+	// The details of the panic are only logged so internals aren't leaked
+	return status.Error(codes.Internal, "internal error")
+}
+
+// requestResource returns the name of the resource a request is for, or the
+// parent of the resources when it doesn't have a name.
+func requestResource(req interface{}) string {
+	if r, ok := req.(interface{ GetName() string }); ok && r.GetName() != "" {
+		return r.GetName()
+	}
+	if r, ok := req.(interface{ GetParent() string }); ok {
+		return r.GetParent()
+	}
+	return ""
+}
+
+// isServerError reports whether the code indicates the server failed to
+// handle a request, rather than the request being rejected.
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented,
+		codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+// wrappedStream replaces the context of a server stream.
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *wrappedStream) Context() context.Context {
+	return s.ctx
+}
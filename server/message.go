@@ -2,27 +2,680 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/chacerapp/apiserver/metrics"
+	"github.com/chacerapp/apiserver/name"
 	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
-func (*server) ListMessages(ctx context.Context, req *serverpb.ListMessagesRequest) (*serverpb.ListMessagesResponse, error) {
-	return &serverpb.ListMessagesResponse{}, nil
+// The longest time a responder can expect to take to arrive.
+const maxMessageETA = 24 * time.Hour
+
+// The shortest and longest time a message can be shown before it expires.
+const (
+	minMessageTTL = time.Minute
+	maxMessageTTL = 7 * 24 * time.Hour
+)
+
+// messageStateTransitions are the states a message is allowed to move to from
+// each state. Completed, cancelled and expired messages can't move.
+//
+//	ACTIVE       -> ACKNOWLEDGED, DECLINED, COMPLETED, CANCELLED, EXPIRED
+//	ACKNOWLEDGED -> ACTIVE, DECLINED, COMPLETED, CANCELLED, EXPIRED
+//	DECLINED     -> ACTIVE, ACKNOWLEDGED, COMPLETED, CANCELLED, EXPIRED
+var messageStateTransitions = map[serverpb.Message_State][]serverpb.Message_State{
+	serverpb.Message_ACTIVE: {
+		serverpb.Message_ACKNOWLEDGED,
+		serverpb.Message_DECLINED,
+		serverpb.Message_COMPLETED,
+		serverpb.Message_CANCELLED,
+		serverpb.Message_EXPIRED,
+	},
+	serverpb.Message_ACKNOWLEDGED: {
+		serverpb.Message_ACTIVE,
+		serverpb.Message_DECLINED,
+		serverpb.Message_COMPLETED,
+		serverpb.Message_CANCELLED,
+		serverpb.Message_EXPIRED,
+	},
+	serverpb.Message_DECLINED: {
+		serverpb.Message_ACTIVE,
+		serverpb.Message_ACKNOWLEDGED,
+		serverpb.Message_COMPLETED,
+		serverpb.Message_CANCELLED,
+		serverpb.Message_EXPIRED,
+	},
 }
 
-func (*server) SendMessage(ctx context.Context, req *serverpb.SendMessageRequest) (*serverpb.Message, error) {
-	return &serverpb.Message{}, nil
+// messageChanges wakes the message watches of every server in the process
+// when a message is sent or changed.
+var messageChanges = &changeNotifier{}
+
+// canTransitionMessage reports whether a message can move between the states.
+func canTransitionMessage(from, to serverpb.Message_State) bool {
+	for _, allowed := range messageStateTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// transitionMessage moves a message to the state and records the change in
+// its history. A FailedPrecondition error is returned when the message can't
+// move to the state, such as when it has already been completed.
+func transitionMessage(message *serverpb.Message, to serverpb.Message_State, actor string) error {
+	from := message.State
+	if from == to && messageStateTransitions[from] != nil {
+		return nil
+	}
+	if !canTransitionMessage(from, to) {
+		return errFailedPrecondition(fmt.Sprintf("message can not move from %s to %s", from, to))
+	}
+
+	message.State = to
+	message.StateChanges = append(message.StateChanges, &serverpb.MessageStateChange{
+		PreviousState: from,
+		State:         to,
+		Actor:         actor,
+		ChangeTime:    ptypes.TimestampNow(),
+	})
+	return scheduleEscalation(message)
+}
+
+func (s *server) ListMessages(ctx context.Context, req *serverpb.ListMessagesRequest) (*serverpb.ListMessagesResponse, error) {
+	if _, _, err := name.ParseLocation(req.Parent, name.AllowWildcard()); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.store.ListMessages(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(messages) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListMessagesResponse{
+		Messages:      messages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// WatchMessages sends every message in the parent that hasn't reached a
+// terminal state, then the messages that are sent or changed. Like the room
+// state watch, changes made through this server are sent as soon as they're
+// made and changes made through other servers are found by polling.
+func (s *server) WatchMessages(req *serverpb.WatchMessagesRequest, stream serverpb.Messenger_WatchMessagesServer) error {
+	ctx := stream.Context()
+	accountID, locationID, err := name.ParseLocation(req.Parent, name.AllowWildcard())
+	if err != nil {
+		return err
+	}
+
+	// A device is online for as long as it is watching the messages
+	online := metrics.DevicesOnline.WithLabelValues(accountID, locationID)
+	online.Inc()
+	defer online.Dec()
+
+	// Wait for changes from before the messages are read so none are missed
+	changed := messageChanges.wait()
+	cursor := time.Now()
+	messages, err := s.store.ListOutstandingMessages(ctx, req.Parent)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&serverpb.WatchMessagesResponse{Messages: messages}); err != nil {
+		return err
+	}
+
+	// The changes that have been sent, so changes found again within the
+	// overlap aren't sent twice
+	sent := map[string]time.Time{}
+	markSent := func(messages []*serverpb.Message) []*serverpb.Message {
+		var unsent []*serverpb.Message
+		for _, message := range messages {
+			updateTime, err := ptypes.Timestamp(message.UpdateTime)
+			if err != nil {
+				continue
+			}
+			key := message.Name + "@" + updateTime.String()
+			if _, ok := sent[key]; !ok {
+				sent[key] = updateTime
+				unsent = append(unsent, message)
+			}
+			if updateTime.After(cursor) {
+				cursor = updateTime
+			}
+		}
+		return unsent
+	}
+	markSent(messages)
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-changed:
+		}
+		changed = messageChanges.wait()
+
+		since := cursor.Add(-roomWatchOverlap)
+		messages, err := s.store.ListChangedMessages(ctx, req.Parent, since)
+		if err != nil {
+			return err
+		}
+		for key, updateTime := range sent {
+			if updateTime.Before(since) {
+				delete(sent, key)
+			}
+		}
+		if unsent := markSent(messages); len(unsent) > 0 {
+			if err := stream.Send(&serverpb.WatchMessagesResponse{Messages: unsent}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SendMessage sends a message to a location. A message with a send time in
+// the future is stored as a scheduled message that is sent once it is due.
+func (s *server) SendMessage(ctx context.Context, req *serverpb.SendMessageRequest) (*serverpb.Message, error) {
+	sendTime, err := validateSendMessage(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMessagePriority(ctx, req.Message); err != nil {
+		return nil, err
+	}
+	if err := s.checkMessageRecipient(ctx, field.NewPath("message"), req.Message); err != nil {
+		return nil, err
+	}
+	if !sendTime.After(time.Now()) {
+		warnings, err := s.checkRecipientPresence(ctx, req.Parent, req.Message)
+		if err != nil {
+			return nil, err
+		}
+		req.Message.Warnings = warnings
+		return s.sendMessage(ctx, req.Parent, req.Message)
+	}
+
+	scheduled, err := s.createScheduledMessage(ctx, req.Parent, "", &serverpb.ScheduledMessage{
+		Message:   req.Message,
+		StartTime: req.SendTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := scheduled.Message
+	message.Location = req.Parent
+	message.ScheduledMessage = scheduled.Name
+	message.DisplayConfig = priorityDisplayConfig(messagePriority(message))
+	return message, nil
+}
+
+// sendMessage stores a message sent to the location, so it is shown on the
+// devices of the location until it is completed, cancelled or expires.
+func (s *server) sendMessage(ctx context.Context, parent string, message *serverpb.Message) (*serverpb.Message, error) {
+	location, err := s.store.GetLocation(ctx, parent)
+	if err != nil {
+		return nil, err
+	} else if location == nil {
+		return nil, errNotFound
+	}
+	recipients, err := s.messageRecipients(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	held, err := s.heldRecipients(ctx, message, recipients)
+	if err != nil {
+		return nil, err
+	}
+	if err := prepareMessage(location, message, recipients, held, requestActor(ctx)); err != nil {
+		return nil, err
+	}
+
+	// A generated ID is retried when it collides with an existing message
+	for attempt := 0; attempt < generatedIDAttempts; attempt++ {
+		var err error
+		if message.Name, err = generateMessageName(parent, message); err != nil {
+			return nil, err
+		}
+
+		created, err := s.store.CreateMessage(ctx, message)
+		if err != nil {
+			return nil, err
+		} else if created != nil {
+			messageChanges.notify()
+			return created, nil
+		}
+	}
+
+	return nil, errAlreadyExists
+}
+
+// prepareMessage sets the fields of a message that is being sent to the
+// location, so it is stored as ACTIVE with the display config of its priority,
+// the time it expires and a delivery for each of its recipients. Deliveries to
+// the held recipients are held rather than pending, and emergency messages are
+// broadcast to every device in the location. The actor is recorded as the
+// caller that sent it.
+func prepareMessage(location *serverpb.Location, message *serverpb.Message, recipients []string, held map[string]bool, actor string) error {
+	expireTime, err := messageExpireTime(location, message, time.Now())
+	if err != nil {
+		return err
+	} else if expireTime != nil {
+		message.Expiration = &serverpb.Message_ExpireTime{ExpireTime: expireTime}
+	}
+
+	message.Location = location.Name
+	message.Priority = messagePriority(message)
+	message.DisplayConfig = priorityDisplayConfig(message.Priority)
+	message.Responses = nil
+	message.Escalations = nil
+	message.EscalationPolicy = location.EscalationPolicy
+	message.Broadcast = message.Priority == serverpb.MessagePriority_MESSAGE_PRIORITY_EMERGENCY
+	message.ClaimedBy = ""
+	message.Deliveries = nil
+	for _, recipient := range recipients {
+		addMessageDelivery(message, recipient)
+		if held[recipient] {
+			holdMessageDelivery(message, recipient)
+		}
+	}
+	message.State = serverpb.Message_ACTIVE
+	message.StateChanges = []*serverpb.MessageStateChange{{
+		State:      serverpb.Message_ACTIVE,
+		Actor:      actor,
+		ChangeTime: ptypes.TimestampNow(),
+	}}
+	return scheduleEscalation(message)
+}
+
+// messageExpireTime returns when a message sent at the time expires. A message
+// with a TTL expires that long after it is sent, and a message without an
+// expire time or a TTL uses the message TTL of its location. A nil time is
+// returned when the message doesn't expire.
+func messageExpireTime(location *serverpb.Location, message *serverpb.Message, sent time.Time) (*timestamp.Timestamp, error) {
+	if expireTime := message.GetExpireTime(); expireTime != nil {
+		return expireTime, nil
+	}
+
+	ttl := message.GetTtl()
+	if ttl == nil {
+		ttl = location.MessageTtl
+	}
+	if ttl == nil {
+		return nil, nil
+	}
+	d, err := ptypes.Duration(ttl)
+	if err != nil {
+		return nil, err
+	}
+	return ptypes.TimestampProto(sent.Add(d))
+}
+
+// generateMessageName generates the name of a message sent to the location
+// from its reason.
+func generateMessageName(parent string, message *serverpb.Message) (string, error) {
+	id, err := generateResourceID(message.Reason, "message")
+	if err != nil {
+		return "", err
+	}
+	return name.BuildRelativeName(parent, name.CollectionMessage, id), nil
 }
 
 func (*server) GenerateMessage(ctx context.Context, req *serverpb.GenerateMessageRequest) (*serverpb.Message, error) {
 	return &serverpb.Message{}, nil
 }
 
-func (*server) CompleteMessage(ctx context.Context, req *serverpb.CompleteMessageRequest) (*empty.Empty, error) {
+func (s *server) CompleteMessage(ctx context.Context, req *serverpb.CompleteMessageRequest) (*empty.Empty, error) {
+	if _, err := s.setMessageState(ctx, req.Name, serverpb.Message_COMPLETED); err != nil {
+		return nil, err
+	}
 	return &empty.Empty{}, nil
 }
 
-func (*server) CancelMessage(ctx context.Context, req *serverpb.CancelMessageRequest) (*empty.Empty, error) {
+func (s *server) CancelMessage(ctx context.Context, req *serverpb.CancelMessageRequest) (*empty.Empty, error) {
+	if _, err := s.setMessageState(ctx, req.Name, serverpb.Message_CANCELLED); err != nil {
+		return nil, err
+	}
 	return &empty.Empty{}, nil
 }
+
+// setMessageState moves a message to the state. A NotFound error is returned
+// when the message does not exist.
+func (s *server) setMessageState(ctx context.Context, messageName string, state serverpb.Message_State) (*serverpb.Message, error) {
+	if _, err := name.ParseMessageName(messageName); err != nil {
+		return nil, err
+	}
+
+	actor := requestActor(ctx)
+	message, err := s.store.UpdateMessage(ctx, messageName, func(existing *serverpb.Message) error {
+		return transitionMessage(existing, state, actor)
+	})
+	if err != nil {
+		return nil, err
+	} else if message == nil {
+		return nil, errNotFound
+	}
+
+	messageChanges.notify()
+	return message, nil
+}
+
+// AcknowledgeMessage records the response of a recipient to a message,
+// replacing any earlier response of the same responder.
+func (s *server) AcknowledgeMessage(ctx context.Context, req *serverpb.AcknowledgeMessageRequest) (*serverpb.Message, error) {
+	if err := validateAcknowledgeMessage(req); err != nil {
+		return nil, err
+	}
+
+	response := &serverpb.MessageResponse{
+		Responder:    req.Responder,
+		State:        req.Response,
+		Eta:          req.Eta,
+		ResponseTime: ptypes.TimestampNow(),
+	}
+	actor := requestActor(ctx)
+	message, err := s.store.UpdateMessage(ctx, req.Name, func(existing *serverpb.Message) error {
+		if err := checkMessageResponder(existing, req.Responder); err != nil {
+			return err
+		}
+		return recordMessageResponse(existing, response, actor)
+	})
+	if err != nil {
+		return nil, err
+	} else if message == nil {
+		return nil, errNotFound
+	}
+
+	messageChanges.notify()
+	return message, nil
+}
+
+// recordMessageResponse adds the response to the message in place of any
+// earlier response of the responder, and records it on the delivery to the
+// responder. The message moves to ACKNOWLEDGED while any responder has
+// acknowledged it, and to DECLINED once every contact it was delivered to has
+// declined it. Until then it stays ACTIVE.
+func recordMessageResponse(message *serverpb.Message, response *serverpb.MessageResponse, actor string) error {
+	if message.ClaimMode == serverpb.MessageClaimMode_MESSAGE_CLAIM_MODE_ANY_MEMBER && message.ClaimedBy != "" && message.ClaimedBy != response.Responder {
+		return errFailedPrecondition(fmt.Sprintf("message has already been claimed by %s", message.ClaimedBy))
+	}
+
+	responses := []*serverpb.MessageResponse{response}
+	for _, existing := range message.Responses {
+		if existing.Responder != response.Responder {
+			responses = append(responses, existing)
+		}
+	}
+
+	state := serverpb.Message_DECLINED
+	for _, r := range responses {
+		if r.State == serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_ACKNOWLEDGED {
+			state = serverpb.Message_ACKNOWLEDGED
+			break
+		}
+	}
+	if state == serverpb.Message_DECLINED && awaitingResponses(message, response.Responder) {
+		state = serverpb.Message_ACTIVE
+	}
+
+	if err := transitionMessage(message, state, actor); err != nil {
+		return err
+	}
+	message.Responses = responses
+
+	for _, delivery := range message.Deliveries {
+		if delivery.Recipient == response.Responder {
+			delivery.State = serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_DELIVERED
+			delivery.Response = response.State
+			delivery.UpdateTime = response.ResponseTime
+		}
+	}
+	if message.ClaimMode == serverpb.MessageClaimMode_MESSAGE_CLAIM_MODE_ANY_MEMBER {
+		claimMessage(message, response)
+	}
+	return nil
+}
+
+// awaitingResponses reports whether a contact the message was delivered to,
+// other than the responder, hasn't declined it. Contacts it's held from aren't
+// waited for.
+func awaitingResponses(message *serverpb.Message, responder string) bool {
+	for _, delivery := range message.Deliveries {
+		if delivery.Recipient == responder || delivery.State == serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_HELD {
+			continue
+		}
+		if delivery.Response != serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_DECLINED {
+			return true
+		}
+	}
+	return false
+}
+
+// claimMessage gives a message sent to any member of a contact group to the
+// member that acknowledged it, and withdraws it from the other members. The
+// claim is released, and the message delivered to the other members again,
+// when the member that claimed it declines it.
+func claimMessage(message *serverpb.Message, response *serverpb.MessageResponse) {
+	switch response.State {
+	case serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_ACKNOWLEDGED:
+		message.ClaimedBy = response.Responder
+	case serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_DECLINED:
+		if message.ClaimedBy != response.Responder {
+			return
+		}
+		message.ClaimedBy = ""
+	}
+
+	for _, delivery := range message.Deliveries {
+		if delivery.Recipient == response.Responder {
+			continue
+		}
+		withdrawn := delivery.State == serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_WITHDRAWN
+		if delivery.State == serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_HELD {
+			continue
+		} else if message.ClaimedBy != "" && !withdrawn {
+			delivery.State = serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_WITHDRAWN
+			delivery.UpdateTime = response.ResponseTime
+		} else if message.ClaimedBy == "" && withdrawn {
+			delivery.State = serverpb.MessageDeliveryState_MESSAGE_DELIVERY_STATE_PENDING
+			delivery.UpdateTime = response.ResponseTime
+		}
+	}
+}
+
+// checkMessageResponder checks the responder is a recipient of the message,
+// which is a contact it was delivered to when it was sent to them or their
+// contact group, or redirected to them. Anyone can respond to a message that
+// wasn't sent to a recipient.
+func checkMessageResponder(message *serverpb.Message, responder string) error {
+	if message.Recipient == "" || message.Recipient == responder {
+		return nil
+	}
+	for _, delivery := range message.Deliveries {
+		if delivery.Recipient == responder {
+			return nil
+		}
+	}
+
+	return convertErrorList(field.ErrorList{field.Invalid(field.NewPath("responder"), responder, "responder is not a recipient of the message")})
+}
+
+// validateSendMessage validates the message and returns the time it will be
+// sent, which is the current time when the send time isn't in the future.
+func validateSendMessage(req *serverpb.SendMessageRequest) (time.Time, error) {
+	var errs field.ErrorList
+	location, err := name.ParseLocationName(req.Parent)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("parent"), req.Parent, err.Error()))
+	}
+
+	sendTime := time.Now()
+	if req.SendTime != nil {
+		if t, err := ptypes.Timestamp(req.SendTime); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("send_time"), req.SendTime.String(), err.Error()))
+		} else if t.After(sendTime) {
+			sendTime = t
+		}
+	}
+
+	if req.Message == nil {
+		errs = append(errs, field.Required(field.NewPath("message"), "message is required"))
+	} else if err == nil {
+		errs = append(errs, validateMessage(field.NewPath("message"), req.Message, location, sendTime)...)
+	}
+	return sendTime, convertErrorList(errs)
+}
+
+// validateMessage validates a message that will be sent to the location at
+// the send time.
+func validateMessage(path *field.Path, message *serverpb.Message, location name.LocationName, sendTime time.Time) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateMessageRecipient(path, message, location)...)
+	if message.Sender != "" {
+		if sender, err := name.ParseContactName(message.Sender); err != nil {
+			errs = append(errs, field.Invalid(path.Child("sender"), message.Sender, err.Error()))
+		} else if sender.Account != location.Account {
+			errs = append(errs, field.Invalid(path.Child("sender"), message.Sender, "contact must be in the account of the message"))
+		}
+	}
+	if message.RequestedRoom != "" {
+		if room, err := name.ParseRoomName(message.RequestedRoom); err != nil {
+			errs = append(errs, field.Invalid(path.Child("requested_room"), message.RequestedRoom, err.Error()))
+		} else if room.Parent() != location {
+			errs = append(errs, field.Invalid(path.Child("requested_room"), message.RequestedRoom, "room must be in the location of the message"))
+		}
+	}
+	if len(message.Reason) > 100 {
+		errs = append(errs, field.Invalid(path.Child("reason"), message.Reason, "reason must not be longer than 100 characters"))
+	}
+	if len(message.Description) > 1024 {
+		errs = append(errs, field.Invalid(path.Child("description"), message.Description, "description must not be longer than 1024 characters"))
+	}
+
+	errs = append(errs, validateMessagePriority(path.Child("priority"), message.Priority)...)
+
+	switch expiration := message.Expiration.(type) {
+	case *serverpb.Message_ExpireTime:
+		if expireTime, err := ptypes.Timestamp(expiration.ExpireTime); err != nil {
+			errs = append(errs, field.Invalid(path.Child("expire_time"), expiration.ExpireTime.String(), err.Error()))
+		} else if !expireTime.After(sendTime) {
+			errs = append(errs, field.Invalid(path.Child("expire_time"), expireTime.Format(time.RFC3339), "expire_time must be after the message is sent"))
+		}
+	case *serverpb.Message_Ttl:
+		errs = append(errs, validateMessageTTL(path.Child("ttl"), expiration.Ttl)...)
+	}
+	return errs
+}
+
+// validateMessageRecipient validates the recipient of a message, which is
+// either a contact or a contact group in the account of the location. A claim
+// mode can only be set for a contact group.
+func validateMessageRecipient(path *field.Path, message *serverpb.Message, location name.LocationName) field.ErrorList {
+	var errs field.ErrorList
+	isGroup := false
+	if message.Recipient != "" {
+		if group, err := name.ParseContactGroupName(message.Recipient); err == nil {
+			isGroup = true
+			if group.Account != location.Account {
+				errs = append(errs, field.Invalid(path.Child("recipient"), message.Recipient, "contact group must be in the account of the message"))
+			}
+		} else if contact, err := name.ParseContactName(message.Recipient); err != nil {
+			errs = append(errs, field.Invalid(path.Child("recipient"), message.Recipient, "recipient must be a contact or a contact group"))
+		} else if contact.Account != location.Account {
+			errs = append(errs, field.Invalid(path.Child("recipient"), message.Recipient, "contact must be in the account of the message"))
+		}
+	}
+
+	switch message.ClaimMode {
+	case serverpb.MessageClaimMode_MESSAGE_CLAIM_MODE_UNSPECIFIED:
+	case serverpb.MessageClaimMode_MESSAGE_CLAIM_MODE_ALL_MEMBERS, serverpb.MessageClaimMode_MESSAGE_CLAIM_MODE_ANY_MEMBER:
+		if !isGroup {
+			errs = append(errs, field.Forbidden(path.Child("claim_mode"), "claim_mode can only be set when the recipient is a contact group"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(path.Child("claim_mode"), message.ClaimMode.String(), []string{
+			serverpb.MessageClaimMode_MESSAGE_CLAIM_MODE_ALL_MEMBERS.String(),
+			serverpb.MessageClaimMode_MESSAGE_CLAIM_MODE_ANY_MEMBER.String(),
+		}))
+	}
+	return errs
+}
+
+// validateMessageTTL validates how long a message is shown before it expires.
+// A TTL that isn't set is valid since messages don't have to expire.
+func validateMessageTTL(path *field.Path, ttl *duration.Duration) field.ErrorList {
+	if ttl == nil {
+		return nil
+	}
+	if d, err := ptypes.Duration(ttl); err != nil || d < minMessageTTL || d > maxMessageTTL || d%time.Second != 0 {
+		return field.ErrorList{field.Invalid(path, ttl.String(), "must be a whole number of seconds between 1 minute and 7 days")}
+	}
+	return nil
+}
+
+func validateAcknowledgeMessage(req *serverpb.AcknowledgeMessageRequest) error {
+	var errs field.ErrorList
+	messageName, err := name.ParseMessageName(req.Name)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("name"), req.Name, err.Error()))
+	}
+	if req.Responder == "" {
+		errs = append(errs, field.Required(field.NewPath("responder"), "responder is required"))
+	} else if responder, err := name.ParseContactName(req.Responder); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("responder"), req.Responder, err.Error()))
+	} else if messageName.Account != "" && responder.Account != messageName.Account {
+		errs = append(errs, field.Invalid(field.NewPath("responder"), req.Responder, "responder must be in the account of the message"))
+	}
+
+	switch req.Response {
+	case serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_UNSPECIFIED:
+		errs = append(errs, field.Required(field.NewPath("response"), "response is required"))
+	case serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_ACKNOWLEDGED:
+		if req.Eta == nil {
+			break
+		}
+		if eta, err := ptypes.Duration(req.Eta); err != nil || eta < 0 || eta > maxMessageETA {
+			errs = append(errs, field.Invalid(field.NewPath("eta"), req.Eta.String(), "eta must be between 0 and 24 hours"))
+		}
+	case serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_DECLINED:
+		if req.Eta != nil {
+			errs = append(errs, field.Forbidden(field.NewPath("eta"), "eta can only be set when acknowledging a message"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(field.NewPath("response"), req.Response.String(), []string{
+			serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_ACKNOWLEDGED.String(),
+			serverpb.MessageResponseState_MESSAGE_RESPONSE_STATE_DECLINED.String(),
+		}))
+	}
+	return convertErrorList(errs)
+}
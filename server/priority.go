@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/type/color"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The permission needed to send a message with the emergency priority.
+const permissionSendEmergency = "messenger.messages.sendEmergency"
+
+// priorityDisplayConfigs are how messages of each priority are displayed on
+// devices. Colors are stored as RGB values between 0 and 1.
+var priorityDisplayConfigs = map[serverpb.MessagePriority]*serverpb.Message_DisplayConfig{
+	serverpb.MessagePriority_MESSAGE_PRIORITY_ROUTINE: {
+		BackgroundColor: &color.Color{Red: 0.93, Green: 0.94, Blue: 0.95},
+		ForegroundColor: &color.Color{Red: 0.2, Green: 0.23, Blue: 0.27},
+		BorderColor:     &color.Color{Red: 0.8, Green: 0.82, Blue: 0.85},
+		Sound:           "sounds/chime",
+	},
+	serverpb.MessagePriority_MESSAGE_PRIORITY_NORMAL: {
+		BackgroundColor: &color.Color{Red: 0.86, Green: 0.92, Blue: 1},
+		ForegroundColor: &color.Color{Red: 0.05, Green: 0.2, Blue: 0.45},
+		BorderColor:     &color.Color{Red: 0.2, Green: 0.45, Blue: 0.85},
+		Sound:           "sounds/alert",
+	},
+	serverpb.MessagePriority_MESSAGE_PRIORITY_URGENT: {
+		BackgroundColor: &color.Color{Red: 1, Green: 0.9, Blue: 0.7},
+		ForegroundColor: &color.Color{Red: 0.45, Green: 0.25},
+		BorderColor:     &color.Color{Red: 0.95, Green: 0.55},
+		Sound:           "sounds/urgent",
+	},
+	serverpb.MessagePriority_MESSAGE_PRIORITY_EMERGENCY: {
+		BackgroundColor: &color.Color{Red: 0.85, Green: 0.1, Blue: 0.1},
+		ForegroundColor: &color.Color{Red: 1, Green: 1, Blue: 1},
+		BorderColor:     &color.Color{Red: 0.5},
+		Sound:           "sounds/siren",
+	},
+}
+
+// messagePriority returns the priority of the message, treating a message
+// without a priority as normal.
+func messagePriority(message *serverpb.Message) serverpb.MessagePriority {
+	if message.Priority == serverpb.MessagePriority_MESSAGE_PRIORITY_UNSPECIFIED {
+		return serverpb.MessagePriority_MESSAGE_PRIORITY_NORMAL
+	}
+	return message.Priority
+}
+
+// priorityDisplayConfig returns the display config used for messages of the
+// priority. A copy is returned so it can be changed by the caller without
+// changing the config of every message of the priority.
+func priorityDisplayConfig(priority serverpb.MessagePriority) *serverpb.Message_DisplayConfig {
+	config, ok := priorityDisplayConfigs[priority]
+	if !ok {
+		config = priorityDisplayConfigs[serverpb.MessagePriority_MESSAGE_PRIORITY_NORMAL]
+	}
+	return proto.Clone(config).(*serverpb.Message_DisplayConfig)
+}
+
+// bypassesDoNotDisturb reports whether messages of the priority are delivered
+// to contacts that don't want to be disturbed.
+func bypassesDoNotDisturb(priority serverpb.MessagePriority) bool {
+	return priority >= serverpb.MessagePriority_MESSAGE_PRIORITY_URGENT
+}
+
+// heldRecipients returns the recipients of a message that it is held from
+// because they don't want to be disturbed. Nothing is held for urgent and
+// emergency messages.
+func (s *server) heldRecipients(ctx context.Context, message *serverpb.Message, recipients []string) (map[string]bool, error) {
+	if bypassesDoNotDisturb(messagePriority(message)) {
+		return nil, nil
+	}
+
+	held := map[string]bool{}
+	for _, recipient := range recipients {
+		contact, err := s.store.GetContact(ctx, recipient)
+		if err != nil {
+			return nil, err
+		}
+		if contact != nil && contact.DoNotDisturb {
+			held[recipient] = true
+		}
+	}
+	return held, nil
+}
+
+func validateMessagePriority(path *field.Path, priority serverpb.MessagePriority) field.ErrorList {
+	if _, ok := serverpb.MessagePriority_name[int32(priority)]; !ok {
+		return field.ErrorList{field.NotSupported(path, priority.String(), []string{
+			serverpb.MessagePriority_MESSAGE_PRIORITY_ROUTINE.String(),
+			serverpb.MessagePriority_MESSAGE_PRIORITY_NORMAL.String(),
+			serverpb.MessagePriority_MESSAGE_PRIORITY_URGENT.String(),
+			serverpb.MessagePriority_MESSAGE_PRIORITY_EMERGENCY.String(),
+		})}
+	}
+	return nil
+}
+
+// checkMessagePriority returns a PermissionDenied error when the caller
+// isn't allowed to send a message with its priority.
+func checkMessagePriority(ctx context.Context, message *serverpb.Message) error {
+	if message.Priority == serverpb.MessagePriority_MESSAGE_PRIORITY_EMERGENCY && !hasPermission(ctx, permissionSendEmergency) {
+		return status.Errorf(codes.PermissionDenied, "sending an emergency message requires the %s permission", permissionSendEmergency)
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chacerapp/apiserver/metrics"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// rateLimitQuotasTTL is how long the quotas of an account are cached for
+	// before they are read from storage again.
+	rateLimitQuotasTTL = 30 * time.Second
+
+	// rateLimitQuotasErrorTTL is how long a failure to read the quotas of an
+	// account is cached for, so a failing storage isn't read on every request.
+	rateLimitQuotasErrorTTL = 5 * time.Second
+
+	// rateLimitSweepInterval is how often buckets that have refilled are
+	// removed so idle accounts and callers don't hold on to memory.
+	rateLimitSweepInterval = time.Minute
+)
+
+// defaultRateLimitTiers are the limits applied to the requests for an account
+// based on the tier in its quotas. Accounts without a tier use the standard
+// limits, and the unlimited tier has no limits.
+var defaultRateLimitTiers = map[serverpb.RateLimitTier][]*serverpb.RateLimit{
+	serverpb.RateLimitTier_RATE_LIMIT_TIER_STANDARD: {
+		{Method: "*", RequestsPerSecond: 20, Burst: 40},
+		{Method: "chacerapp.v1.Messenger/SendMessage", RequestsPerSecond: 5, Burst: 10},
+		{Method: "chacerapp.v1.Messenger/GenerateMessage", RequestsPerSecond: 5, Burst: 10},
+	},
+	serverpb.RateLimitTier_RATE_LIMIT_TIER_PREMIUM: {
+		{Method: "*", RequestsPerSecond: 100, Burst: 200},
+		{Method: "chacerapp.v1.Messenger/SendMessage", RequestsPerSecond: 25, Burst: 50},
+		{Method: "chacerapp.v1.Messenger/GenerateMessage", RequestsPerSecond: 25, Burst: 50},
+	},
+	serverpb.RateLimitTier_RATE_LIMIT_TIER_UNLIMITED: nil,
+}
+
+// defaultCallerRateLimits are the limits applied to the requests of every
+// caller, regardless of the accounts the requests are made for.
+var defaultCallerRateLimits = []*serverpb.RateLimit{
+	{Method: "*", RequestsPerSecond: 200, Burst: 400},
+	{Method: "chacerapp.v1.Messenger/SendMessage", RequestsPerSecond: 50, Burst: 100},
+	{Method: "chacerapp.v1.Messenger/GenerateMessage", RequestsPerSecond: 50, Burst: 100},
+}
+
+// RateLimiter limits the rate of the requests made for each account and by
+// each caller with a token bucket per method. The limits of an account are
+// determined by the tier and overrides in its quotas. Requests that exceed a
+// limit are rejected with a ResourceExhausted error that includes when the
+// request can be retried.
+type RateLimiter struct {
+	store store.Storage
+	now   func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*rate.Limiter
+	quotas    map[string]cachedQuotas
+	lastSweep time.Time
+}
+
+type cachedQuotas struct {
+	quotas  *serverpb.AccountQuotas
+	expires time.Time
+}
+
+// NewRateLimiter creates a RateLimiter that reads the quotas of accounts from
+// the storage.
+func NewRateLimiter(storage store.Storage) *RateLimiter {
+	return &RateLimiter{
+		store:     storage,
+		now:       time.Now,
+		buckets:   map[string]*rate.Limiter{},
+		quotas:    map[string]cachedQuotas{},
+		lastSweep: time.Now(),
+	}
+}
+
+// rateLimitInterceptor rejects unary requests that exceed the rate limits of
+// the account they are made for or of the caller.
+func rateLimitInterceptor(limiter *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := limiter.allow(ctx, info.FullMethod, requestResource(req)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamRateLimitInterceptor rejects streaming requests that exceed the rate
+// limits of the caller.
+func streamRateLimitInterceptor(limiter *RateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := limiter.allow(ss.Context(), info.FullMethod, ""); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// allow takes a token from the buckets of the account and the caller for the
+// method. No tokens are taken unless the request is allowed by both.
+func (l *RateLimiter) allow(ctx context.Context, fullMethod, resource string) error {
+	// Only the API services are limited so health checks are always answered
+	if !strings.HasPrefix(fullMethod, "/chacerapp.") {
+		return nil
+	}
+	method := strings.TrimPrefix(fullMethod, "/")
+
+	var accountLimit *serverpb.RateLimit
+	account := resourceAccount(resource)
+	if account != "" {
+		accountLimit = findRateLimit(l.accountRateLimits(ctx, account), method)
+	}
+	// Callers with a client certificate are limited by its identity, so they
+	// share a bucket wherever they connect from, and anyone else by address
+	caller := requestActor(ctx)
+	callerLimit := findRateLimit(defaultCallerRateLimits, method)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.sweepLocked(now)
+
+	var reservations []*rate.Reservation
+	check := func(kind, subject string, limit *serverpb.RateLimit) error {
+		if subject == "" || limit == nil {
+			return nil
+		}
+		r := l.bucketLocked(kind+":"+subject+":"+method, limit, now).ReserveN(now, 1)
+		if delay := r.DelayFrom(now); !r.OK() || delay > 0 {
+			r.CancelAt(now)
+			for _, reserved := range reservations {
+				reserved.CancelAt(now)
+			}
+			metrics.RateLimited(fullMethod, kind)
+			return errRateLimited(subject, method, delay)
+		}
+		reservations = append(reservations, r)
+		return nil
+	}
+	if err := check("account", account, accountLimit); err != nil {
+		return err
+	}
+	return check("caller", caller, callerLimit)
+}
+
+// bucketLocked returns the bucket for the key, creating it when needed. The
+// rate and burst of an existing bucket are updated when the limit has changed.
+func (l *RateLimiter) bucketLocked(key string, limit *serverpb.RateLimit, now time.Time) *rate.Limiter {
+	perSecond, burst := rate.Limit(limit.RequestsPerSecond), int(limit.Burst)
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = rate.NewLimiter(perSecond, burst)
+		l.buckets[key] = bucket
+	}
+	if bucket.Limit() != perSecond {
+		bucket.SetLimitAt(now, perSecond)
+	}
+	if bucket.Burst() != burst {
+		bucket.SetBurstAt(now, burst)
+	}
+	return bucket
+}
+
+// sweepLocked removes the buckets that have refilled since a full bucket is
+// the same as a new one.
+func (l *RateLimiter) sweepLocked(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+			delete(l.buckets, key)
+		}
+	}
+	for account, cached := range l.quotas {
+		if now.After(cached.expires) {
+			delete(l.quotas, account)
+		}
+	}
+}
+
+// accountRateLimits returns the limits for the account, which are the limits
+// of its tier along with any overrides in its quotas. The overrides are listed
+// first so they take precedence.
+func (l *RateLimiter) accountRateLimits(ctx context.Context, account string) []*serverpb.RateLimit {
+	quotas := l.accountQuotas(ctx, account)
+	tier := quotas.GetRateLimitTier()
+	if tier == serverpb.RateLimitTier_RATE_LIMIT_TIER_UNSPECIFIED {
+		tier = serverpb.RateLimitTier_RATE_LIMIT_TIER_STANDARD
+	}
+	if tier == serverpb.RateLimitTier_RATE_LIMIT_TIER_UNLIMITED {
+		return quotas.GetRateLimits()
+	}
+	return append(append([]*serverpb.RateLimit{}, quotas.GetRateLimits()...), defaultRateLimitTiers[tier]...)
+}
+
+// accountQuotas returns the cached quotas of the account, reading them from
+// storage once they have expired. Nil is returned when the account does not
+// exist, or its quotas can't be read, so the standard limits will apply.
+func (l *RateLimiter) accountQuotas(ctx context.Context, account string) *serverpb.AccountQuotas {
+	l.mu.Lock()
+	cached, ok := l.quotas[account]
+	l.mu.Unlock()
+	if ok && l.now().Before(cached.expires) {
+		return cached.quotas
+	}
+
+	// Requests are limited with the standard limits, rather than rejected,
+	// when the quotas can't be read
+	ttl := rateLimitQuotasTTL
+	existing, err := l.store.GetAccount(ctx, account)
+	if err != nil {
+		existing, ttl = nil, rateLimitQuotasErrorTTL
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.quotas[account] = cachedQuotas{
+		quotas:  existing.GetQuotas(),
+		expires: l.now().Add(ttl),
+	}
+	return existing.GetQuotas()
+}
+
+// invalidate removes the cached quotas of the account so updated limits are
+// applied to the next request. It is safe to call on a nil RateLimiter.
+func (l *RateLimiter) invalidate(account string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.quotas, account)
+}
+
+// findRateLimit returns the first limit for the method, or for every method,
+// in the list of limits. Nil is returned when the method isn't limited.
+func findRateLimit(limits []*serverpb.RateLimit, method string) *serverpb.RateLimit {
+	var wildcard *serverpb.RateLimit
+	for _, limit := range limits {
+		if limit.Method == method {
+			return limit
+		} else if limit.Method == "*" && wildcard == nil {
+			wildcard = limit
+		}
+	}
+	return wildcard
+}
+
+// validMethodName reports whether the name, e.g.
+// chacerapp.v1.Messenger/SendMessage, is a method of one of the API services.
+func validMethodName(method string) bool {
+	parts := strings.Split(method, "/")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "chacerapp.") {
+		return false
+	}
+	descr, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(parts[0]))
+	if err != nil {
+		return false
+	}
+	service, ok := descr.(protoreflect.ServiceDescriptor)
+	return ok && service.Methods().ByName(protoreflect.Name(parts[1])) != nil
+}
+
+// resourceAccount returns the name of the account that a resource belongs to,
+// or an empty string when the resource doesn't belong to a single account.
+func resourceAccount(resource string) string {
+	segments := strings.SplitN(resource, "/", 3)
+	if len(segments) < 2 || segments[0] != "accounts" || segments[1] == "" || segments[1] == "-" {
+		return ""
+	}
+	return segments[0] + "/" + segments[1]
+}
+
+func errRateLimited(subject, method string, delay time.Duration) error {
+	s, err := status.New(codes.ResourceExhausted, "rate limit exceeded").
+		WithDetails(
+			&errdetails.QuotaFailure{
+				Violations: []*errdetails.QuotaFailure_Violation{{
+					Subject:     subject,
+					Description: fmt.Sprintf("rate limit exceeded for %s", method),
+				}},
+			},
+			&errdetails.RetryInfo{
+				RetryDelay: ptypes.DurationProto(delay),
+			},
+		)
+	if err != nil {
+		return err
+	}
+	return s.Err()
+}
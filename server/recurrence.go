@@ -0,0 +1,208 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The furthest after the later of the start of a recurrence and the time
+// being checked that an occurrence is looked for.
+const recurrenceHorizonDays = 4 * 366
+
+// The largest INTERVAL a recurrence rule can use.
+const maxRecurrenceInterval = 366
+
+// recurrenceWeekdays are the days of the week used by the BYDAY part of a
+// recurrence rule.
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// recurrence is the supported subset of an RFC 5545 recurrence rule.
+type recurrence struct {
+	freq       string
+	interval   int
+	byDay      map[time.Weekday]bool
+	byMonthDay []int
+	count      int
+	until      time.Time
+}
+
+// parseRecurrence parses a recurrence rule such as
+// `FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE`. A nil recurrence is returned for an
+// empty rule.
+func parseRecurrence(rule string) (*recurrence, error) {
+	if rule == "" {
+		return nil, nil
+	}
+
+	r := &recurrence{interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(strings.TrimPrefix(rule, "RRULE:"), ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("%q must be in the format NAME=VALUE", part)
+		}
+		key, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		if seen[key] {
+			return nil, fmt.Errorf("%s can only be set once", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			switch value {
+			case "DAILY", "WEEKLY", "MONTHLY":
+				r.freq = value
+			default:
+				return nil, fmt.Errorf("FREQ must be DAILY, WEEKLY or MONTHLY")
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 || interval > maxRecurrenceInterval {
+				return nil, fmt.Errorf("INTERVAL must be between 1 and %d", maxRecurrenceInterval)
+			}
+			r.interval = interval
+		case "BYDAY":
+			r.byDay = map[time.Weekday]bool{}
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := recurrenceWeekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("BYDAY must be a list of MO, TU, WE, TH, FR, SA or SU")
+				}
+				r.byDay[weekday] = true
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay == 0 || monthDay < -31 || monthDay > 31 {
+					return nil, fmt.Errorf("BYMONTHDAY must be a list of days between 1 and 31 or -31 and -1")
+				}
+				r.byMonthDay = append(r.byMonthDay, monthDay)
+			}
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("COUNT must be a positive number")
+			}
+			r.count = count
+		case "UNTIL":
+			until, err := parseRecurrenceTime(value)
+			if err != nil {
+				return nil, err
+			}
+			r.until = until
+		default:
+			return nil, fmt.Errorf("%s is not supported", key)
+		}
+	}
+
+	if r.freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	} else if r.count > 0 && !r.until.IsZero() {
+		return nil, fmt.Errorf("COUNT and UNTIL can not both be set")
+	} else if r.freq == "WEEKLY" && r.byMonthDay != nil {
+		return nil, fmt.Errorf("BYMONTHDAY can not be used with FREQ=WEEKLY")
+	}
+	return r, nil
+}
+
+// parseRecurrenceTime parses the UNTIL time of a rule, either a UTC time such
+// as 20261231T235959Z or a date such as 20261231 which includes the whole day.
+func parseRecurrenceTime(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("UNTIL must be a UTC time such as 20261231T235959Z or a date such as 20261231")
+}
+
+// nextOccurrence returns the first time at or after the time that a schedule
+// starting at start occurs, with its time of day kept in the time zone. The
+// start is always the first occurrence. False is returned when the schedule
+// has ended by the time.
+func nextOccurrence(r *recurrence, start, after time.Time, tz *time.Location) (time.Time, bool) {
+	start = start.In(tz)
+	if !start.Before(after) {
+		return start, true
+	} else if r == nil {
+		return time.Time{}, false
+	}
+
+	startDate := dateOf(start)
+	endDate := dateOf(after.In(tz)).AddDate(0, 0, recurrenceHorizonDays)
+	occurrences := 1
+	for day := startDate.AddDate(0, 0, 1); !day.After(endDate); day = day.AddDate(0, 0, 1) {
+		if !r.matches(startDate, day) {
+			continue
+		}
+		occurrence := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, tz)
+		occurrences++
+		if (r.count > 0 && occurrences > r.count) || (!r.until.IsZero() && occurrence.After(r.until)) {
+			return time.Time{}, false
+		}
+		if !occurrence.Before(after) {
+			return occurrence, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// matches reports whether the rule occurs on the day. Both dates must be at
+// midnight UTC.
+func (r *recurrence) matches(startDate, day time.Time) bool {
+	days := int(day.Sub(startDate).Hours() / 24)
+	switch r.freq {
+	case "DAILY":
+		if days%r.interval != 0 {
+			return false
+		}
+	case "WEEKLY":
+		// Weeks start on a Monday, so the week of the start is week 0
+		offset := (int(startDate.Weekday()) + 6) % 7
+		if ((days+offset)/7)%r.interval != 0 {
+			return false
+		}
+		if r.byDay == nil {
+			return day.Weekday() == startDate.Weekday()
+		}
+	case "MONTHLY":
+		months := (day.Year()-startDate.Year())*12 + int(day.Month()) - int(startDate.Month())
+		if months%r.interval != 0 {
+			return false
+		}
+		if r.byDay == nil && r.byMonthDay == nil {
+			return day.Day() == startDate.Day()
+		}
+	}
+
+	if r.byDay != nil && !r.byDay[day.Weekday()] {
+		return false
+	}
+	if r.byMonthDay != nil {
+		lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		for _, monthDay := range r.byMonthDay {
+			if monthDay == day.Day() || (monthDay < 0 && lastDay+monthDay+1 == day.Day()) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// dateOf returns the calendar date of the time at midnight UTC, so days can
+// be counted without being affected by daylight saving time.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
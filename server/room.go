@@ -11,6 +11,10 @@ import (
 )
 
 func (s *server) ListRooms(ctx context.Context, req *serverpb.ListRoomsRequest) (*serverpb.ListRoomsResponse, error) {
+	if err := validateListRooms(req); err != nil {
+		return nil, err
+	}
+
 	// Validate the pagination request
 	pageInfo, err := s.validatePageableRequest(req)
 	if err != nil {
@@ -33,9 +37,19 @@ func (s *server) ListRooms(ctx context.Context, req *serverpb.ListRoomsRequest)
 		}
 	}
 
+	// Return the groups with the rooms so they can be displayed together
+	var groups []*serverpb.RoomGroup
+	if req.Order == store.DisplayOrder {
+		groups, err = s.store.ListRoomGroups(ctx, req.Parent, store.WithPageSize(maxGlobalPageSize))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &serverpb.ListRoomsResponse{
 		Rooms:         colors,
 		NextPageToken: nextPageToken,
+		RoomGroups:    groups,
 	}, nil
 }
 
@@ -57,7 +71,7 @@ func (s *server) CreateRoom(ctx context.Context, req *serverpb.CreateRoomRequest
 
 	room, err := s.store.CreateRoom(ctx, req.Room)
 	if err != nil {
-		return nil, err
+		return nil, convertQuotaError(err)
 	} else if room == nil {
 		return nil, errAlreadyExists
 	}
@@ -108,6 +122,21 @@ func (s *server) DeleteRoom(ctx context.Context, req *serverpb.DeleteRoomRequest
 	}
 }
 
+func validateListRooms(req *serverpb.ListRoomsRequest) error {
+	var errs field.ErrorList
+	switch req.Order {
+	case "":
+	case store.DisplayOrder:
+		// Rooms can only be ordered for display within a single location
+		if _, err := name.ParseLocationName(req.Parent); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("parent"), req.Parent, "parent must be a single location when ordering for display"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(field.NewPath("order"), req.Order, []string{store.DisplayOrder}))
+	}
+	return convertErrorList(errs)
+}
+
 // validateRoom will validate the data specified in a room. The create
 // argument should be used to indicate if the room is being created.
 func validateRoom(room *serverpb.Room, create bool) error {
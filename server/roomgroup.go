@@ -0,0 +1,217 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/ptypes/empty"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *server) ListRoomGroups(ctx context.Context, req *serverpb.ListRoomGroupsRequest) (*serverpb.ListRoomGroupsResponse, error) {
+	if _, _, err := name.ParseLocation(req.Parent); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.store.ListRoomGroups(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(groups) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListRoomGroupsResponse{
+		RoomGroups:    groups,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *server) CreateRoomGroup(ctx context.Context, req *serverpb.CreateRoomGroupRequest) (*serverpb.RoomGroup, error) {
+	if err := validateCreateRoomGroup(req); err != nil {
+		return nil, err
+	}
+
+	// Validate the parent is accurate by looking up the location
+	if location, err := s.store.GetLocation(ctx, req.Parent); err != nil {
+		return nil, err
+	} else if location == nil {
+		return nil, errNotFound
+	}
+
+	// Set the name of the group based on the provided ID
+	req.RoomGroup.Name = name.BuildRelativeName(req.Parent, name.CollectionRoomGroups, req.RoomGroupId)
+	if err := s.checkRoomGroupMembers(ctx, req.RoomGroup); err != nil {
+		return nil, err
+	}
+
+	group, err := s.store.CreateRoomGroup(ctx, req.RoomGroup)
+	if err != nil {
+		return nil, convertRoomInGroupError(req.RoomGroup, err)
+	} else if group == nil {
+		return nil, errAlreadyExists
+	}
+
+	return group, nil
+}
+
+func (s *server) GetRoomGroup(ctx context.Context, req *serverpb.GetRoomGroupRequest) (*serverpb.RoomGroup, error) {
+	if _, err := name.ParseRoomGroupName(req.Name); err != nil {
+		return nil, err
+	}
+
+	group, err := s.store.GetRoomGroup(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errNotFound
+	}
+
+	return group, nil
+}
+
+func (s *server) UpdateRoomGroup(ctx context.Context, req *serverpb.UpdateRoomGroupRequest) (*serverpb.RoomGroup, error) {
+	if err := validateUpdateRoomGroup(req); err != nil {
+		return nil, err
+	}
+	if err := s.checkRoomGroupMembers(ctx, req.RoomGroup); err != nil {
+		return nil, err
+	}
+
+	if group, err := s.store.UpdateRoomGroup(ctx, req.RoomGroup, store.WithUpdateMask(req.UpdateMask)); err != nil {
+		return nil, convertRoomInGroupError(req.RoomGroup, err)
+	} else if group == nil {
+		return nil, errNotFound
+	} else {
+		return group, nil
+	}
+}
+
+func (s *server) DeleteRoomGroup(ctx context.Context, req *serverpb.DeleteRoomGroupRequest) (*empty.Empty, error) {
+	if _, err := name.ParseRoomGroupName(req.Name); err != nil {
+		return nil, err
+	}
+
+	if group, err := s.store.DeleteRoomGroup(ctx, req.Name); err != nil {
+		return nil, err
+	} else if group == nil {
+		return nil, errNotFound
+	} else {
+		return &empty.Empty{}, nil
+	}
+}
+
+// checkRoomGroupMembers returns an InvalidArgument error when one of the
+// member rooms of a group does not exist.
+func (s *server) checkRoomGroupMembers(ctx context.Context, group *serverpb.RoomGroup) error {
+	var errs field.ErrorList
+	path := field.NewPath("room_group", "rooms")
+	for i, roomName := range group.Rooms {
+		room, err := s.store.GetRoom(ctx, roomName)
+		if err != nil {
+			return err
+		} else if room == nil {
+			errs = append(errs, field.Invalid(path.Index(i), roomName, "room does not exist"))
+		}
+	}
+	return convertErrorList(errs)
+}
+
+// convertRoomInGroupError converts the error returned by storage when a member
+// room of the group is already in another group into an InvalidArgument
+// error. Any other error is returned as is.
+func convertRoomInGroupError(group *serverpb.RoomGroup, err error) error {
+	var inGroupErr *store.RoomInGroupError
+	if !errors.As(err, &inGroupErr) {
+		return err
+	}
+
+	path := field.NewPath("room_group", "rooms")
+	for i, roomName := range group.Rooms {
+		if roomName == inGroupErr.Room {
+			path = path.Index(i)
+			break
+		}
+	}
+	return convertErrorList(field.ErrorList{
+		field.Invalid(path, inGroupErr.Room, "room is already in "+inGroupErr.RoomGroup),
+	})
+}
+
+func validateCreateRoomGroup(req *serverpb.CreateRoomGroupRequest) error {
+	var errs field.ErrorList
+	location, err := name.ParseLocationName(req.Parent)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("parent"), req.Parent, err.Error()))
+	}
+	if !name.ValidResourceID(req.RoomGroupId) {
+		errs = append(errs, field.Invalid(field.NewPath("room_group_id"), req.RoomGroupId, "invalid room group ID"))
+	}
+	if req.RoomGroup == nil {
+		errs = append(errs, field.Required(field.NewPath("room_group"), "room_group is required"))
+	} else {
+		if req.RoomGroup.DisplayName == "" {
+			errs = append(errs, field.Required(field.NewPath("room_group", "display_name"), "display_name is required"))
+		}
+		if err == nil {
+			errs = append(errs, validateRoomGroup(req.RoomGroup, location)...)
+		}
+	}
+	return convertErrorList(errs)
+}
+
+func validateUpdateRoomGroup(req *serverpb.UpdateRoomGroupRequest) error {
+	path := field.NewPath("room_group")
+	if req.RoomGroup == nil {
+		return convertErrorList(field.ErrorList{field.Required(path, "room_group is required")})
+	}
+
+	groupName, err := name.ParseRoomGroupName(req.RoomGroup.Name)
+	if err != nil {
+		return convertErrorList(field.ErrorList{field.Invalid(path.Child("name"), req.RoomGroup.Name, err.Error())})
+	}
+	return convertErrorList(validateRoomGroup(req.RoomGroup, groupName.Parent()))
+}
+
+// validateRoomGroup validates the settable fields of a group in the location.
+// The member rooms must be rooms in the same location and can only be listed
+// once.
+func validateRoomGroup(group *serverpb.RoomGroup, location name.LocationName) field.ErrorList {
+	path := field.NewPath("room_group")
+
+	var errs field.ErrorList
+	errs = append(errs, validateDisplayName(path, group)...)
+	errs = append(errs, validateDescription(path, group)...)
+
+	seen := map[string]bool{}
+	for i, roomName := range group.Rooms {
+		roomPath := path.Child("rooms").Index(i)
+		room, err := name.ParseRoomName(roomName)
+		if err != nil {
+			errs = append(errs, field.Invalid(roomPath, roomName, err.Error()))
+		} else if room.Parent() != location {
+			errs = append(errs, field.Invalid(roomPath, roomName, "room must be in the location of the group"))
+		} else if seen[roomName] {
+			errs = append(errs, field.Duplicate(roomPath, roomName))
+		}
+		seen[roomName] = true
+	}
+	return errs
+}
@@ -0,0 +1,269 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// roomStateTransitions are the states a room is allowed to move to from each
+// state.
+//
+//	AVAILABLE      -> OCCUPIED, OUT_OF_SERVICE
+//	OCCUPIED       -> AVAILABLE, NEEDS_CLEANING, OUT_OF_SERVICE
+//	NEEDS_CLEANING -> AVAILABLE, OUT_OF_SERVICE
+//	OUT_OF_SERVICE -> AVAILABLE, NEEDS_CLEANING
+var roomStateTransitions = map[serverpb.RoomState][]serverpb.RoomState{
+	serverpb.RoomState_ROOM_STATE_AVAILABLE: {
+		serverpb.RoomState_ROOM_STATE_OCCUPIED,
+		serverpb.RoomState_ROOM_STATE_OUT_OF_SERVICE,
+	},
+	serverpb.RoomState_ROOM_STATE_OCCUPIED: {
+		serverpb.RoomState_ROOM_STATE_AVAILABLE,
+		serverpb.RoomState_ROOM_STATE_NEEDS_CLEANING,
+		serverpb.RoomState_ROOM_STATE_OUT_OF_SERVICE,
+	},
+	serverpb.RoomState_ROOM_STATE_NEEDS_CLEANING: {
+		serverpb.RoomState_ROOM_STATE_AVAILABLE,
+		serverpb.RoomState_ROOM_STATE_OUT_OF_SERVICE,
+	},
+	serverpb.RoomState_ROOM_STATE_OUT_OF_SERVICE: {
+		serverpb.RoomState_ROOM_STATE_AVAILABLE,
+		serverpb.RoomState_ROOM_STATE_NEEDS_CLEANING,
+	},
+}
+
+// The time before the last change seen by a watch that is checked again for
+// changes, so changes committed late or by servers with a slower clock are
+// still sent to the watch.
+const roomWatchOverlap = 5 * time.Second
+
+// The number of rooms read at a time for the first response of a watch.
+const roomWatchPageSize = 500
+
+// roomStateChanges wakes the room state watches of every server in the
+// process, e.g. the public server and the server behind the HTTP gateway.
+var roomStateChanges = &changeNotifier{}
+
+// canTransitionRoom reports whether a room can move between the states.
+func canTransitionRoom(from, to serverpb.RoomState) bool {
+	for _, allowed := range roomStateTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// roomTransition is a precondition for changing the state of a room that fails
+// when the room can't move to the state from its current state.
+func roomTransition(to serverpb.RoomState) store.UpdateOption {
+	return store.WithPrecondition(func(existing proto.Message) error {
+		from := existing.(*serverpb.Room).GetState()
+		if !canTransitionRoom(from, to) {
+			return errFailedPrecondition(fmt.Sprintf(
+				"room can not move from %s to %s",
+				strings.TrimPrefix(from.String(), "ROOM_STATE_"),
+				strings.TrimPrefix(to.String(), "ROOM_STATE_"),
+			))
+		}
+		return nil
+	})
+}
+
+// changeNotifier wakes the watches on this server when a change is made, so
+// they don't wait for their next poll to send it.
+type changeNotifier struct {
+	mu      sync.Mutex
+	changed chan struct{}
+}
+
+// wait returns a channel that is closed on the next change.
+func (n *changeNotifier) wait() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.changed == nil {
+		n.changed = make(chan struct{})
+	}
+	return n.changed
+}
+
+// notify wakes everything waiting for a change.
+func (n *changeNotifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.changed != nil {
+		close(n.changed)
+		n.changed = nil
+	}
+}
+
+func (s *server) SetRoomState(ctx context.Context, req *serverpb.SetRoomStateRequest) (*serverpb.Room, error) {
+	if err := validateSetRoomState(req); err != nil {
+		return nil, err
+	}
+
+	room, err := s.store.SetRoomState(ctx, req.Name, req.State, requestActor(ctx), roomTransition(req.State))
+	if err != nil {
+		return nil, err
+	} else if room == nil {
+		return nil, errNotFound
+	}
+
+	roomStateChanges.notify()
+	return room, nil
+}
+
+func (s *server) ListRoomStateChanges(ctx context.Context, req *serverpb.ListRoomStateChangesRequest) (*serverpb.ListRoomStateChangesResponse, error) {
+	if _, _, _, err := name.ParseRoom(req.Parent); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.store.ListRoomStateChanges(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(changes) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListRoomStateChangesResponse{
+		RoomStateChanges: changes,
+		NextPageToken:    nextPageToken,
+	}, nil
+}
+
+// WatchRoomStates sends every room in the parent, then the rooms whose state
+// changed. Changes made through this server are sent as soon as they're made,
+// and changes made through other servers are found by polling the storage.
+func (s *server) WatchRoomStates(req *serverpb.WatchRoomStatesRequest, stream serverpb.Rooms_WatchRoomStatesServer) error {
+	ctx := stream.Context()
+	if _, _, err := name.ParseLocation(req.Parent, name.AllowWildcard()); err != nil {
+		return err
+	}
+
+	// Wait for changes from before the rooms are read so none are missed
+	changed := roomStateChanges.wait()
+	cursor := time.Now()
+	rooms, err := s.listAllRooms(ctx, req.Parent)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&serverpb.WatchRoomStatesResponse{Rooms: rooms}); err != nil {
+		return err
+	}
+
+	// The state changes that have been sent, so changes found again
+	// within the overlap aren't sent twice
+	sent := map[string]time.Time{}
+	markSent := func(rooms []*serverpb.Room) []*serverpb.Room {
+		var unsent []*serverpb.Room
+		for _, room := range rooms {
+			changeTime, err := ptypes.Timestamp(room.StateChangeTime)
+			if err != nil {
+				continue
+			}
+			key := room.Uid + "@" + changeTime.String()
+			if _, ok := sent[key]; !ok {
+				sent[key] = changeTime
+				unsent = append(unsent, room)
+			}
+			if changeTime.After(cursor) {
+				cursor = changeTime
+			}
+		}
+		return unsent
+	}
+	markSent(rooms)
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-changed:
+		}
+		changed = roomStateChanges.wait()
+
+		since := cursor.Add(-roomWatchOverlap)
+		rooms, err := s.store.ListChangedRooms(ctx, req.Parent, since)
+		if err != nil {
+			return err
+		}
+		for key, changeTime := range sent {
+			if changeTime.Before(since) {
+				delete(sent, key)
+			}
+		}
+		if unsent := markSent(rooms); len(unsent) > 0 {
+			if err := stream.Send(&serverpb.WatchRoomStatesResponse{Rooms: unsent}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// listAllRooms lists every room in the parent, a page at a time.
+func (s *server) listAllRooms(ctx context.Context, parent string) ([]*serverpb.Room, error) {
+	var rooms []*serverpb.Room
+	for offset := 0; ; offset += roomWatchPageSize {
+		page, err := s.store.ListRooms(ctx, parent, store.WithPageInfo(store.PageInfo{EndCursor: strconv.Itoa(offset)}), store.WithPageSize(roomWatchPageSize))
+		if err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, page...)
+		if len(page) < roomWatchPageSize {
+			return rooms, nil
+		}
+	}
+}
+
+func validateSetRoomState(req *serverpb.SetRoomStateRequest) error {
+	var errs field.ErrorList
+	if _, _, _, err := name.ParseRoom(req.Name); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("name"), req.Name, err.Error()))
+	}
+	if req.State == serverpb.RoomState_ROOM_STATE_UNSPECIFIED {
+		errs = append(errs, field.Required(field.NewPath("state"), "state is required"))
+	} else if _, ok := roomStateTransitions[req.State]; !ok {
+		errs = append(errs, field.NotSupported(field.NewPath("state"), req.State.String(), roomStateNames()))
+	}
+	return convertErrorList(errs)
+}
+
+// roomStateNames returns the names of the states a room can be in.
+func roomStateNames() []string {
+	var names []string
+	for value := int32(1); value < int32(len(serverpb.RoomState_name)); value++ {
+		if state := serverpb.RoomState(value); roomStateTransitions[state] != nil {
+			names = append(names, state.String())
+		}
+	}
+	return names
+}
@@ -0,0 +1,443 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *server) ListScheduledMessages(ctx context.Context, req *serverpb.ListScheduledMessagesRequest) (*serverpb.ListScheduledMessagesResponse, error) {
+	if _, _, err := name.ParseLocation(req.Parent); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := s.store.ListScheduledMessages(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(scheduled) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListScheduledMessagesResponse{
+		ScheduledMessages: scheduled,
+		NextPageToken:     nextPageToken,
+	}, nil
+}
+
+func (s *server) CreateScheduledMessage(ctx context.Context, req *serverpb.CreateScheduledMessageRequest) (*serverpb.ScheduledMessage, error) {
+	if err := validateCreateScheduledMessage(req); err != nil {
+		return nil, err
+	}
+	if err := checkMessagePriority(ctx, req.ScheduledMessage.Message); err != nil {
+		return nil, err
+	}
+	if err := s.checkMessageRecipient(ctx, field.NewPath("scheduled_message", "message"), req.ScheduledMessage.Message); err != nil {
+		return nil, err
+	}
+	return s.createScheduledMessage(ctx, req.Parent, req.ScheduledMessageId, req.ScheduledMessage)
+}
+
+func (s *server) GetScheduledMessage(ctx context.Context, req *serverpb.GetScheduledMessageRequest) (*serverpb.ScheduledMessage, error) {
+	if _, err := name.ParseScheduledMessageName(req.Name); err != nil {
+		return nil, err
+	}
+
+	scheduled, err := s.store.GetScheduledMessage(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	} else if scheduled == nil {
+		return nil, errNotFound
+	}
+
+	return scheduled, nil
+}
+
+func (s *server) PauseScheduledMessage(ctx context.Context, req *serverpb.PauseScheduledMessageRequest) (*serverpb.ScheduledMessage, error) {
+	if _, err := name.ParseScheduledMessageName(req.Name); err != nil {
+		return nil, err
+	}
+
+	scheduled, err := s.store.PauseScheduledMessage(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	} else if scheduled == nil {
+		return nil, errNotFound
+	}
+
+	return scheduled, nil
+}
+
+func (s *server) ResumeScheduledMessage(ctx context.Context, req *serverpb.ResumeScheduledMessageRequest) (*serverpb.ScheduledMessage, error) {
+	scheduledName, err := name.ParseScheduledMessageName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tz, err := s.locationTimeZone(ctx, scheduledName.Parent().String())
+	if err != nil {
+		return nil, err
+	}
+
+	// The times the message would have been sent while it was paused are
+	// skipped rather than all being sent as soon as it resumes
+	now := time.Now()
+	scheduled, err := s.store.ResumeScheduledMessage(ctx, req.Name, func(existing *serverpb.ScheduledMessage) (*timestamp.Timestamp, error) {
+		if !existing.Paused {
+			return existing.NextSendTime, nil
+		} else if existing.NextSendTime == nil {
+			// The schedule ended before it was paused
+			return nil, nil
+		}
+		return nextSendTime(existing, now, tz)
+	})
+	if err != nil {
+		return nil, err
+	} else if scheduled == nil {
+		return nil, errNotFound
+	}
+
+	return scheduled, nil
+}
+
+func (s *server) DeleteScheduledMessage(ctx context.Context, req *serverpb.DeleteScheduledMessageRequest) (*empty.Empty, error) {
+	if _, err := name.ParseScheduledMessageName(req.Name); err != nil {
+		return nil, err
+	}
+
+	if scheduled, err := s.store.DeleteScheduledMessage(ctx, req.Name); err != nil {
+		return nil, err
+	} else if scheduled == nil {
+		return nil, errNotFound
+	} else {
+		return &empty.Empty{}, nil
+	}
+}
+
+// createScheduledMessage creates a validated scheduled message in the
+// location. An ID is generated from the reason of the message when one isn't
+// given.
+func (s *server) createScheduledMessage(ctx context.Context, parent, scheduledID string, scheduled *serverpb.ScheduledMessage) (*serverpb.ScheduledMessage, error) {
+	tz, err := s.locationTimeZone(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first send time is checked against the time zone of the location
+	// since the rule can end before it is first due
+	next, err := nextSendTime(scheduled, time.Now(), tz)
+	if err != nil {
+		return nil, err
+	} else if next == nil {
+		return nil, convertErrorList(field.ErrorList{field.Invalid(
+			field.NewPath("scheduled_message", "recurrence"),
+			scheduled.Recurrence,
+			"recurrence must have an occurrence after the current time",
+		)})
+	}
+	scheduled.NextSendTime = next
+	scheduled.Message.Location = parent
+
+	// A generated ID is retried when it collides with an existing scheduled
+	// message since the caller didn't ask for that ID
+	for attempt := 0; attempt < generatedIDAttempts; attempt++ {
+		id := scheduledID
+		if id == "" {
+			if id, err = generateResourceID(scheduled.Message.Reason, "message"); err != nil {
+				return nil, err
+			}
+		}
+		scheduled.Name = name.BuildRelativeName(parent, name.CollectionScheduledMessages, id)
+
+		created, err := s.store.CreateScheduledMessage(ctx, scheduled)
+		if err != nil {
+			return nil, err
+		} else if created != nil {
+			return created, nil
+		} else if scheduledID != "" {
+			break
+		}
+	}
+
+	return nil, errAlreadyExists
+}
+
+// locationTimeZone returns the time zone of a location. A NotFound error is
+// returned when the location does not exist.
+func (s *server) locationTimeZone(ctx context.Context, locationName string) (*time.Location, error) {
+	location, err := s.store.GetLocation(ctx, locationName)
+	if err != nil {
+		return nil, err
+	} else if location == nil {
+		return nil, errNotFound
+	}
+	return loadTimeZone(location.TimeZone)
+}
+
+// nextSendTime returns the first time at or after the time that a scheduled
+// message is sent, or nil when its schedule has ended by then.
+func nextSendTime(scheduled *serverpb.ScheduledMessage, after time.Time, tz *time.Location) (*timestamp.Timestamp, error) {
+	rule, err := parseRecurrence(scheduled.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	start, err := ptypes.Timestamp(scheduled.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	next, ok := nextOccurrence(rule, start, after, tz)
+	if !ok {
+		return nil, nil
+	}
+	return ptypes.TimestampProto(next)
+}
+
+func validateCreateScheduledMessage(req *serverpb.CreateScheduledMessageRequest) error {
+	var errs field.ErrorList
+	location, err := name.ParseLocationName(req.Parent)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("parent"), req.Parent, err.Error()))
+	}
+	if req.ScheduledMessageId != "" && !name.ValidResourceID(req.ScheduledMessageId) {
+		errs = append(errs, field.Invalid(field.NewPath("scheduled_message_id"), req.ScheduledMessageId, "invalid scheduled message ID"))
+	}
+
+	path := field.NewPath("scheduled_message")
+	if req.ScheduledMessage == nil {
+		return convertErrorList(append(errs, field.Required(path, "scheduled_message is required")))
+	}
+
+	rule, ruleErr := parseRecurrence(req.ScheduledMessage.Recurrence)
+	if ruleErr != nil {
+		errs = append(errs, field.Invalid(path.Child("recurrence"), req.ScheduledMessage.Recurrence, ruleErr.Error()))
+	}
+	start := time.Now()
+	if req.ScheduledMessage.StartTime == nil {
+		errs = append(errs, field.Required(path.Child("start_time"), "start_time is required"))
+	} else if start, err = ptypes.Timestamp(req.ScheduledMessage.StartTime); err != nil {
+		errs = append(errs, field.Invalid(path.Child("start_time"), req.ScheduledMessage.StartTime.String(), err.Error()))
+	} else if rule == nil && ruleErr == nil && !start.After(time.Now()) {
+		errs = append(errs, field.Invalid(path.Child("start_time"), start.Format(time.RFC3339), "start_time must be in the future for a message that is only sent once"))
+	}
+
+	message := req.ScheduledMessage.Message
+	if message == nil {
+		errs = append(errs, field.Required(path.Child("message"), "message is required"))
+	} else if location != (name.LocationName{}) {
+		errs = append(errs, validateMessage(path.Child("message"), message, location, start)...)
+		// A fixed expire time would only apply to the first message sent
+		if rule != nil && message.GetExpireTime() != nil {
+			errs = append(errs, field.Forbidden(path.Child("message", "expire_time"), "expire_time can not be used with a recurrence, use ttl instead"))
+		}
+	}
+	return convertErrorList(errs)
+}
+
+// Dispatcher sends scheduled messages once they are due.
+type Dispatcher struct {
+	store     store.Storage
+	messenger *server
+
+	mu  sync.Mutex
+	err error
+}
+
+// NewDispatcher creates a Dispatcher that finds due scheduled messages in
+// the storage.
+func NewDispatcher(storage store.Storage) *Dispatcher {
+	return &Dispatcher{store: storage, messenger: &server{store: storage}}
+}
+
+// Run will send the scheduled messages that are due at the interval until
+// the context is done. Every server can run a Dispatcher since each time a
+// scheduled message is due it is claimed by a single server.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		runCtx, cancel := context.WithTimeout(ctx, interval)
+		if err := d.Dispatch(runCtx, time.Now()); err != nil && ctx.Err() == nil {
+			workerLogger.logWork("Dispatcher", "failed to send scheduled messages", "", err)
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch sends every scheduled message that is due by the given time. A
+// message is claimed by moving its schedule on to the next time it is due in
+// the same transaction that stores the sent message, so it is only sent by
+// one server and a claim is never lost without its message. Times that were missed
+// while no server was running are skipped, except for the most recent which
+// is sent late. The first error will be returned after every scheduled
+// message has been attempted.
+func (d *Dispatcher) Dispatch(ctx context.Context, now time.Time) error {
+	due, err := d.store.ListDueScheduledMessages(ctx, now)
+	d.mu.Lock()
+	d.err = err
+	d.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, scheduled := range due {
+		if err := d.dispatch(ctx, scheduled, now); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to send %s: %v", scheduled.Name, err)
+		}
+	}
+	return firstErr
+}
+
+// Check is a HealthCheck that fails while the due scheduled messages can't
+// be found, so the Messenger service reports it isn't serving.
+func (d *Dispatcher) Check(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.err
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, scheduled *serverpb.ScheduledMessage, now time.Time) error {
+	scheduledName, err := name.ParseScheduledMessageName(scheduled.Name)
+	if err != nil {
+		return err
+	}
+	location, err := d.store.GetLocation(ctx, scheduledName.Parent().String())
+	if err != nil {
+		return err
+	}
+	// A message in a deleted location ends its schedule without being sent
+	tz := time.UTC
+	if location != nil {
+		if tz, err = loadTimeZone(location.TimeZone); err != nil {
+			return err
+		}
+	}
+
+	dueTime, err := ptypes.Timestamp(scheduled.NextSendTime)
+	if err != nil {
+		return err
+	}
+	after := dueTime
+	if now.After(after) {
+		after = now
+	}
+	var next *time.Time
+	if location != nil {
+		nextSend, err := nextSendTime(scheduled, after.Add(time.Nanosecond), tz)
+		if err != nil {
+			return err
+		} else if nextSend != nil {
+			t, err := ptypes.Timestamp(nextSend)
+			if err != nil {
+				return err
+			}
+			next = &t
+		}
+	}
+
+	// The message is stored along with the claim. A message that is rejected
+	// when it's sent, e.g. because its contact group was deleted, still moves
+	// the schedule on without being sent
+	var message *serverpb.Message
+	var rejected error
+	if location != nil {
+		if message, err = d.prepareMessage(ctx, claimedMessage(scheduled), location); isRejection(err) {
+			rejected = err
+		} else if err != nil {
+			return err
+		}
+	}
+
+	claimed, err := d.store.ClaimScheduledMessage(ctx, scheduled.Name, dueTime, next, message)
+	if err != nil || claimed == nil || location == nil {
+		return err
+	} else if rejected != nil {
+		return rejected
+	}
+
+	messageChanges.notify()
+	workerLogger.logWork("Dispatcher", fmt.Sprintf("scheduled message sent for %s", dueTime.Format(time.RFC3339)), scheduled.Name, nil)
+	return nil
+}
+
+// claimedMessage returns a copy of the message of a scheduled message that is
+// sent when it is claimed.
+func claimedMessage(scheduled *serverpb.ScheduledMessage) *serverpb.Message {
+	message := proto.Clone(scheduled.Message).(*serverpb.Message)
+	message.ScheduledMessage = scheduled.Name
+	return message
+}
+
+// prepareMessage checks a scheduled message can still be sent to the location
+// in the same way as SendMessage, and prepares it to be stored with a new
+// name. A nil Message is returned with the error when it is rejected.
+func (d *Dispatcher) prepareMessage(ctx context.Context, message *serverpb.Message, location *serverpb.Location) (*serverpb.Message, error) {
+	if err := d.messenger.checkMessageRecipient(ctx, field.NewPath("message"), message); err != nil {
+		return nil, err
+	}
+	warnings, err := d.messenger.checkRecipientPresence(ctx, location.Name, message)
+	if err != nil {
+		return nil, err
+	}
+	message.Warnings = warnings
+
+	recipients, err := d.messenger.messageRecipients(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	held, err := d.messenger.heldRecipients(ctx, message, recipients)
+	if err != nil {
+		return nil, err
+	}
+	if err := prepareMessage(location, message, recipients, held, ""); err != nil {
+		return nil, err
+	}
+	if message.Name, err = generateMessageName(location.Name, message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// isRejection reports whether the error rejected a message, rather than the
+// message failing to be checked.
+func isRejection(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound:
+		return true
+	}
+	return false
+}
@@ -1,41 +1,176 @@
 package server
 
 import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/chacerapp/apiserver/metrics"
+	"github.com/chacerapp/apiserver/name"
 	"github.com/chacerapp/apiserver/server/serverpb"
 	"github.com/chacerapp/apiserver/store"
+	"github.com/chacerapp/apiserver/tracing"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// The default interval watches check the storage for changes.
+const defaultWatchInterval = 2 * time.Second
+
 var (
 	errNotFound      = status.Error(codes.NotFound, "not found")
 	errAlreadyExists = status.Error(codes.AlreadyExists, "already exists")
 )
 
+// Option configures the server created by NewGRPCServer.
+type Option func(*options)
+
+type options struct {
+	gatewayAddr   net.Addr
+	grpcOptions   []grpc.ServerOption
+	health        *Health
+	logOutput     io.Writer
+	permissions   map[string][]string
+	rateLimiter   *RateLimiter
+	watchInterval time.Duration
+}
+
+// WithGRPCOptions adds options to the gRPC server, such as TLS credentials.
+func WithGRPCOptions(opts ...grpc.ServerOption) Option {
+	return func(o *options) {
+		o.grpcOptions = append(o.grpcOptions, opts...)
+	}
+}
+
+// WithGatewayAddr sets the address the HTTP gateway connects to the server
+// from, which must be unique to the gateway such as an in-memory listener.
+// The client address forwarded by the gateway is only trusted for requests
+// made from it, and is ignored for every other request.
+func WithGatewayAddr(addr net.Addr) Option {
+	return func(o *options) {
+		o.gatewayAddr = addr
+	}
+}
+
+// WithHealth sets the Health used to report the serving status of the
+// server. By default the server will only check the storage can be reached.
+func WithHealth(health *Health) Option {
+	return func(o *options) {
+		o.health = health
+	}
+}
+
+// WithPermissions sets the permissions granted to each caller, keyed by the
+// common name of the verified client certificate they connect with. Only the
+// additional permissions of an endpoint are checked against them, and callers
+// without a verified client certificate have none.
+func WithPermissions(permissions map[string][]string) Option {
+	return func(o *options) {
+		o.permissions = permissions
+	}
+}
+
+// WithRateLimiter sets the RateLimiter used to limit the rate of requests.
+// The same RateLimiter should be shared by every server using the storage so
+// requests are limited regardless of the server they are made to.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *options) {
+		o.rateLimiter = limiter
+	}
+}
+
+// WithWatchInterval sets how often watches check the storage for changes made
+// through other servers. Changes made through the same server are sent to its
+// watches straight away. By default the storage is checked every 2 seconds.
+func WithWatchInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.watchInterval = interval
+	}
+}
+
+// WithLogOutput sets where the JSON request logs are written. By default
+// they are written to stderr.
+func WithLogOutput(w io.Writer) Option {
+	return func(o *options) {
+		o.logOutput = w
+	}
+}
+
 // NewGRPCServer will create a new gRPC server
 // with a default set of interceptors that should
-// be used for the server.
-func NewGRPCServer(storage store.Storage) *grpc.Server {
+// be used for the server, including tracing,
+// request IDs, request logging, metrics, rate limiting
+// and panic recovery. The standard health service and server
+// reflection are also registered.
+func NewGRPCServer(storage store.Storage, opts ...Option) *grpc.Server {
+	o := &options{logOutput: os.Stderr, watchInterval: defaultWatchInterval}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.health == nil {
+		o.health = NewHealth(storage)
+	}
+	if o.rateLimiter == nil {
+		o.rateLimiter = NewRateLimiter(storage)
+	}
+
 	// create a new RPC server
-	rpcServer := &server{storage}
-	// Create a new gRPC server
-	svr := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(authInterceptor()),
-	)
+	rpcServer := &server{
+		store:         storage,
+		rateLimiter:   o.rateLimiter,
+		watchInterval: o.watchInterval,
+	}
+	logger := &requestLogger{out: o.logOutput}
+	// Create a new gRPC server. Panics are recovered within the metrics and
+	// logging interceptors so they are recorded as Internal errors.
+	svr := grpc.NewServer(append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			gatewayInterceptor(o.gatewayAddr),
+			tracing.UnaryServerInterceptor(),
+			requestIDInterceptor(),
+			accessLogInterceptor(logger),
+			metrics.UnaryServerInterceptor(),
+			rateLimitInterceptor(o.rateLimiter),
+			recoveryInterceptor(logger),
+			auditInterceptor(storage, logger),
+			authInterceptor(o.permissions),
+			accountPhaseInterceptor(storage),
+		),
+		grpc.ChainStreamInterceptor(
+			streamGatewayInterceptor(o.gatewayAddr),
+			tracing.StreamServerInterceptor(),
+			streamRequestIDInterceptor(),
+			streamAccessLogInterceptor(logger),
+			metrics.StreamServerInterceptor(),
+			streamRateLimitInterceptor(o.rateLimiter),
+			streamRecoveryInterceptor(logger),
+			streamAccountPhaseInterceptor(storage, o.watchInterval),
+		),
+	}, o.grpcOptions...)...)
 
 	// Register all of the services for this server
 	serverpb.RegisterAccountsServer(svr, rpcServer)
+	serverpb.RegisterAuditLogServer(svr, rpcServer)
+	serverpb.RegisterContactsServer(svr, rpcServer)
 	serverpb.RegisterIAMCredentialsServer(svr, rpcServer)
 	serverpb.RegisterLocationsServer(svr, rpcServer)
 	serverpb.RegisterMessengerServer(svr, rpcServer)
 	serverpb.RegisterRoomsServer(svr, rpcServer)
 	serverpb.RegisterTemplatesServer(svr, rpcServer)
 	serverpb.RegisterUserManagerServer(svr, rpcServer)
+
+	// The health service reports the status of every service registered above
+	o.health.register(svr)
+	reflection.Register(svr)
 	return svr
 }
 
@@ -43,6 +178,8 @@ func NewGRPCServer(storage store.Storage) *grpc.Server {
 // API server.
 type APIServer interface {
 	serverpb.AccountsServer
+	serverpb.AuditLogServer
+	serverpb.ContactsServer
 	serverpb.IAMCredentialsServer
 	serverpb.LocationsServer
 	serverpb.MessengerServer
@@ -53,11 +190,13 @@ type APIServer interface {
 
 // New creates an API server
 func New(storage store.Storage) APIServer {
-	return &server{storage}
+	return &server{store: storage}
 }
 
 type server struct {
-	store store.Storage
+	store         store.Storage
+	rateLimiter   *RateLimiter
+	watchInterval time.Duration
 }
 
 func convertErrorList(errs field.ErrorList) error {
@@ -83,6 +222,28 @@ func convertErrorList(errs field.ErrorList) error {
 	return s.Err()
 }
 
+// convertQuotaError converts the error returned by storage when an account
+// has reached a quota into a ResourceExhausted error. Any other error is
+// returned as is.
+func convertQuotaError(err error) error {
+	var quotaErr *store.QuotaExceededError
+	if !errors.As(err, &quotaErr) {
+		return err
+	}
+
+	s, err := status.New(codes.ResourceExhausted, "quota exceeded").
+		WithDetails(&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     quotaErr.Account,
+				Description: quotaErr.Error(),
+			}},
+		})
+	if err != nil {
+		return err
+	}
+	return s.Err()
+}
+
 func errFailedPrecondition(msg string) error {
 	return status.Error(codes.FailedPrecondition, msg)
 }
@@ -121,6 +282,52 @@ func slugify(str string) string {
 	return slugified
 }
 
+const (
+	// The number of random characters added to the end of generated IDs.
+	generatedIDSuffixLength = 6
+	// The number of times a generated ID is retried when it already exists.
+	generatedIDAttempts = 3
+	// The longest resource ID accepted by name.ValidResourceID.
+	maxResourceIDLength = 63
+)
+
+// generateResourceID generates a valid resource ID from the display name of a
+// resource, with a random suffix so resources with the same display name don't
+// collide. The fallback is used when the display name has no characters that
+// can be used in an ID, e.g. when it isn't written with Latin characters.
+func generateResourceID(displayName, fallback string) (string, error) {
+	suffix, err := randomIDSuffix(generatedIDSuffixLength)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := strings.Trim(slugify(displayName), "-")
+	if prefix == "" {
+		prefix = fallback
+	}
+	if max := maxResourceIDLength - len(suffix) - 1; len(prefix) > max {
+		prefix = strings.TrimRight(prefix[:max], "-")
+	}
+
+	id := prefix + "-" + suffix
+	if !name.ValidResourceID(id) {
+		return "", fmt.Errorf("generated an invalid resource ID %q", id)
+	}
+	return id, nil
+}
+
+func randomIDSuffix(length int) (string, error) {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	raw := make([]byte, length)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	for i := range raw {
+		raw[i] = alphabet[int(raw[i])%len(alphabet)]
+	}
+	return string(raw), nil
+}
+
 // Validate the labels of the resource are in the correct format.
 func validateLabels(path *field.Path, labelsObj interface{ GetLabels() map[string]string }) field.ErrorList {
 	var errs field.ErrorList
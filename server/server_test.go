@@ -1,35 +1,53 @@
 package server_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math/big"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-txdb"
+	"github.com/chacerapp/apiserver/migrations"
 	"github.com/chacerapp/apiserver/server"
 	"github.com/chacerapp/apiserver/store"
 	"github.com/cucumber/godog"
 	"github.com/cucumber/godog/colors"
 	"github.com/cucumber/messages-go/v10"
 	"github.com/golang-migrate/migrate"
-	_ "github.com/golang-migrate/migrate/database/cockroachdb"
-	_ "github.com/golang-migrate/migrate/source/file"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	_ "github.com/lib/pq"
 	"github.com/stretchr/objx"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
@@ -56,8 +74,20 @@ type serverFeature struct {
 	request       interface{}
 	response      interface{}
 	nextPageToken string
+	stashedName   string
 	ctx           context.Context
 	db            *sql.DB
+	storage       store.Storage
+	health        *server.Health
+	httpServer    *httptest.Server
+	httpStatus    int
+	httpHeader    http.Header
+	httpBody      string
+	header        metadata.MD
+	requestLog    bytes.Buffer
+	streamed      chan interface{}
+	stopStream    context.CancelFunc
+	certificates  *testCertificates
 }
 
 func TestMain(m *testing.M) {
@@ -99,12 +129,28 @@ func (f *serverFeature) callingTheRPC(method string) error {
 	// clear out entries from previous calls
 	f.response = nil
 	f.responseError = nil
+	f.header = nil
 
+	var err error
+	if f.response, err = newResponseMessage(method); err != nil {
+		return err
+	}
+	// Copy the request so that any modifications to the request object
+	// by the RPC do not effect the object we have stored.
+	request := proto.Clone(f.request.(proto.Message))
+	// Invoke the API call
+	f.responseError = f.clientConn.Invoke(f.ctx, method, request, f.response, grpc.Header(&f.header))
+
+	return nil
+}
+
+// newResponseMessage returns a new instance of the response message of the method.
+func newResponseMessage(method string) (interface{}, error) {
 	// Split the full name into its parts
 	nameParts := strings.Split(method, "/")
 	serviceDescr, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(nameParts[0]))
 	if err != nil {
-		return fmt.Errorf("unable to find service descriptor for method %v: %v", method, err)
+		return nil, fmt.Errorf("unable to find service descriptor for method %v: %v", method, err)
 	}
 	// Grab the method descriptor
 	methodDescr := serviceDescr.(protoreflect.ServiceDescriptor).Methods().ByName(protoreflect.Name(nameParts[1]))
@@ -112,16 +158,80 @@ func (f *serverFeature) callingTheRPC(method string) error {
 	responseMessageName := string(methodDescr.(protoreflect.MethodDescriptor).Output().FullName())
 	// Grab a new instance of the proto response message. This should be guaranteed to be
 	// registered in the Proto registry since it came from the method descriptor
-	f.response = reflect.New(proto.MessageType(responseMessageName).Elem()).Interface()
-	// Copy the request so that any modifications to the request object
-	// by the RPC do not effect the object we have stored.
-	request := proto.Clone(f.request.(proto.Message))
-	// Invoke the API call
-	f.responseError = f.clientConn.Invoke(f.ctx, method, request, f.response)
+	return reflect.New(proto.MessageType(responseMessageName).Elem()).Interface(), nil
+}
 
+// Starts a server streaming RPC with the request. The responses are received in the
+// background until the end of the scenario.
+func (f *serverFeature) watchingTheRPC(method string) error {
+	if _, err := newResponseMessage(method); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(f.ctx)
+	f.stopStream = cancel
+	stream, err := f.clientConn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, method)
+	if err != nil {
+		return err
+	}
+	if err := stream.SendMsg(proto.Clone(f.request.(proto.Message))); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+
+	streamed := make(chan interface{})
+	f.streamed = streamed
+	go func() {
+		for {
+			response, _ := newResponseMessage(method)
+			var received interface{} = response
+			if err := stream.RecvMsg(response); err != nil {
+				received = err
+			}
+			select {
+			case streamed <- received:
+			case <-ctx.Done():
+				return
+			}
+			if _, failed := received.(error); failed {
+				return
+			}
+		}
+	}()
 	return nil
 }
 
+// Waits for the next response of the streaming RPC, which is used as the response of
+// the following steps.
+func (f *serverFeature) receivingTheNextStreamedResponse() error {
+	select {
+	case received := <-f.streamed:
+		if err, ok := received.(error); ok {
+			return fmt.Errorf("the stream ended: %v", err)
+		}
+		f.response = received
+		return nil
+	case <-time.After(5 * time.Second):
+		return fmt.Errorf("a streamed response was not received within 5 seconds")
+	}
+}
+
+func (f *serverFeature) theStreamWillEndWithAnErrorWithCode(stringCode string) error {
+	select {
+	case received := <-f.streamed:
+		err, ok := received.(error)
+		if !ok {
+			return fmt.Errorf("expected the stream to end, but a response was received")
+		}
+		f.responseError = err
+		return f.iWillReceiveAnErrorWithCode(stringCode)
+	case <-time.After(5 * time.Second):
+		return fmt.Errorf("the stream did not end within 5 seconds")
+	}
+}
+
 func (f *serverFeature) iWillReceiveAnErrorWithCode(stringCode string) error {
 	expectedCode := new(codes.Code)
 	if err := expectedCode.UnmarshalJSON([]byte(stringCode)); err != nil {
@@ -207,6 +317,18 @@ func (f *serverFeature) theResponseValueWillBe(path, expected string) error {
 	return nil
 }
 
+func (f *serverFeature) theResponseValueWillMatch(path, pattern string) error {
+	var jsonMarshaler = &jsonpb.Marshaler{EmitDefaults: true}
+	r, _ := jsonMarshaler.MarshalToString(f.response.(proto.Message))
+	actual := objx.MustFromJSON(r).Get(path).String()
+	if matched, err := regexp.MatchString(pattern, actual); err != nil {
+		return err
+	} else if !matched {
+		return fmt.Errorf("expected '%s' to match '%s', got '%s'", path, pattern, actual)
+	}
+	return nil
+}
+
 func (f *serverFeature) theResponseValueWillHaveLength(path string, expectedLen int) error {
 	var jsonMarshaler = &jsonpb.Marshaler{}
 	r, _ := jsonMarshaler.MarshalToString(f.response.(proto.Message))
@@ -234,6 +356,30 @@ func (f *serverFeature) stashingTheNextPageTokenFromTheResponse() error {
 	}
 }
 
+func (f *serverFeature) stashingTheNameFromTheResponse() error {
+	if r, ok := f.response.(interface{ GetName() string }); !ok {
+		return fmt.Errorf("the response does not have a name")
+	} else if r.GetName() == "" {
+		return fmt.Errorf("the response does not contain a name")
+	} else {
+		f.stashedName = r.GetName()
+		return nil
+	}
+}
+
+func (f *serverFeature) usingTheStashedName(field string) error {
+	var jsonMarshaler = &jsonpb.Marshaler{}
+	r, _ := jsonMarshaler.MarshalToString(f.request.(proto.Message))
+	updated := objx.MustFromJSON(r).Set(field, f.stashedName).MustJSON()
+	request := reflect.ValueOf(f.request).Interface()
+	if err := jsonpb.Unmarshal(strings.NewReader(updated), request.(proto.Message)); err != nil {
+		return fmt.Errorf("failed to set the stashed name in the request: %v", err)
+	}
+
+	f.request = request
+	return nil
+}
+
 func (f *serverFeature) usingTheStashedNextPageToken() error {
 	// verify the request satisfies the page token interface
 	if _, ok := f.request.(interface{ GetPageToken() string }); !ok {
@@ -370,18 +516,447 @@ func (f *serverFeature) resourcesCreatedFromJSON(resourcesJSON []byte) error {
 	return nil
 }
 
+func (f *serverFeature) sendingAnHTTPRequest(method, path string) error {
+	return f.sendHTTPRequest(method, path, "")
+}
+
+func (f *serverFeature) sendingAnHTTPRequestWithBody(method, path string, body *messages.PickleStepArgument_PickleDocString) error {
+	return f.sendHTTPRequest(method, path, body.Content)
+}
+
+// Sends a request to the HTTP gateway and stores the status code and body of the response
+func (f *serverFeature) sendHTTPRequest(method, path, body string) error {
+	// clear out entries from previous calls
+	f.httpStatus = 0
+	f.httpBody = ""
+
+	req, err := http.NewRequest(method, f.httpServer.URL+path, strings.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := f.httpServer.Client().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send HTTP request: %v", err)
+	}
+	defer res.Body.Close()
+
+	rawBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read HTTP response body: %v", err)
+	}
+	f.httpStatus = res.StatusCode
+	f.httpHeader = res.Header
+	f.httpBody = string(rawBody)
+	return nil
+}
+
+func (f *serverFeature) sendingACORSPreflightRequest(method, origin string) error {
+	req, err := http.NewRequest(http.MethodOptions, f.httpServer.URL+"/"+method, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %v", err)
+	}
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+
+	res, err := f.httpServer.Client().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send HTTP request: %v", err)
+	}
+	res.Body.Close()
+
+	f.httpStatus = res.StatusCode
+	f.httpHeader = res.Header
+	f.httpBody = ""
+	return nil
+}
+
+func (f *serverFeature) theHTTPResponseHeaderWillBe(name, expected string) error {
+	if actual := f.httpHeader.Get(name); actual != expected {
+		return fmt.Errorf("expected header '%s' to be '%s', got '%s'", name, expected, actual)
+	}
+	return nil
+}
+
+// Calls the RPC with the stored request using the gRPC-Web protocol. The response and error are
+// stored in the same way as a native gRPC call so the same assertions can be used for both.
+func (f *serverFeature) callingTheRPCOverGRPCWeb(method string) error {
+	// clear out entries from previous calls
+	f.response = nil
+	f.responseError = nil
+
+	payload, err := proto.Marshal(f.request.(proto.Message))
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %v", err)
+	}
+	// Every message is prefixed with a flag byte and the length of the message
+	frame := make([]byte, 5+len(payload))
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(payload)))
+	copy(frame[5:], payload)
+
+	req, err := http.NewRequest(http.MethodPost, f.httpServer.URL+"/"+method, bytes.NewReader(frame))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("X-Grpc-Web", "1")
+
+	res, err := f.httpServer.Client().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send gRPC-Web request: %v", err)
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read gRPC-Web response: %v", err)
+	}
+
+	// Grab a new instance of the proto response message for the method
+	nameParts := strings.Split(method, "/")
+	serviceDescr, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(nameParts[0]))
+	if err != nil {
+		return fmt.Errorf("unable to find service descriptor for method %v: %v", method, err)
+	}
+	methodDescr := serviceDescr.(protoreflect.ServiceDescriptor).Methods().ByName(protoreflect.Name(nameParts[1]))
+	response := reflect.New(proto.MessageType(string(methodDescr.Output().FullName())).Elem()).Interface()
+
+	// Errors without a response body will have the status sent in the headers
+	trailers := http.Header{}
+	for _, key := range []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"} {
+		if value := res.Header.Get(key); value != "" {
+			trailers.Set(key, value)
+		}
+	}
+	for len(body) >= 5 {
+		length := binary.BigEndian.Uint32(body[1:5])
+		if uint32(len(body)-5) < length {
+			return fmt.Errorf("gRPC-Web response frame is truncated")
+		}
+		data := body[5 : 5+length]
+		// The most significant bit of the flag will be set for the trailers frame
+		if body[0]&0x80 != 0 {
+			for _, line := range strings.Split(string(data), "\r\n") {
+				if parts := strings.SplitN(line, ":", 2); len(parts) == 2 {
+					trailers.Set(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+				}
+			}
+		} else if err := proto.Unmarshal(data, response.(proto.Message)); err != nil {
+			return fmt.Errorf("failed to unmarshal gRPC-Web response: %v", err)
+		}
+		body = body[5+length:]
+	}
+
+	f.response = response
+	f.responseError, err = grpcWebStatus(trailers)
+	return err
+}
+
+// Converts the gRPC-Web trailers to the error that would have been returned by a native gRPC call
+func grpcWebStatus(trailers http.Header) (error, error) {
+	if details := trailers.Get("Grpc-Status-Details-Bin"); details != "" {
+		raw, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(details, "="))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode gRPC status details: %v", err)
+		}
+		st := &spb.Status{}
+		if err := proto.Unmarshal(raw, st); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal gRPC status details: %v", err)
+		}
+		return status.ErrorProto(st), nil
+	}
+
+	code := new(codes.Code)
+	if err := code.UnmarshalJSON([]byte(trailers.Get("Grpc-Status"))); err != nil {
+		return nil, fmt.Errorf("invalid gRPC status in gRPC-Web response: %v", err)
+	}
+	message, _ := url.PathUnescape(trailers.Get("Grpc-Message"))
+	return status.Error(*code, message), nil
+}
+
+func (f *serverFeature) usingTheRequestID(id string) error {
+	f.ctx = metadata.AppendToOutgoingContext(f.ctx, "x-request-id", id)
+	return nil
+}
+
+func (f *serverFeature) usingTheClientCertificateFor(commonName string) error {
+	cert, err := f.certificates.issue(commonName)
+	if err != nil {
+		return err
+	}
+	clientConn, err := f.dial(&cert)
+	if err != nil {
+		return err
+	}
+	f.clientConn = clientConn
+	return nil
+}
+
+// dial connects to the server, presenting the client certificate when one is
+// given.
+func (f *serverFeature) dial(cert *tls.Certificate) (*grpc.ClientConn, error) {
+	config := &tls.Config{RootCAs: f.certificates.pool, ServerName: "localhost"}
+	if cert != nil {
+		config.Certificates = []tls.Certificate{*cert}
+	}
+	return grpc.Dial(f.listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(config)))
+}
+
+// testCertificates issues the certificates of the server and of the clients
+// from a CA that is created for the test run.
+type testCertificates struct {
+	ca     *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	pool   *x509.CertPool
+	server tls.Certificate
+}
+
+func newTestCertificates() (*testCertificates, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "chacerapp test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &testCertificates{ca: ca, caKey: key, pool: x509.NewCertPool()}
+	c.pool.AddCert(ca)
+	if c.server, err = c.issue("localhost", "localhost"); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// issue creates a certificate signed by the CA for the common name, which
+// can be used by a server for the DNS names or otherwise by a client.
+func (c *testCertificates) issue(commonName string, dnsNames ...string) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.ca, &key.PublicKey, c.caKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+func (f *serverFeature) usingTheForwardedAddress(addr string) error {
+	f.ctx = metadata.AppendToOutgoingContext(f.ctx, "x-forwarded-for", addr)
+	return nil
+}
+
+func (f *serverFeature) theResponseHeaderWillBe(name, expected string) error {
+	if actual := f.header.Get(name); len(actual) != 1 || actual[0] != expected {
+		return fmt.Errorf("expected header '%s' to be '%s', got %v", name, expected, actual)
+	}
+	return nil
+}
+
+func (f *serverFeature) theResponseHeaderWillBeSet(name string) error {
+	if actual := f.header.Get(name); len(actual) != 1 || actual[0] == "" {
+		return fmt.Errorf("expected header '%s' to be set, got %v", name, actual)
+	}
+	return nil
+}
+
+func (f *serverFeature) theHTTPResponseHeaderWillBeSet(name string) error {
+	if f.httpHeader.Get(name) == "" {
+		return fmt.Errorf("expected header '%s' to be set", name)
+	}
+	return nil
+}
+
+// Verifies that a JSON entry was written to the request log with the field set to the value
+func (f *serverFeature) theRequestLogWillContainAnEntryWith(table *godog.Table) error {
+	for _, line := range strings.Split(strings.TrimSpace(f.requestLog.String()), "\n") {
+		entry, err := objx.FromJSON(line)
+		if err != nil {
+			return fmt.Errorf("request log entry is not valid JSON: %v: %s", err, line)
+		}
+
+		matches := true
+		for _, row := range table.Rows {
+			if entry.Get(row.Cells[0].Value).String() != row.Cells[1].Value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return nil
+		}
+	}
+	return fmt.Errorf("no request log entry matched the table, the log contained:\n%s", f.requestLog.String())
+}
+
+// Verifies that the response error contains a QuotaFailure error detail with a violation for the subject
+func (f *serverFeature) theQuotaFailureErrorDetailsWillBeForTheSubject(subject string) error {
+	errStatus, ok := status.FromError(f.responseError)
+	if !ok {
+		return fmt.Errorf("error was not able to be converted to a gRPC status: %v", f.responseError)
+	}
+
+	for _, detail := range errStatus.Details() {
+		if quotaFailure, ok := detail.(*errdetails.QuotaFailure); ok {
+			for _, violation := range quotaFailure.Violations {
+				if violation.Subject == subject {
+					return nil
+				}
+			}
+			return fmt.Errorf("quota failure did not contain a violation for %q: %+v", subject, quotaFailure.Violations)
+		}
+	}
+	return fmt.Errorf("response error did not contain a quota failure error detail")
+}
+
+// Verifies that the response error contains a RetryInfo error detail with a retry delay
+func (f *serverFeature) theErrorDetailsWillIncludeARetryDelay() error {
+	errStatus, ok := status.FromError(f.responseError)
+	if !ok {
+		return fmt.Errorf("error was not able to be converted to a gRPC status: %v", f.responseError)
+	}
+
+	for _, detail := range errStatus.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			if retryInfo.RetryDelay.GetSeconds() <= 0 && retryInfo.RetryDelay.GetNanos() <= 0 {
+				return fmt.Errorf("expected a positive retry delay, got %v", retryInfo.RetryDelay)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("response error did not contain a retry info error detail")
+}
+
+func (f *serverFeature) theSuspensionsThatExpireWithinAreReactivated(within string) error {
+	d, err := time.ParseDuration(within)
+	if err != nil {
+		return err
+	}
+	return server.NewReactivator(f.storage).Reactivate(f.ctx, time.Now().Add(d))
+}
+
+func (f *serverFeature) theScheduledMessagesDueWithinAreSent(within string) error {
+	d, err := time.ParseDuration(within)
+	if err != nil {
+		return err
+	}
+	return server.NewDispatcher(f.storage).Dispatch(f.ctx, time.Now().Add(d))
+}
+
+func (f *serverFeature) theMessagesThatExpireWithinAreExpired(within string) error {
+	d, err := time.ParseDuration(within)
+	if err != nil {
+		return err
+	}
+	return server.NewSweeper(f.storage).Sweep(f.ctx, time.Now().Add(d))
+}
+
+func (f *serverFeature) theMessagesDueToEscalateWithinAreEscalated(within string) error {
+	d, err := time.ParseDuration(within)
+	if err != nil {
+		return err
+	}
+	return server.NewEscalator(f.storage).Escalate(f.ctx, time.Now().Add(d))
+}
+
+func (f *serverFeature) aDependencyOfTheServiceIsFailing(service string) error {
+	f.health.AddServiceCheck(service, func(context.Context) error {
+		return errors.New("dependency is unavailable")
+	})
+	if err := f.health.Check(f.ctx); err == nil {
+		return fmt.Errorf("expected the health check of %s to fail", service)
+	}
+	return nil
+}
+
+func (f *serverFeature) iWillReceiveAnHTTPStatusCode(expected int) error {
+	if f.httpStatus != expected {
+		return fmt.Errorf("expected HTTP status code %d, got %d: %s", expected, f.httpStatus, f.httpBody)
+	}
+	return nil
+}
+
+func (f *serverFeature) theHTTPResponseValueWillBe(path, expected string) error {
+	body, err := objx.FromJSON(f.httpBody)
+	if err != nil {
+		return fmt.Errorf("HTTP response body is not valid JSON: %v: %s", err, f.httpBody)
+	}
+	actual := body.Get(path).String()
+	if actual != expected {
+		return fmt.Errorf("expected '%s' to be '%s', got '%s'", path, expected, actual)
+	}
+	return nil
+}
+
 func (f *serverFeature) registerSteps(suite *godog.Suite) {
 	suite.Step(`^a JSON "([^"]*Request)"$`, f.aJSONgRPCRequest)
 	suite.Step(`^calling the "([^"]*)" RPC$`, f.callingTheRPC)
+	suite.Step(`^watching the "([^"]*)" RPC$`, f.watchingTheRPC)
+	suite.Step(`^receiving the next streamed response$`, f.receivingTheNextStreamedResponse)
+	suite.Step(`^the stream will end with an error with code "([^"]*)"$`, f.theStreamWillEndWithAnErrorWithCode)
 	suite.Step(`^I will receive an error with code ("[^"]*")$`, f.iWillReceiveAnErrorWithCode)
 	suite.Step(`^the BadRequest error details will be for the following fields$`, f.theErrorDetailsWillBeForTheFollowingFields)
 	suite.Step(`^I will receive a successful response$`, f.iWillReceiveASuccessfulResponse)
 	suite.Step(`^the response value "([^"]*)" will be "([^"]*)"$`, f.theResponseValueWillBe)
+	suite.Step(`^the response value "([^"]*)" will match "([^"]*)"$`, f.theResponseValueWillMatch)
 	suite.Step(`^the response value "([^"]*)" will have a length of (\d+)$`, f.theResponseValueWillHaveLength)
 	suite.Step(`^stashing the next page token from the response$`, f.stashingTheNextPageTokenFromTheResponse)
 	suite.Step(`^using the stashed next page token$`, f.usingTheStashedNextPageToken)
+	suite.Step(`^stashing the name from the response$`, f.stashingTheNameFromTheResponse)
+	suite.Step(`^using the stashed name as the "([^"]*)"$`, f.usingTheStashedName)
 	suite.Step(`^data loaded from the seed file "([^"]*)"$`, f.dataLoadedFromTheSeedFile)
 	suite.Step(`^these resources are created:$`, f.dataSeededFromJSONBlob)
+	suite.Step(`^sending a "([A-Z]+)" HTTP request to "([^"]*)"$`, f.sendingAnHTTPRequest)
+	suite.Step(`^sending a "([A-Z]+)" HTTP request to "([^"]*)" with the body$`, f.sendingAnHTTPRequestWithBody)
+	suite.Step(`^I will receive an HTTP status code of (\d+)$`, f.iWillReceiveAnHTTPStatusCode)
+	suite.Step(`^the HTTP response value "([^"]*)" will be "([^"]*)"$`, f.theHTTPResponseValueWillBe)
+	suite.Step(`^calling the "([^"]*)" RPC over gRPC-Web$`, f.callingTheRPCOverGRPCWeb)
+	suite.Step(`^sending a CORS preflight request for "([^"]*)" from the origin "([^"]*)"$`, f.sendingACORSPreflightRequest)
+	suite.Step(`^the HTTP response header "([^"]*)" will be "([^"]*)"$`, f.theHTTPResponseHeaderWillBe)
+	suite.Step(`^the HTTP response header "([^"]*)" will be set$`, f.theHTTPResponseHeaderWillBeSet)
+	suite.Step(`^using the request ID "([^"]*)"$`, f.usingTheRequestID)
+	suite.Step(`^using the forwarded address "([^"]*)"$`, f.usingTheForwardedAddress)
+	suite.Step(`^using the client certificate for "([^"]*)"$`, f.usingTheClientCertificateFor)
+	suite.Step(`^the response header "([^"]*)" will be "([^"]*)"$`, f.theResponseHeaderWillBe)
+	suite.Step(`^the response header "([^"]*)" will be set$`, f.theResponseHeaderWillBeSet)
+	suite.Step(`^the request log will contain an entry with$`, f.theRequestLogWillContainAnEntryWith)
+	suite.Step(`^the QuotaFailure error details will be for the subject "([^"]*)"$`, f.theQuotaFailureErrorDetailsWillBeForTheSubject)
+	suite.Step(`^the error details will include a retry delay$`, f.theErrorDetailsWillIncludeARetryDelay)
+	suite.Step(`^the suspensions that expire within "([^"]*)" are reactivated$`, f.theSuspensionsThatExpireWithinAreReactivated)
+	suite.Step(`^the scheduled messages due within "([^"]*)" are sent$`, f.theScheduledMessagesDueWithinAreSent)
+	suite.Step(`^the messages that expire within "([^"]*)" are expired$`, f.theMessagesThatExpireWithinAreExpired)
+	suite.Step(`^the messages due to escalate within "([^"]*)" are escalated$`, f.theMessagesDueToEscalateWithinAreEscalated)
+	suite.Step(`^a dependency of the "([^"]*)" service is failing$`, f.aDependencyOfTheServiceIsFailing)
 }
 
 func FeatureContext(s *godog.Suite) {
@@ -390,11 +965,22 @@ func FeatureContext(s *godog.Suite) {
 	feature := &serverFeature{}
 	feature.registerSteps(s)
 
+	feature.certificates, err = newTestCertificates()
+	if err != nil {
+		log.Fatalf("failed to create test certificates: %v", err)
+	}
+
 	s.BeforeSuite(func() {
-		m, err := migrate.New("file://../migrations", "cockroachdb://root@localhost:26257/chacerapp_tests?sslmode=disable")
+		db, err := sql.Open("postgres", "postgres://root@localhost:26257/chacerapp_tests?sslmode=disable")
+		if err != nil {
+			log.Fatalf("failed to open new database connection: %v", err)
+		}
+		// Closing the migration runner will also close the database
+		m, err := migrations.New(db)
 		if err != nil {
 			log.Fatalf("failed to migrate database: %v", err)
 		}
+		defer m.Close()
 		if err := m.Up(); err != nil && err != migrate.ErrNoChange {
 			log.Fatalf("failed to migrate database: %v", err)
 		}
@@ -406,10 +992,11 @@ func FeatureContext(s *godog.Suite) {
 			log.Fatalf("failed to create tcp listener: %v", err)
 		}
 
-		feature.clientConn, err = grpc.Dial(feature.listener.Addr().String(), grpc.WithInsecure())
+		clientConn, err := feature.dial(nil)
 		if err != nil {
 			log.Fatalf("failed to create client connection: %v", err)
 		}
+		feature.clientConn = clientConn
 		feature.ctx = context.Background()
 		feature.db, err = sql.Open("txdb", "postgres://root@localhost:26257/chacerapp_tests?sslmode=disable")
 		if err != nil {
@@ -417,15 +1004,46 @@ func FeatureContext(s *godog.Suite) {
 		}
 
 		// Create a new gRPC server to run tests against
-		feature.server = server.NewGRPCServer(store.New(
+		feature.requestLog.Reset()
+		feature.storage = store.New(
 			feature.db,
 			store.NewPaginator([]byte("my-super-secure-test-secret-3234")),
-		))
+		)
+		feature.health = server.NewHealth(feature.storage)
+		// Clients can connect with a certificate from the test CA to be
+		// identified by it
+		creds := credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{feature.certificates.server},
+			ClientCAs:    feature.certificates.pool,
+			ClientAuth:   tls.VerifyClientCertIfGiven,
+		})
+		feature.server = server.NewGRPCServer(
+			feature.storage,
+			server.WithLogOutput(&feature.requestLog),
+			server.WithHealth(feature.health),
+			server.WithGRPCOptions(grpc.Creds(creds)),
+		)
 		// Start the server in the background
 		go feature.server.Serve(feature.listener)
+
+		// Create a new HTTP gateway in front of the gRPC server
+		gateway, err := server.NewHTTPHandler(feature.ctx, clientConn)
+		if err != nil {
+			log.Fatalf("failed to create HTTP gateway: %v", err)
+		}
+		feature.httpServer = httptest.NewServer(server.NewGRPCWebHandler(
+			feature.server,
+			gateway,
+			[]string{"https://console.chacerapp.test"},
+		))
 	})
 
 	s.AfterScenario(func(*messages.Pickle, error) {
+		if feature.stopStream != nil {
+			feature.stopStream()
+			feature.stopStream = nil
+		}
+		feature.httpServer.Close()
 		feature.listener.Close()
 		feature.server.Stop()
 		feature.db.Close()
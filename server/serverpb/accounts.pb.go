@@ -9,6 +9,7 @@ package serverpb
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
@@ -32,6 +33,127 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// SuspensionEnd contains the ways a suspension can end.
+type SuspensionEnd int32
+
+const (
+	// The suspension has not ended.
+	SuspensionEnd_SUSPENSION_END_UNSPECIFIED SuspensionEnd = 0
+	// The account was activated by an administrator.
+	SuspensionEnd_SUSPENSION_END_ACTIVATED SuspensionEnd = 1
+	// The account was activated by its owner.
+	SuspensionEnd_SUSPENSION_END_SELF_ACTIVATED SuspensionEnd = 2
+	// The suspension expired and the account was activated automatically.
+	SuspensionEnd_SUSPENSION_END_EXPIRED SuspensionEnd = 3
+	// The account was deactivated while it was suspended.
+	SuspensionEnd_SUSPENSION_END_DEACTIVATED SuspensionEnd = 4
+)
+
+// Enum value maps for SuspensionEnd.
+var (
+	SuspensionEnd_name = map[int32]string{
+		0: "SUSPENSION_END_UNSPECIFIED",
+		1: "SUSPENSION_END_ACTIVATED",
+		2: "SUSPENSION_END_SELF_ACTIVATED",
+		3: "SUSPENSION_END_EXPIRED",
+		4: "SUSPENSION_END_DEACTIVATED",
+	}
+	SuspensionEnd_value = map[string]int32{
+		"SUSPENSION_END_UNSPECIFIED":    0,
+		"SUSPENSION_END_ACTIVATED":      1,
+		"SUSPENSION_END_SELF_ACTIVATED": 2,
+		"SUSPENSION_END_EXPIRED":        3,
+		"SUSPENSION_END_DEACTIVATED":    4,
+	}
+)
+
+func (x SuspensionEnd) Enum() *SuspensionEnd {
+	p := new(SuspensionEnd)
+	*p = x
+	return p
+}
+
+func (x SuspensionEnd) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuspensionEnd) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_accounts_proto_enumTypes[0].Descriptor()
+}
+
+func (SuspensionEnd) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_accounts_proto_enumTypes[0]
+}
+
+func (x SuspensionEnd) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuspensionEnd.Descriptor instead.
+func (SuspensionEnd) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{0}
+}
+
+// RateLimitTier contains the tiers of rate limits that can be
+// applied to an account.
+type RateLimitTier int32
+
+const (
+	// Not set. The standard limits will be applied.
+	RateLimitTier_RATE_LIMIT_TIER_UNSPECIFIED RateLimitTier = 0
+	// The limits for most accounts.
+	RateLimitTier_RATE_LIMIT_TIER_STANDARD RateLimitTier = 1
+	// Higher limits for accounts with a large number of
+	// locations or integrations.
+	RateLimitTier_RATE_LIMIT_TIER_PREMIUM RateLimitTier = 2
+	// Requests are not limited by account. Requests are still
+	// limited by caller.
+	RateLimitTier_RATE_LIMIT_TIER_UNLIMITED RateLimitTier = 3
+)
+
+// Enum value maps for RateLimitTier.
+var (
+	RateLimitTier_name = map[int32]string{
+		0: "RATE_LIMIT_TIER_UNSPECIFIED",
+		1: "RATE_LIMIT_TIER_STANDARD",
+		2: "RATE_LIMIT_TIER_PREMIUM",
+		3: "RATE_LIMIT_TIER_UNLIMITED",
+	}
+	RateLimitTier_value = map[string]int32{
+		"RATE_LIMIT_TIER_UNSPECIFIED": 0,
+		"RATE_LIMIT_TIER_STANDARD":    1,
+		"RATE_LIMIT_TIER_PREMIUM":     2,
+		"RATE_LIMIT_TIER_UNLIMITED":   3,
+	}
+)
+
+func (x RateLimitTier) Enum() *RateLimitTier {
+	p := new(RateLimitTier)
+	*p = x
+	return p
+}
+
+func (x RateLimitTier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimitTier) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_accounts_proto_enumTypes[1].Descriptor()
+}
+
+func (RateLimitTier) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_accounts_proto_enumTypes[1]
+}
+
+func (x RateLimitTier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimitTier.Descriptor instead.
+func (RateLimitTier) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{1}
+}
+
 // AccountPhase contains the possible phases that an account can be in.
 type AccountPhase int32
 
@@ -40,7 +162,8 @@ const (
 	AccountPhase_ACCOUNT_PHASE_UNSPECIFIED AccountPhase = 0
 	// The account is active.
 	AccountPhase_ACCOUNT_PHASE_ACTIVE AccountPhase = 1
-	// The account is inactive.
+	// The account is inactive at the request of its owner and
+	// can be activated again.
 	AccountPhase_ACCOUNT_PHASE_INACTIVE AccountPhase = 2
 	// The account is in a pending state and must be activated
 	// by an administrator. Refer to the reason and description
@@ -90,11 +213,11 @@ func (x AccountPhase) String() string {
 }
 
 func (AccountPhase) Descriptor() protoreflect.EnumDescriptor {
-	return file_chacerapp_v1_accounts_proto_enumTypes[0].Descriptor()
+	return file_chacerapp_v1_accounts_proto_enumTypes[2].Descriptor()
 }
 
 func (AccountPhase) Type() protoreflect.EnumType {
-	return &file_chacerapp_v1_accounts_proto_enumTypes[0]
+	return &file_chacerapp_v1_accounts_proto_enumTypes[2]
 }
 
 func (x AccountPhase) Number() protoreflect.EnumNumber {
@@ -103,7 +226,7 @@ func (x AccountPhase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccountPhase.Descriptor instead.
 func (AccountPhase) EnumDescriptor() ([]byte, []int) {
-	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{0}
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{2}
 }
 
 // Represents an account in the platform
@@ -282,11 +405,29 @@ type AccountQuotas struct {
 	// The name of the resource in the format `accounts/*`
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The max number of locations that are allowed
-	// to exist on the account.
-	Locations int32 `protobuf:"varint,2,opt,name=locations,proto3" json:"locations,omitempty"`
-	// The max number of devices that can be created
-	// on the account.
-	Devices int32 `protobuf:"varint,3,opt,name=devices,proto3" json:"devices,omitempty"`
+	// to exist on the account. The default for the tier
+	// of the account is used when it isn't set, and a
+	// value of 0 doesn't allow any locations.
+	Locations *int32 `protobuf:"varint,2,opt,name=locations,proto3,oneof" json:"locations,omitempty"`
+	// The max number of devices that can be registered
+	// on the account. The default for the tier of the
+	// account is used when it isn't set, and a value of
+	// 0 doesn't allow any devices.
+	Devices *int32 `protobuf:"varint,3,opt,name=devices,proto3,oneof" json:"devices,omitempty"`
+	// The tier of the account, which determines the default
+	// quotas and rate limits for the account.
+	RateLimitTier RateLimitTier `protobuf:"varint,4,opt,name=rate_limit_tier,json=rateLimitTier,proto3,enum=chacerapp.v1.RateLimitTier" json:"rate_limit_tier,omitempty"`
+	// Rate limits that override the limits of the tier for
+	// specific methods.
+	RateLimits []*RateLimit `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// The max number of rooms that can be created across
+	// every location on the account. The default for the
+	// tier of the account is used when it isn't set, and
+	// a value of 0 doesn't allow any rooms.
+	Rooms *int32 `protobuf:"varint,6,opt,name=rooms,proto3,oneof" json:"rooms,omitempty"`
+	// The number of resources on the account that count
+	// against the quotas.
+	Usage *AccountUsage `protobuf:"bytes,7,opt,name=usage,proto3" json:"usage,omitempty"`
 }
 
 func (x *AccountQuotas) Reset() {
@@ -329,37 +470,63 @@ func (x *AccountQuotas) GetName() string {
 }
 
 func (x *AccountQuotas) GetLocations() int32 {
-	if x != nil {
-		return x.Locations
+	if x != nil && x.Locations != nil {
+		return *x.Locations
 	}
 	return 0
 }
 
 func (x *AccountQuotas) GetDevices() int32 {
+	if x != nil && x.Devices != nil {
+		return *x.Devices
+	}
+	return 0
+}
+
+func (x *AccountQuotas) GetRateLimitTier() RateLimitTier {
 	if x != nil {
-		return x.Devices
+		return x.RateLimitTier
+	}
+	return RateLimitTier_RATE_LIMIT_TIER_UNSPECIFIED
+}
+
+func (x *AccountQuotas) GetRateLimits() []*RateLimit {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+func (x *AccountQuotas) GetRooms() int32 {
+	if x != nil && x.Rooms != nil {
+		return *x.Rooms
 	}
 	return 0
 }
 
-// AccountStatus represents the status of an account.
-type AccountStatus struct {
+func (x *AccountQuotas) GetUsage() *AccountUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// AccountUsage is the number of resources that exist on an account.
+type AccountUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the resource in the format `accounts/*/status`
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The phase of the accont.
-	Phase AccountPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=chacerapp.v1.AccountPhase" json:"phase,omitempty"`
-	// The reason that an account is in a phase.
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	// A longer message for why an account is in a phase.
-	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// The number of locations on the account.
+	Locations int32 `protobuf:"varint,1,opt,name=locations,proto3" json:"locations,omitempty"`
+	// The number of rooms across every location on the account.
+	Rooms int32 `protobuf:"varint,2,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	// The number of devices registered across every location on the account.
+	Devices int32 `protobuf:"varint,3,opt,name=devices,proto3" json:"devices,omitempty"`
 }
 
-func (x *AccountStatus) Reset() {
-	*x = AccountStatus{}
+func (x *AccountUsage) Reset() {
+	*x = AccountUsage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -367,13 +534,13 @@ func (x *AccountStatus) Reset() {
 	}
 }
 
-func (x *AccountStatus) String() string {
+func (x *AccountUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountStatus) ProtoMessage() {}
+func (*AccountUsage) ProtoMessage() {}
 
-func (x *AccountStatus) ProtoReflect() protoreflect.Message {
+func (x *AccountUsage) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -385,61 +552,52 @@ func (x *AccountStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountStatus.ProtoReflect.Descriptor instead.
-func (*AccountStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountUsage.ProtoReflect.Descriptor instead.
+func (*AccountUsage) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *AccountStatus) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AccountStatus) GetPhase() AccountPhase {
+func (x *AccountUsage) GetLocations() int32 {
 	if x != nil {
-		return x.Phase
+		return x.Locations
 	}
-	return AccountPhase_ACCOUNT_PHASE_UNSPECIFIED
+	return 0
 }
 
-func (x *AccountStatus) GetReason() string {
+func (x *AccountUsage) GetRooms() int32 {
 	if x != nil {
-		return x.Reason
+		return x.Rooms
 	}
-	return ""
+	return 0
 }
 
-func (x *AccountStatus) GetMessage() string {
+func (x *AccountUsage) GetDevices() int32 {
 	if x != nil {
-		return x.Message
+		return x.Devices
 	}
-	return ""
+	return 0
 }
 
-// ListAccountsRequest will return a paginated list of accounts.
-//
-// (-- api-linter: core::0132::request-parent-required=disabled
-//     aip.dev/not-precedent: Accounts are top level resources and have no parent. --)
-type ListAccountsRequest struct {
+// RateLimit is the rate that requests can be made to a method
+// for an account.
+type RateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The max number of results per page that should be returned. If the number
-	// of available results is larger than `page_size`, a `next_page_token` is
-	// returned which can be used to get the next page of results in subsequent
-	// requests. Acceptable values are 0 to 500, inclusive. (Default: 10)
-	// The default value is used when a page_size of 0 is provided.
-	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Specifies a page token to use. Set this to the nextPageToken returned by
-	// previous list requests to get the next page of results.
-	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The full name of the method the limit applies to, e.g.
+	// `chacerapp.v1.Messenger/SendMessage`, or `*` to apply the
+	// limit to every method without a more specific limit.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// The number of requests per second that are allowed.
+	RequestsPerSecond float64 `protobuf:"fixed64,2,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// The number of requests that can be made at once before
+	// the rate applies.
+	Burst int32 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
 }
 
-func (x *ListAccountsRequest) Reset() {
-	*x = ListAccountsRequest{}
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,13 +605,13 @@ func (x *ListAccountsRequest) Reset() {
 	}
 }
 
-func (x *ListAccountsRequest) String() string {
+func (x *RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsRequest) ProtoMessage() {}
+func (*RateLimit) ProtoMessage() {}
 
-func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,42 +623,53 @@ func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
-func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListAccountsRequest) GetPageSize() int32 {
+func (x *RateLimit) GetMethod() string {
 	if x != nil {
-		return x.PageSize
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RateLimit) GetRequestsPerSecond() float64 {
+	if x != nil {
+		return x.RequestsPerSecond
 	}
 	return 0
 }
 
-func (x *ListAccountsRequest) GetPageToken() string {
+func (x *RateLimit) GetBurst() int32 {
 	if x != nil {
-		return x.PageToken
+		return x.Burst
 	}
-	return ""
+	return 0
 }
 
-// ListAccountsResponse will list the accounts.
-type ListAccountsResponse struct {
+// AccountStatus represents the status of an account.
+type AccountStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A list of accounts.
-	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	// This token allows you to get the next page of results for list requests.
-	// If the number of results is larger than `page_size`, use the
-	// `next_page_token` as a value for the query parameter `page_token` in the
-	// next request. The value will become empty when there are no more pages.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The name of the resource in the format `accounts/*/status`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The phase of the accont.
+	Phase AccountPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=chacerapp.v1.AccountPhase" json:"phase,omitempty"`
+	// The reason that an account is in a phase.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// A longer message for why an account is in a phase.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// The time a temporary suspension of the account will end
+	// and the account will be activated.
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
 }
 
-func (x *ListAccountsResponse) Reset() {
-	*x = ListAccountsResponse{}
+func (x *AccountStatus) Reset() {
+	*x = AccountStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,13 +677,13 @@ func (x *ListAccountsResponse) Reset() {
 	}
 }
 
-func (x *ListAccountsResponse) String() string {
+func (x *AccountStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsResponse) ProtoMessage() {}
+func (*AccountStatus) ProtoMessage() {}
 
-func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+func (x *AccountStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,46 +695,69 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountStatus.ProtoReflect.Descriptor instead.
+func (*AccountStatus) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListAccountsResponse) GetAccounts() []*Account {
+func (x *AccountStatus) GetName() string {
 	if x != nil {
-		return x.Accounts
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAccountsResponse) GetNextPageToken() string {
+func (x *AccountStatus) GetPhase() AccountPhase {
 	if x != nil {
-		return x.NextPageToken
+		return x.Phase
+	}
+	return AccountPhase_ACCOUNT_PHASE_UNSPECIFIED
+}
+
+func (x *AccountStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-// CreateAccountRequest will
-//
-// (-- api-linter: core::0133::request-parent-required=disabled
-//     aip.dev/not-precedent: We need to do this because reasons. --)
-type CreateAccountRequest struct {
+func (x *AccountStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AccountStatus) GetExpireTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// SuspensionReason describes a reason that an account can be suspended for.
+type SuspensionReason struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The account that should be created.
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The ID to use for the account, which will become the final component of
-	// the accounts's resource name.
-	//
-	// This value should be between 4 and 63 characters. Valid characters
-	// are /[a-z][0-9]-/.
-	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-}
-
-func (x *CreateAccountRequest) Reset() {
-	*x = CreateAccountRequest{}
+	// The code of the reason, which is used as the reason of a
+	// SuspendAccountRequest.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// A description of the reason that can be shown to the owner
+	// of the account.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the owner of the account can activate the account
+	// themselves once they have resolved the reason.
+	SelfReactivate bool `protobuf:"varint,3,opt,name=self_reactivate,json=selfReactivate,proto3" json:"self_reactivate,omitempty"`
+	// How long a suspension for the reason lasts before the account
+	// is activated automatically, when not set by the request. Not
+	// set when suspensions for the reason don't expire.
+	ReactivateAfter *duration.Duration `protobuf:"bytes,4,opt,name=reactivate_after,json=reactivateAfter,proto3" json:"reactivate_after,omitempty"`
+}
+
+func (x *SuspensionReason) Reset() {
+	*x = SuspensionReason{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,13 +765,13 @@ func (x *CreateAccountRequest) Reset() {
 	}
 }
 
-func (x *CreateAccountRequest) String() string {
+func (x *SuspensionReason) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountRequest) ProtoMessage() {}
+func (*SuspensionReason) ProtoMessage() {}
 
-func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *SuspensionReason) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,53 +783,78 @@ func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuspensionReason.ProtoReflect.Descriptor instead.
+func (*SuspensionReason) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateAccountRequest) GetAccount() *Account {
+func (x *SuspensionReason) GetCode() string {
 	if x != nil {
-		return x.Account
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateAccountRequest) GetAccountId() string {
+func (x *SuspensionReason) GetDescription() string {
 	if x != nil {
-		return x.AccountId
+		return x.Description
 	}
 	return ""
 }
 
-// UpdateAccountRequest will update the account.
-type UpdateAccountRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The account that should be updated.
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The update mask that applies to the resource.
-	UpdateMask *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+func (x *SuspensionReason) GetSelfReactivate() bool {
+	if x != nil {
+		return x.SelfReactivate
+	}
+	return false
 }
 
-func (x *UpdateAccountRequest) Reset() {
-	*x = UpdateAccountRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_accounts_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SuspensionReason) GetReactivateAfter() *duration.Duration {
+	if x != nil {
+		return x.ReactivateAfter
 	}
+	return nil
 }
 
-func (x *UpdateAccountRequest) String() string {
+// AccountSuspension is a record of an account being suspended.
+type AccountSuspension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the resource in the format `accounts/*/suspensions/*`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The code of the reason the account was suspended for.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// A longer message for why the account was suspended.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The time the account was suspended.
+	SuspendTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=suspend_time,json=suspendTime,proto3" json:"suspend_time,omitempty"`
+	// The time the suspension was set to expire, if it was temporary.
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The time the suspension ended. Not set while the account is
+	// still suspended.
+	EndTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// How the suspension ended.
+	End SuspensionEnd `protobuf:"varint,7,opt,name=end,proto3,enum=chacerapp.v1.SuspensionEnd" json:"end,omitempty"`
+}
+
+func (x *AccountSuspension) Reset() {
+	*x = AccountSuspension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountSuspension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAccountRequest) ProtoMessage() {}
+func (*AccountSuspension) ProtoMessage() {}
 
-func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountSuspension) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -649,38 +866,83 @@ func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountSuspension.ProtoReflect.Descriptor instead.
+func (*AccountSuspension) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateAccountRequest) GetAccount() *Account {
+func (x *AccountSuspension) GetName() string {
 	if x != nil {
-		return x.Account
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountSuspension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccountSuspension) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AccountSuspension) GetSuspendTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.SuspendTime
 	}
 	return nil
 }
 
-func (x *UpdateAccountRequest) GetUpdateMask() *field_mask.FieldMask {
+func (x *AccountSuspension) GetExpireTime() *timestamp.Timestamp {
 	if x != nil {
-		return x.UpdateMask
+		return x.ExpireTime
 	}
 	return nil
 }
 
-// GetAccountRequest will get an account.
-type GetAccountRequest struct {
+func (x *AccountSuspension) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *AccountSuspension) GetEnd() SuspensionEnd {
+	if x != nil {
+		return x.End
+	}
+	return SuspensionEnd_SUSPENSION_END_UNSPECIFIED
+}
+
+// ListAccountsRequest will return a paginated list of accounts.
+//
+// (-- api-linter: core::0132::request-parent-required=disabled
+//
+//	aip.dev/not-precedent: Accounts are top level resources and have no parent. --)
+type ListAccountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the account to get.
-	// Specified in the format 'accounts/*`.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The max number of results per page that should be returned. If the number
+	// of available results is larger than `page_size`, a `next_page_token` is
+	// returned which can be used to get the next page of results in subsequent
+	// requests. Acceptable values are 0 to 500, inclusive. (Default: 10)
+	// The default value is used when a page_size of 0 is provided.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Specifies a page token to use. Set this to the nextPageToken returned by
+	// previous list requests to get the next page of results.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *GetAccountRequest) Reset() {
-	*x = GetAccountRequest{}
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -688,13 +950,13 @@ func (x *GetAccountRequest) Reset() {
 	}
 }
 
-func (x *GetAccountRequest) String() string {
+func (x *ListAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountRequest) ProtoMessage() {}
+func (*ListAccountsRequest) ProtoMessage() {}
 
-func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,31 +968,42 @@ func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetAccountRequest) GetName() string {
+func (x *ListAccountsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Name
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccountsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-// ActivateAccountRequest will approve an account and activate it.
-type ActivateAccountRequest struct {
+// ListAccountsResponse will list the accounts.
+type ListAccountsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the account to delete.
-	// Specified in the format 'accounts/*`.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A list of accounts.
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// This token allows you to get the next page of results for list requests.
+	// If the number of results is larger than `page_size`, use the
+	// `next_page_token` as a value for the query parameter `page_token` in the
+	// next request. The value will become empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *ActivateAccountRequest) Reset() {
-	*x = ActivateAccountRequest{}
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -738,13 +1011,13 @@ func (x *ActivateAccountRequest) Reset() {
 	}
 }
 
-func (x *ActivateAccountRequest) String() string {
+func (x *ListAccountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateAccountRequest) ProtoMessage() {}
+func (*ListAccountsResponse) ProtoMessage() {}
 
-func (x *ActivateAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -756,27 +1029,51 @@ func (x *ActivateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateAccountRequest.ProtoReflect.Descriptor instead.
-func (*ActivateAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ActivateAccountRequest) GetName() string {
+func (x *ListAccountsResponse) GetAccounts() []*Account {
 	if x != nil {
-		return x.Name
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ListAccountsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-// ActivateAccountResponse will activate an account.
-type ActivateAccountResponse struct {
+// CreateAccountRequest will
+//
+// (-- api-linter: core::0133::request-parent-required=disabled
+//
+//	aip.dev/not-precedent: We need to do this because reasons. --)
+type CreateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The account that should be created.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The ID to use for the account, which will become the final component of
+	// the accounts's resource name.
+	//
+	// This value should be between 4 and 63 characters. Valid characters
+	// are /[a-z][0-9]-/.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Whether the account is being created by its own users when signing
+	// up, rather than by an administrator. Self-service accounts start in
+	// the PENDING phase and must be activated before they can be used.
+	SelfService bool `protobuf:"varint,3,opt,name=self_service,json=selfService,proto3" json:"self_service,omitempty"`
 }
 
-func (x *ActivateAccountResponse) Reset() {
-	*x = ActivateAccountResponse{}
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -784,13 +1081,13 @@ func (x *ActivateAccountResponse) Reset() {
 	}
 }
 
-func (x *ActivateAccountResponse) String() string {
+func (x *CreateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateAccountResponse) ProtoMessage() {}
+func (*CreateAccountRequest) ProtoMessage() {}
 
-func (x *ActivateAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -802,33 +1099,46 @@ func (x *ActivateAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateAccountResponse.ProtoReflect.Descriptor instead.
-func (*ActivateAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{9}
 }
 
-// SuspendAccountRequest will approve an account and activate it.
-type SuspendAccountRequest struct {
+func (x *CreateAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetSelfService() bool {
+	if x != nil {
+		return x.SelfService
+	}
+	return false
+}
+
+// UpdateAccountRequest will update the account.
+type UpdateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the account to delete.
-	// Specified in the format 'accounts/*`.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The reason why the account is being suspended. The following
-	// reasons are currently supported.
-	//
-	// Supported Values:
-	// - Billing
-	// - Fraud
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	// A longer message explaining why the account has been suspended.
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The account that should be updated.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The update mask that applies to the resource.
+	UpdateMask *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *SuspendAccountRequest) Reset() {
-	*x = SuspendAccountRequest{}
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -836,13 +1146,13 @@ func (x *SuspendAccountRequest) Reset() {
 	}
 }
 
-func (x *SuspendAccountRequest) String() string {
+func (x *UpdateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendAccountRequest) ProtoMessage() {}
+func (*UpdateAccountRequest) ProtoMessage() {}
 
-func (x *SuspendAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -854,45 +1164,38 @@ func (x *SuspendAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendAccountRequest.ProtoReflect.Descriptor instead.
-func (*SuspendAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SuspendAccountRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SuspendAccountRequest) GetReason() string {
+func (x *UpdateAccountRequest) GetAccount() *Account {
 	if x != nil {
-		return x.Reason
+		return x.Account
 	}
-	return ""
+	return nil
 }
 
-func (x *SuspendAccountRequest) GetMessage() string {
+func (x *UpdateAccountRequest) GetUpdateMask() *field_mask.FieldMask {
 	if x != nil {
-		return x.Message
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-// SuspendAccountResponse will suspend an account.
-type SuspendAccountResponse struct {
+// GetAccountRequest will get an account.
+type GetAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the account to Suspend.
+	// The name of the account to get.
 	// Specified in the format 'accounts/*`.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SuspendAccountResponse) Reset() {
-	*x = SuspendAccountResponse{}
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_accounts_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -900,13 +1203,13 @@ func (x *SuspendAccountResponse) Reset() {
 	}
 }
 
-func (x *SuspendAccountResponse) String() string {
+func (x *GetAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendAccountResponse) ProtoMessage() {}
+func (*GetAccountRequest) ProtoMessage() {}
 
-func (x *SuspendAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_accounts_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -918,46 +1221,603 @@ func (x *SuspendAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendAccountResponse.ProtoReflect.Descriptor instead.
-func (*SuspendAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SuspendAccountResponse) GetName() string {
+func (x *GetAccountRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// DeleteAccountRequest will delete an account.
-type DeleteAccountRequest struct {
+// ActivateAccountRequest will approve an account and activate it.
+type ActivateAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the account to delete.
+	// Specified in the format 'accounts/*`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the account is being activated by its owner, rather
+	// than by an administrator.
+	SelfService bool `protobuf:"varint,2,opt,name=self_service,json=selfService,proto3" json:"self_service,omitempty"`
+}
+
+func (x *ActivateAccountRequest) Reset() {
+	*x = ActivateAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateAccountRequest) ProtoMessage() {}
+
+func (x *ActivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ActivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ActivateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivateAccountRequest) GetSelfService() bool {
+	if x != nil {
+		return x.SelfService
+	}
+	return false
+}
+
+// ActivateAccountResponse will activate an account.
+type ActivateAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ActivateAccountResponse) Reset() {
+	*x = ActivateAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateAccountResponse) ProtoMessage() {}
+
+func (x *ActivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*ActivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{13}
+}
+
+// SuspendAccountRequest will approve an account and activate it.
+type SuspendAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the account to delete.
+	// Specified in the format 'accounts/*`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The reason why the account is being suspended. This must be
+	// the code of one of the reasons returned by ListSuspensionReasons.
+	//
+	// Supported Values:
+	// - Billing
+	// - Fraud
+	// - Abuse
+	// - Security
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// A longer message explaining why the account has been suspended.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The time the suspension will end and the account will be
+	// activated automatically. The default reactivation time of the
+	// reason is used when not set.
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *SuspendAccountRequest) Reset() {
+	*x = SuspendAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspendAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendAccountRequest) ProtoMessage() {}
+
+func (x *SuspendAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendAccountRequest.ProtoReflect.Descriptor instead.
+func (*SuspendAccountRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SuspendAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SuspendAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendAccountRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SuspendAccountRequest) GetExpireTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// SuspendAccountResponse will suspend an account.
+type SuspendAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the account to Suspend.
+	// Specified in the format 'accounts/*`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SuspendAccountResponse) Reset() {
+	*x = SuspendAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspendAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendAccountResponse) ProtoMessage() {}
+
+func (x *SuspendAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendAccountResponse.ProtoReflect.Descriptor instead.
+func (*SuspendAccountResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SuspendAccountResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeactivateAccountRequest will make an account inactive.
+type DeactivateAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the account to deactivate.
+	// Specified in the format 'accounts/*`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A message explaining why the account is being deactivated.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeactivateAccountRequest) Reset() {
+	*x = DeactivateAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountRequest) ProtoMessage() {}
+
+func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeactivateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeactivateAccountRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DeactivateAccountResponse will deactivate an account.
+type DeactivateAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeactivateAccountResponse) Reset() {
+	*x = DeactivateAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountResponse) ProtoMessage() {}
+
+func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{17}
+}
+
+// DeleteAccountRequest will delete an account.
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the account to delete.
+	// Specified in the format 'accounts/*`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ListAccountSuspensionsRequest will list the suspensions of an account.
+type ListAccountSuspensionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The account to list the suspensions of.
+	// Specified in the format 'accounts/*'.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The max number of results per page that should be returned. If the number
+	// of available results is larger than `page_size`, a `next_page_token` is
+	// returned which can be used to get the next page of results in subsequent
+	// requests. Acceptable values are 0 to 500, inclusive. (Default: 10)
+	// The default value is used when a page_size of 0 is provided.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Specifies a page token to use. Set this to the nextPageToken returned by
+	// previous list requests to get the next page of results.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListAccountSuspensionsRequest) Reset() {
+	*x = ListAccountSuspensionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAccountSuspensionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountSuspensionsRequest) ProtoMessage() {}
+
+func (x *ListAccountSuspensionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountSuspensionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountSuspensionsRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListAccountSuspensionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListAccountSuspensionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccountSuspensionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListAccountSuspensionsResponse will list the suspensions of an account.
+type ListAccountSuspensionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A list of suspensions of the account.
+	AccountSuspensions []*AccountSuspension `protobuf:"bytes,1,rep,name=account_suspensions,json=accountSuspensions,proto3" json:"account_suspensions,omitempty"`
+	// This token allows you to get the next page of results for list requests.
+	// If the number of results is larger than `page_size`, use the
+	// `next_page_token` as a value for the query parameter `page_token` in the
+	// next request. The value will become empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAccountSuspensionsResponse) Reset() {
+	*x = ListAccountSuspensionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAccountSuspensionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountSuspensionsResponse) ProtoMessage() {}
+
+func (x *ListAccountSuspensionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountSuspensionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountSuspensionsResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListAccountSuspensionsResponse) GetAccountSuspensions() []*AccountSuspension {
+	if x != nil {
+		return x.AccountSuspensions
+	}
+	return nil
+}
+
+func (x *ListAccountSuspensionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListSuspensionReasonsRequest will list the reasons an account can be
+// suspended for.
+type ListSuspensionReasonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSuspensionReasonsRequest) Reset() {
+	*x = ListSuspensionReasonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSuspensionReasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuspensionReasonsRequest) ProtoMessage() {}
+
+func (x *ListSuspensionReasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuspensionReasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuspensionReasonsRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{21}
+}
+
+// ListSuspensionReasonsResponse will list the reasons an account can be
+// suspended for.
+type ListSuspensionReasonsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the account to delete.
-	// Specified in the format 'accounts/*`.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A list of the reasons an account can be suspended for.
+	SuspensionReasons []*SuspensionReason `protobuf:"bytes,1,rep,name=suspension_reasons,json=suspensionReasons,proto3" json:"suspension_reasons,omitempty"`
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
+func (x *ListSuspensionReasonsResponse) Reset() {
+	*x = ListSuspensionReasonsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_accounts_proto_msgTypes[12]
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *ListSuspensionReasonsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*ListSuspensionReasonsResponse) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_accounts_proto_msgTypes[12]
+func (x *ListSuspensionReasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,16 +1828,16 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListSuspensionReasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuspensionReasonsResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteAccountRequest) GetName() string {
+func (x *ListSuspensionReasonsResponse) GetSuspensionReasons() []*SuspensionReason {
 	if x != nil {
-		return x.Name
+		return x.SuspensionReasons
 	}
-	return ""
+	return nil
 }
 
 // GetAccountStatusRequest will retrieve the status for an account.
@@ -994,7 +1854,7 @@ type GetAccountStatusRequest struct {
 func (x *GetAccountStatusRequest) Reset() {
 	*x = GetAccountStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_accounts_proto_msgTypes[13]
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1007,7 +1867,7 @@ func (x *GetAccountStatusRequest) String() string {
 func (*GetAccountStatusRequest) ProtoMessage() {}
 
 func (x *GetAccountStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_accounts_proto_msgTypes[13]
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1880,7 @@ func (x *GetAccountStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetAccountStatusRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{13}
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetAccountStatusRequest) GetName() string {
@@ -1045,7 +1905,7 @@ type UpdateAccountStatusRequest struct {
 func (x *UpdateAccountStatusRequest) Reset() {
 	*x = UpdateAccountStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_accounts_proto_msgTypes[14]
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1058,7 +1918,7 @@ func (x *UpdateAccountStatusRequest) String() string {
 func (*UpdateAccountStatusRequest) ProtoMessage() {}
 
 func (x *UpdateAccountStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_accounts_proto_msgTypes[14]
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,7 +1931,7 @@ func (x *UpdateAccountStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAccountStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAccountStatusRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{14}
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdateAccountStatusRequest) GetAccountStatus() *AccountStatus {
@@ -1102,7 +1962,7 @@ type GetAccountQuotasRequest struct {
 func (x *GetAccountQuotasRequest) Reset() {
 	*x = GetAccountQuotasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_accounts_proto_msgTypes[15]
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1115,7 +1975,7 @@ func (x *GetAccountQuotasRequest) String() string {
 func (*GetAccountQuotasRequest) ProtoMessage() {}
 
 func (x *GetAccountQuotasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_accounts_proto_msgTypes[15]
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,7 +1988,7 @@ func (x *GetAccountQuotasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountQuotasRequest.ProtoReflect.Descriptor instead.
 func (*GetAccountQuotasRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{15}
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetAccountQuotasRequest) GetName() string {
@@ -1153,7 +2013,7 @@ type UpdateAccountQuotasRequest struct {
 func (x *UpdateAccountQuotasRequest) Reset() {
 	*x = UpdateAccountQuotasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_accounts_proto_msgTypes[16]
+		mi := &file_chacerapp_v1_accounts_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1166,7 +2026,7 @@ func (x *UpdateAccountQuotasRequest) String() string {
 func (*UpdateAccountQuotasRequest) ProtoMessage() {}
 
 func (x *UpdateAccountQuotasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_accounts_proto_msgTypes[16]
+	mi := &file_chacerapp_v1_accounts_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +2039,7 @@ func (x *UpdateAccountQuotasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAccountQuotasRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAccountQuotasRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{16}
+	return file_chacerapp_v1_accounts_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateAccountQuotasRequest) GetAccountQuotas() *AccountQuotas {
@@ -1211,175 +2071,319 @@ var file_chacerapp_v1_accounts_proto_rawDesc = []byte{
 	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x06, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x48, 0x0a,
-	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
-	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x38, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x42, 0x03,
-	0xe0, 0x41, 0x03, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x73,
-	0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
-	0xe0, 0x41, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x15, 0x0a,
-	0x03, 0x75, 0x69, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x06, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x48, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12,
+	0x21, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x67, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x41, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
+	0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x45,
+	0xea, 0x41, 0x42, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x7d, 0x2a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x32, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc0, 0x03, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x02, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x5d, 0xea, 0x41, 0x5a, 0x0a, 0x1f, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x19, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x2a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x32, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x5c, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x34, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x62, 0x75,
+	0x72, 0x73, 0x74, 0x22, 0xb5, 0x02, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x36, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x5d, 0xea, 0x41,
+	0x5a, 0x0a, 0x1f, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x0d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x0d, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x10,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
+	0x44, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xe3, 0x03, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x46, 0xea, 0x41, 0x43, 0x0a, 0x1a, 0x61, 0x70, 0x69, 0x73,
-	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2a, 0x08, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x32, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xca, 0x01,
-	0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12,
-	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x5e, 0xea, 0x41, 0x5b, 0x0a,
-	0x20, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
-	0x73, 0x12, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x2a, 0x0d, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x32, 0x0d, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0d, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x5e,
-	0xea, 0x41, 0x5b, 0x0a, 0x20, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
-	0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f,
-	0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32,
-	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x71, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x64, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x3a, 0x7c, 0xea,
+	0x41, 0x79, 0x0a, 0x23, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x7d, 0x2a, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68,
 	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07,
+	0x6e, 0x74, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x66, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68,
-	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x4b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe0, 0x41, 0x02,
-	0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
-	0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe0,
-	0x41, 0x02, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x63,
-	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x4b, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe2,
+	0x41, 0x01, 0x02, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x73, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x22, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6c,
+	0x66, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x73, 0x65, 0x6c, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x19, 0x0a, 0x17,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x22, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x50,
+	0x0a, 0x16, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1b, 0x0a,
+	0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x6c, 0x0a, 0x18, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe0, 0x41, 0x02, 0xfa,
-	0x41, 0x1c, 0x0a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
-	0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x16, 0x53,
-	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1d, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x17, 0x0a, 0x15, 0x63, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x23, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x1d, 0x0a, 0x1b, 0x63, 0x68, 0x61, 0x63, 0x65,
-	0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a,
-	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
-	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x52, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x1d, 0x0a, 0x1b, 0x63,
-	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x9d, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x42, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
-	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75,
-	0x6f, 0x74, 0x61, 0x73, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f,
-	0x74, 0x61, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
-	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe2, 0x41, 0x01, 0x02,
+	0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x1b,
+	0x0a, 0x19, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x22, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe2,
+	0x41, 0x01, 0x02, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x6e, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x11, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x73, 0x22, 0x57, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xe2, 0x41, 0x01,
+	0x02, 0xfa, 0x41, 0x21, 0x0a, 0x1f, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x1a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b,
+	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x57, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x21, 0x0a, 0x1f, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68,
+	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x2a, 0xac, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x55, 0x53, 0x50, 0x45,
+	0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x04, 0x2a, 0x8a, 0x01, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49,
+	0x4d, 0x49, 0x54, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41,
+	0x52, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x10,
+	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f,
+	0x54, 0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x03,
 	0x2a, 0xb9, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x68, 0x61, 0x73,
 	0x65, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x48, 0x41,
 	0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
@@ -1391,7 +2395,7 @@ var file_chacerapp_v1_accounts_proto_rawDesc = []byte{
 	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x48, 0x41,
 	0x53, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1c,
 	0x0a, 0x18, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f,
-	0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x32, 0xd0, 0x0e, 0x0a,
+	0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x32, 0xe6, 0x12, 0x0a,
 	0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x4c, 0x69,
 	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x61,
 	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
@@ -1405,38 +2409,38 @@ var file_chacerapp_v1_accounts_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
 	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
-	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x8a,
-	0x88, 0x27, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0xda, 0x41, 0x12, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x3a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x82, 0x01, 0x0a,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0xda,
+	0x41, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x8a, 0x88, 0x27, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0c,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x82, 0x01, 0x0a,
 	0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x68,
 	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63,
 	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x3c, 0x8a, 0x88, 0x27, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x65, 0x74, 0xda, 0x41, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f,
+	0x75, 0x6e, 0x74, 0x22, 0x3c, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x8a, 0x88, 0x27, 0x14,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x2e, 0x67, 0x65, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f,
 	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a,
 	0x7d, 0x12, 0xab, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
 	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
 	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5f,
-	0x8a, 0x88, 0x27, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0xda, 0x41, 0x13, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
-	0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x32, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0xda, 0x41, 0x13, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x8a, 0x88, 0x27, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x32, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x12,
 	0x8b, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
 	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x8a, 0x88,
-	0x27, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0xda, 0x41,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x8a, 0x88, 0x27, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
 	0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xad, 0x01,
 	0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
@@ -1445,78 +2449,111 @@ var file_chacerapp_v1_accounts_proto_rawDesc = []byte{
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
 	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41,
 	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d,
-	0x8a, 0x88, 0x27, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0xda, 0x41, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a,
-	0x7d, 0x3a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xa8, 0x01,
+	0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x8a, 0x88, 0x27, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f,
+	0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0xa8, 0x01,
 	0x0a, 0x0e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x12, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
 	0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
 	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x8a, 0x88, 0x27,
-	0x18, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x2e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x73, 0x75,
-	0x73, 0x70, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0xa1, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e,
-	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0xda, 0x41, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x8a, 0x88, 0x27, 0x18, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x7d,
+	0x3a, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0xb7, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
+	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x51, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x8a, 0x88, 0x27, 0x1b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a,
+	0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x12, 0xc7, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x8a, 0x88, 0x27, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x7d,
+	0x2f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x8f, 0x01, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0xa1,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x8a, 0x88, 0x27, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x7d, 0x12, 0xd9, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
 	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x49, 0x8a, 0x88, 0x27, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
-	0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x7d, 0x12, 0xd9, 0x01, 0x0a,
-	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7b, 0x8a, 0x88, 0x27,
-	0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0xda, 0x41, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x32, 0x2b, 0x2f, 0x76, 0x31,
-	0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x7d, 0x3a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x25, 0x2e,
-	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x73, 0x22, 0x7b, 0xda, 0x41, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x8a, 0x88, 0x27, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3d, 0x3a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x32, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x7d, 0x12, 0xa1,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x22, 0x49, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x8a, 0x88, 0x27, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x7d, 0x12, 0xe0, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
 	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
-	0x73, 0x22, 0x49, 0x8a, 0x88, 0x27, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
-	0x73, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
-	0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x7d, 0x12, 0xe0, 0x01, 0x0a,
-	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75,
-	0x6f, 0x74, 0x61, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x22, 0x81, 0x01, 0x8a, 0x88,
-	0x27, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0xda,
-	0x41, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73,
-	0x2c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x3d, 0x32, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x7d, 0x3a,
-	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x42,
-	0x70, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x70, 0x62, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x5c, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x22, 0x81, 0x01, 0xda, 0x41, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x2c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x8a, 0x88, 0x27, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x3a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x32, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x2e, 0x6e, 0x61,
+	0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x73, 0x7d, 0x42, 0x71, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x70, 0x62, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1531,76 +2568,107 @@ func file_chacerapp_v1_accounts_proto_rawDescGZIP() []byte {
 	return file_chacerapp_v1_accounts_proto_rawDescData
 }
 
-var file_chacerapp_v1_accounts_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_chacerapp_v1_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_chacerapp_v1_accounts_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_chacerapp_v1_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_chacerapp_v1_accounts_proto_goTypes = []interface{}{
-	(AccountPhase)(0),                  // 0: chacerapp.v1.AccountPhase
-	(*Account)(nil),                    // 1: chacerapp.v1.Account
-	(*AccountQuotas)(nil),              // 2: chacerapp.v1.AccountQuotas
-	(*AccountStatus)(nil),              // 3: chacerapp.v1.AccountStatus
-	(*ListAccountsRequest)(nil),        // 4: chacerapp.v1.ListAccountsRequest
-	(*ListAccountsResponse)(nil),       // 5: chacerapp.v1.ListAccountsResponse
-	(*CreateAccountRequest)(nil),       // 6: chacerapp.v1.CreateAccountRequest
-	(*UpdateAccountRequest)(nil),       // 7: chacerapp.v1.UpdateAccountRequest
-	(*GetAccountRequest)(nil),          // 8: chacerapp.v1.GetAccountRequest
-	(*ActivateAccountRequest)(nil),     // 9: chacerapp.v1.ActivateAccountRequest
-	(*ActivateAccountResponse)(nil),    // 10: chacerapp.v1.ActivateAccountResponse
-	(*SuspendAccountRequest)(nil),      // 11: chacerapp.v1.SuspendAccountRequest
-	(*SuspendAccountResponse)(nil),     // 12: chacerapp.v1.SuspendAccountResponse
-	(*DeleteAccountRequest)(nil),       // 13: chacerapp.v1.DeleteAccountRequest
-	(*GetAccountStatusRequest)(nil),    // 14: chacerapp.v1.GetAccountStatusRequest
-	(*UpdateAccountStatusRequest)(nil), // 15: chacerapp.v1.UpdateAccountStatusRequest
-	(*GetAccountQuotasRequest)(nil),    // 16: chacerapp.v1.GetAccountQuotasRequest
-	(*UpdateAccountQuotasRequest)(nil), // 17: chacerapp.v1.UpdateAccountQuotasRequest
-	nil,                                // 18: chacerapp.v1.Account.LabelsEntry
-	nil,                                // 19: chacerapp.v1.Account.AnnotationsEntry
-	(*timestamp.Timestamp)(nil),        // 20: google.protobuf.Timestamp
-	(*field_mask.FieldMask)(nil),       // 21: google.protobuf.FieldMask
+	(SuspensionEnd)(0),                     // 0: chacerapp.v1.SuspensionEnd
+	(RateLimitTier)(0),                     // 1: chacerapp.v1.RateLimitTier
+	(AccountPhase)(0),                      // 2: chacerapp.v1.AccountPhase
+	(*Account)(nil),                        // 3: chacerapp.v1.Account
+	(*AccountQuotas)(nil),                  // 4: chacerapp.v1.AccountQuotas
+	(*AccountUsage)(nil),                   // 5: chacerapp.v1.AccountUsage
+	(*RateLimit)(nil),                      // 6: chacerapp.v1.RateLimit
+	(*AccountStatus)(nil),                  // 7: chacerapp.v1.AccountStatus
+	(*SuspensionReason)(nil),               // 8: chacerapp.v1.SuspensionReason
+	(*AccountSuspension)(nil),              // 9: chacerapp.v1.AccountSuspension
+	(*ListAccountsRequest)(nil),            // 10: chacerapp.v1.ListAccountsRequest
+	(*ListAccountsResponse)(nil),           // 11: chacerapp.v1.ListAccountsResponse
+	(*CreateAccountRequest)(nil),           // 12: chacerapp.v1.CreateAccountRequest
+	(*UpdateAccountRequest)(nil),           // 13: chacerapp.v1.UpdateAccountRequest
+	(*GetAccountRequest)(nil),              // 14: chacerapp.v1.GetAccountRequest
+	(*ActivateAccountRequest)(nil),         // 15: chacerapp.v1.ActivateAccountRequest
+	(*ActivateAccountResponse)(nil),        // 16: chacerapp.v1.ActivateAccountResponse
+	(*SuspendAccountRequest)(nil),          // 17: chacerapp.v1.SuspendAccountRequest
+	(*SuspendAccountResponse)(nil),         // 18: chacerapp.v1.SuspendAccountResponse
+	(*DeactivateAccountRequest)(nil),       // 19: chacerapp.v1.DeactivateAccountRequest
+	(*DeactivateAccountResponse)(nil),      // 20: chacerapp.v1.DeactivateAccountResponse
+	(*DeleteAccountRequest)(nil),           // 21: chacerapp.v1.DeleteAccountRequest
+	(*ListAccountSuspensionsRequest)(nil),  // 22: chacerapp.v1.ListAccountSuspensionsRequest
+	(*ListAccountSuspensionsResponse)(nil), // 23: chacerapp.v1.ListAccountSuspensionsResponse
+	(*ListSuspensionReasonsRequest)(nil),   // 24: chacerapp.v1.ListSuspensionReasonsRequest
+	(*ListSuspensionReasonsResponse)(nil),  // 25: chacerapp.v1.ListSuspensionReasonsResponse
+	(*GetAccountStatusRequest)(nil),        // 26: chacerapp.v1.GetAccountStatusRequest
+	(*UpdateAccountStatusRequest)(nil),     // 27: chacerapp.v1.UpdateAccountStatusRequest
+	(*GetAccountQuotasRequest)(nil),        // 28: chacerapp.v1.GetAccountQuotasRequest
+	(*UpdateAccountQuotasRequest)(nil),     // 29: chacerapp.v1.UpdateAccountQuotasRequest
+	nil,                                    // 30: chacerapp.v1.Account.LabelsEntry
+	nil,                                    // 31: chacerapp.v1.Account.AnnotationsEntry
+	(*timestamp.Timestamp)(nil),            // 32: google.protobuf.Timestamp
+	(*duration.Duration)(nil),              // 33: google.protobuf.Duration
+	(*field_mask.FieldMask)(nil),           // 34: google.protobuf.FieldMask
 }
 var file_chacerapp_v1_accounts_proto_depIdxs = []int32{
-	18, // 0: chacerapp.v1.Account.labels:type_name -> chacerapp.v1.Account.LabelsEntry
-	19, // 1: chacerapp.v1.Account.annotations:type_name -> chacerapp.v1.Account.AnnotationsEntry
-	3,  // 2: chacerapp.v1.Account.status:type_name -> chacerapp.v1.AccountStatus
-	2,  // 3: chacerapp.v1.Account.quotas:type_name -> chacerapp.v1.AccountQuotas
-	20, // 4: chacerapp.v1.Account.create_time:type_name -> google.protobuf.Timestamp
-	20, // 5: chacerapp.v1.Account.update_time:type_name -> google.protobuf.Timestamp
-	20, // 6: chacerapp.v1.Account.delete_time:type_name -> google.protobuf.Timestamp
-	0,  // 7: chacerapp.v1.AccountStatus.phase:type_name -> chacerapp.v1.AccountPhase
-	1,  // 8: chacerapp.v1.ListAccountsResponse.accounts:type_name -> chacerapp.v1.Account
-	1,  // 9: chacerapp.v1.CreateAccountRequest.account:type_name -> chacerapp.v1.Account
-	1,  // 10: chacerapp.v1.UpdateAccountRequest.account:type_name -> chacerapp.v1.Account
-	21, // 11: chacerapp.v1.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
-	3,  // 12: chacerapp.v1.UpdateAccountStatusRequest.account_status:type_name -> chacerapp.v1.AccountStatus
-	21, // 13: chacerapp.v1.UpdateAccountStatusRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 14: chacerapp.v1.UpdateAccountQuotasRequest.account_quotas:type_name -> chacerapp.v1.AccountQuotas
-	21, // 15: chacerapp.v1.UpdateAccountQuotasRequest.update_mask:type_name -> google.protobuf.FieldMask
-	4,  // 16: chacerapp.v1.Accounts.ListAccounts:input_type -> chacerapp.v1.ListAccountsRequest
-	6,  // 17: chacerapp.v1.Accounts.CreateAccount:input_type -> chacerapp.v1.CreateAccountRequest
-	8,  // 18: chacerapp.v1.Accounts.GetAccount:input_type -> chacerapp.v1.GetAccountRequest
-	7,  // 19: chacerapp.v1.Accounts.UpdateAccount:input_type -> chacerapp.v1.UpdateAccountRequest
-	13, // 20: chacerapp.v1.Accounts.DeleteAccount:input_type -> chacerapp.v1.DeleteAccountRequest
-	9,  // 21: chacerapp.v1.Accounts.ActivateAccount:input_type -> chacerapp.v1.ActivateAccountRequest
-	11, // 22: chacerapp.v1.Accounts.SuspendAccount:input_type -> chacerapp.v1.SuspendAccountRequest
-	14, // 23: chacerapp.v1.Accounts.GetAccountStatus:input_type -> chacerapp.v1.GetAccountStatusRequest
-	15, // 24: chacerapp.v1.Accounts.UpdateAccountStatus:input_type -> chacerapp.v1.UpdateAccountStatusRequest
-	16, // 25: chacerapp.v1.Accounts.GetAccountQuotas:input_type -> chacerapp.v1.GetAccountQuotasRequest
-	17, // 26: chacerapp.v1.Accounts.UpdateAccountQuotas:input_type -> chacerapp.v1.UpdateAccountQuotasRequest
-	5,  // 27: chacerapp.v1.Accounts.ListAccounts:output_type -> chacerapp.v1.ListAccountsResponse
-	1,  // 28: chacerapp.v1.Accounts.CreateAccount:output_type -> chacerapp.v1.Account
-	1,  // 29: chacerapp.v1.Accounts.GetAccount:output_type -> chacerapp.v1.Account
-	1,  // 30: chacerapp.v1.Accounts.UpdateAccount:output_type -> chacerapp.v1.Account
-	1,  // 31: chacerapp.v1.Accounts.DeleteAccount:output_type -> chacerapp.v1.Account
-	10, // 32: chacerapp.v1.Accounts.ActivateAccount:output_type -> chacerapp.v1.ActivateAccountResponse
-	12, // 33: chacerapp.v1.Accounts.SuspendAccount:output_type -> chacerapp.v1.SuspendAccountResponse
-	3,  // 34: chacerapp.v1.Accounts.GetAccountStatus:output_type -> chacerapp.v1.AccountStatus
-	3,  // 35: chacerapp.v1.Accounts.UpdateAccountStatus:output_type -> chacerapp.v1.AccountStatus
-	2,  // 36: chacerapp.v1.Accounts.GetAccountQuotas:output_type -> chacerapp.v1.AccountQuotas
-	2,  // 37: chacerapp.v1.Accounts.UpdateAccountQuotas:output_type -> chacerapp.v1.AccountQuotas
-	27, // [27:38] is the sub-list for method output_type
-	16, // [16:27] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	30, // 0: chacerapp.v1.Account.labels:type_name -> chacerapp.v1.Account.LabelsEntry
+	31, // 1: chacerapp.v1.Account.annotations:type_name -> chacerapp.v1.Account.AnnotationsEntry
+	7,  // 2: chacerapp.v1.Account.status:type_name -> chacerapp.v1.AccountStatus
+	4,  // 3: chacerapp.v1.Account.quotas:type_name -> chacerapp.v1.AccountQuotas
+	32, // 4: chacerapp.v1.Account.create_time:type_name -> google.protobuf.Timestamp
+	32, // 5: chacerapp.v1.Account.update_time:type_name -> google.protobuf.Timestamp
+	32, // 6: chacerapp.v1.Account.delete_time:type_name -> google.protobuf.Timestamp
+	1,  // 7: chacerapp.v1.AccountQuotas.rate_limit_tier:type_name -> chacerapp.v1.RateLimitTier
+	6,  // 8: chacerapp.v1.AccountQuotas.rate_limits:type_name -> chacerapp.v1.RateLimit
+	5,  // 9: chacerapp.v1.AccountQuotas.usage:type_name -> chacerapp.v1.AccountUsage
+	2,  // 10: chacerapp.v1.AccountStatus.phase:type_name -> chacerapp.v1.AccountPhase
+	32, // 11: chacerapp.v1.AccountStatus.expire_time:type_name -> google.protobuf.Timestamp
+	33, // 12: chacerapp.v1.SuspensionReason.reactivate_after:type_name -> google.protobuf.Duration
+	32, // 13: chacerapp.v1.AccountSuspension.suspend_time:type_name -> google.protobuf.Timestamp
+	32, // 14: chacerapp.v1.AccountSuspension.expire_time:type_name -> google.protobuf.Timestamp
+	32, // 15: chacerapp.v1.AccountSuspension.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 16: chacerapp.v1.AccountSuspension.end:type_name -> chacerapp.v1.SuspensionEnd
+	3,  // 17: chacerapp.v1.ListAccountsResponse.accounts:type_name -> chacerapp.v1.Account
+	3,  // 18: chacerapp.v1.CreateAccountRequest.account:type_name -> chacerapp.v1.Account
+	3,  // 19: chacerapp.v1.UpdateAccountRequest.account:type_name -> chacerapp.v1.Account
+	34, // 20: chacerapp.v1.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
+	32, // 21: chacerapp.v1.SuspendAccountRequest.expire_time:type_name -> google.protobuf.Timestamp
+	9,  // 22: chacerapp.v1.ListAccountSuspensionsResponse.account_suspensions:type_name -> chacerapp.v1.AccountSuspension
+	8,  // 23: chacerapp.v1.ListSuspensionReasonsResponse.suspension_reasons:type_name -> chacerapp.v1.SuspensionReason
+	7,  // 24: chacerapp.v1.UpdateAccountStatusRequest.account_status:type_name -> chacerapp.v1.AccountStatus
+	34, // 25: chacerapp.v1.UpdateAccountStatusRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 26: chacerapp.v1.UpdateAccountQuotasRequest.account_quotas:type_name -> chacerapp.v1.AccountQuotas
+	34, // 27: chacerapp.v1.UpdateAccountQuotasRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10, // 28: chacerapp.v1.Accounts.ListAccounts:input_type -> chacerapp.v1.ListAccountsRequest
+	12, // 29: chacerapp.v1.Accounts.CreateAccount:input_type -> chacerapp.v1.CreateAccountRequest
+	14, // 30: chacerapp.v1.Accounts.GetAccount:input_type -> chacerapp.v1.GetAccountRequest
+	13, // 31: chacerapp.v1.Accounts.UpdateAccount:input_type -> chacerapp.v1.UpdateAccountRequest
+	21, // 32: chacerapp.v1.Accounts.DeleteAccount:input_type -> chacerapp.v1.DeleteAccountRequest
+	15, // 33: chacerapp.v1.Accounts.ActivateAccount:input_type -> chacerapp.v1.ActivateAccountRequest
+	17, // 34: chacerapp.v1.Accounts.SuspendAccount:input_type -> chacerapp.v1.SuspendAccountRequest
+	19, // 35: chacerapp.v1.Accounts.DeactivateAccount:input_type -> chacerapp.v1.DeactivateAccountRequest
+	22, // 36: chacerapp.v1.Accounts.ListAccountSuspensions:input_type -> chacerapp.v1.ListAccountSuspensionsRequest
+	24, // 37: chacerapp.v1.Accounts.ListSuspensionReasons:input_type -> chacerapp.v1.ListSuspensionReasonsRequest
+	26, // 38: chacerapp.v1.Accounts.GetAccountStatus:input_type -> chacerapp.v1.GetAccountStatusRequest
+	27, // 39: chacerapp.v1.Accounts.UpdateAccountStatus:input_type -> chacerapp.v1.UpdateAccountStatusRequest
+	28, // 40: chacerapp.v1.Accounts.GetAccountQuotas:input_type -> chacerapp.v1.GetAccountQuotasRequest
+	29, // 41: chacerapp.v1.Accounts.UpdateAccountQuotas:input_type -> chacerapp.v1.UpdateAccountQuotasRequest
+	11, // 42: chacerapp.v1.Accounts.ListAccounts:output_type -> chacerapp.v1.ListAccountsResponse
+	3,  // 43: chacerapp.v1.Accounts.CreateAccount:output_type -> chacerapp.v1.Account
+	3,  // 44: chacerapp.v1.Accounts.GetAccount:output_type -> chacerapp.v1.Account
+	3,  // 45: chacerapp.v1.Accounts.UpdateAccount:output_type -> chacerapp.v1.Account
+	3,  // 46: chacerapp.v1.Accounts.DeleteAccount:output_type -> chacerapp.v1.Account
+	16, // 47: chacerapp.v1.Accounts.ActivateAccount:output_type -> chacerapp.v1.ActivateAccountResponse
+	18, // 48: chacerapp.v1.Accounts.SuspendAccount:output_type -> chacerapp.v1.SuspendAccountResponse
+	20, // 49: chacerapp.v1.Accounts.DeactivateAccount:output_type -> chacerapp.v1.DeactivateAccountResponse
+	23, // 50: chacerapp.v1.Accounts.ListAccountSuspensions:output_type -> chacerapp.v1.ListAccountSuspensionsResponse
+	25, // 51: chacerapp.v1.Accounts.ListSuspensionReasons:output_type -> chacerapp.v1.ListSuspensionReasonsResponse
+	7,  // 52: chacerapp.v1.Accounts.GetAccountStatus:output_type -> chacerapp.v1.AccountStatus
+	7,  // 53: chacerapp.v1.Accounts.UpdateAccountStatus:output_type -> chacerapp.v1.AccountStatus
+	4,  // 54: chacerapp.v1.Accounts.GetAccountQuotas:output_type -> chacerapp.v1.AccountQuotas
+	4,  // 55: chacerapp.v1.Accounts.UpdateAccountQuotas:output_type -> chacerapp.v1.AccountQuotas
+	42, // [42:56] is the sub-list for method output_type
+	28, // [28:42] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_chacerapp_v1_accounts_proto_init() }
@@ -1635,7 +2703,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountStatus); i {
+			switch v := v.(*AccountUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1647,7 +2715,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsRequest); i {
+			switch v := v.(*RateLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1659,7 +2727,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsResponse); i {
+			switch v := v.(*AccountStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1671,7 +2739,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountRequest); i {
+			switch v := v.(*SuspensionReason); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1683,7 +2751,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAccountRequest); i {
+			switch v := v.(*AccountSuspension); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1695,7 +2763,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountRequest); i {
+			switch v := v.(*ListAccountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1707,7 +2775,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateAccountRequest); i {
+			switch v := v.(*ListAccountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1719,7 +2787,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateAccountResponse); i {
+			switch v := v.(*CreateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1731,7 +2799,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuspendAccountRequest); i {
+			switch v := v.(*UpdateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1743,7 +2811,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuspendAccountResponse); i {
+			switch v := v.(*GetAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1755,7 +2823,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAccountRequest); i {
+			switch v := v.(*ActivateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1767,7 +2835,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountStatusRequest); i {
+			switch v := v.(*ActivateAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1779,7 +2847,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAccountStatusRequest); i {
+			switch v := v.(*SuspendAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1791,7 +2859,7 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountQuotasRequest); i {
+			switch v := v.(*SuspendAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1803,6 +2871,126 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 		file_chacerapp_v1_accounts_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeactivateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeactivateAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountSuspensionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountSuspensionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSuspensionReasonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSuspensionReasonsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountQuotasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_accounts_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateAccountQuotasRequest); i {
 			case 0:
 				return &v.state
@@ -1815,13 +3003,14 @@ func file_chacerapp_v1_accounts_proto_init() {
 			}
 		}
 	}
+	file_chacerapp_v1_accounts_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chacerapp_v1_accounts_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   19,
+			NumEnums:      3,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1857,8 +3046,10 @@ type AccountsClient interface {
 	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
 	// CreateAccount will create a new account
 	//
-	// An AlreadyExists error will be returned when the resulting account's
-	// resource name conflicts with an existing account.
+	// Accounts created by an administrator are active immediately, while
+	// self-service accounts start in the PENDING phase until they have been
+	// activated. An AlreadyExists error will be returned when the resulting
+	// account's resource name conflicts with an existing account.
 	//
 	// (-- api-linter: core::0133::http-uri-parent=disabled
 	//     aip.dev/not-precedent: Accounts are top level resources and have no parent. --)
@@ -1882,27 +3073,63 @@ type AccountsClient interface {
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*Account, error)
 	// ActivateAccount will activate a pending account.
 	//
-	// An account can only be activated when it is in the PENDING or SUSPENDED phase. A
-	// FailedPrecondition error will be returned when an account is in any other phase.
-	// A NotFound error will be returned when the requested account does not exist.
+	// An account can only be activated when it is in the PENDING, SUSPENDED or INACTIVE
+	// phase. A FailedPrecondition error will be returned when an account is in any other
+	// phase. The owner of an account can only activate it when it is INACTIVE, or when
+	// it was suspended for a reason that allows the owner to reactivate it. A NotFound
+	// error will be returned when the requested account does not exist.
 	ActivateAccount(ctx context.Context, in *ActivateAccountRequest, opts ...grpc.CallOption) (*ActivateAccountResponse, error)
 	// SuspendAccount will suspend an active account.
 	//
-	// An account can only be suspended when the account is active. If the account is
-	// not active, a FailedPrecondition error will be returned. A NotFound error is
-	// returned when the requested account does not exist.
+	// A suspension with an expire_time, or for a reason with an automatic
+	// reactivation time, is temporary and the account will be activated once
+	// it has expired.
+	//
+	// The account will be in the SUSPENDING phase while the devices of the account are
+	// disconnected and its outstanding messages are cancelled, and will then be moved to
+	// the SUSPENDED phase. An account can only be suspended when the account is active,
+	// or to resume a suspension that didn't complete. If the account is in any other
+	// phase, a FailedPrecondition error will be returned. A NotFound error is returned
+	// when the requested account does not exist.
 	SuspendAccount(ctx context.Context, in *SuspendAccountRequest, opts ...grpc.CallOption) (*SuspendAccountResponse, error)
+	// DeactivateAccount will make an account inactive at the request of its owner.
+	//
+	// An account can be deactivated when it is in the PENDING, ACTIVE or SUSPENDED
+	// phase. A FailedPrecondition error will be returned when an account is in any
+	// other phase. A NotFound error is returned when the requested account does not
+	// exist.
+	DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error)
+	// ListAccountSuspensions will list the suspensions of an account, with
+	// the most recent suspension first.
+	//
+	// An empty result will be returned when the account does not exist or
+	// has never been suspended.
+	ListAccountSuspensions(ctx context.Context, in *ListAccountSuspensionsRequest, opts ...grpc.CallOption) (*ListAccountSuspensionsResponse, error)
+	// ListSuspensionReasons will list the reasons an account can be suspended for.
+	//
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//     aip.dev/not-precedent: Suspension reasons are not resources. --)
+	ListSuspensionReasons(ctx context.Context, in *ListSuspensionReasonsRequest, opts ...grpc.CallOption) (*ListSuspensionReasonsResponse, error)
 	// GetAccountStatus will retrieve the status for an account
 	//
 	// A NotFound error will be returned when the account does not exist.
 	GetAccountStatus(ctx context.Context, in *GetAccountStatusRequest, opts ...grpc.CallOption) (*AccountStatus, error)
 	// UpdateAccountStatus will update the status for an account
 	//
-	// A NotFound error will be returned when the account does not exist.
+	// The phase can only be changed to a phase the account is allowed to move
+	// to from its current phase, otherwise a FailedPrecondition error will be
+	// returned. Accounts are suspended with SuspendAccount and suspended
+	// accounts are activated with ActivateAccount, and the reason of a
+	// suspended account can't be changed, which also return a FailedPrecondition
+	// error. The reason must be in the catalog of ListSuspensionReasons when it
+	// is set. A NotFound error will be returned when the account does not exist.
 	UpdateAccountStatus(ctx context.Context, in *UpdateAccountStatusRequest, opts ...grpc.CallOption) (*AccountStatus, error)
 	// GetAccountQuotas will retrieve the quotas for an account
 	//
-	// A NotFound error will be returned when the account does not exist.
+	// The returned quotas include the defaults for the tier of the account
+	// in place of any quota that hasn't been set, along with the current
+	// usage of the account. A NotFound error will be returned when the
+	// account does not exist.
 	GetAccountQuotas(ctx context.Context, in *GetAccountQuotasRequest, opts ...grpc.CallOption) (*AccountQuotas, error)
 	// UpdateAccountQuotas will update the quotas for an account
 	//
@@ -1981,6 +3208,33 @@ func (c *accountsClient) SuspendAccount(ctx context.Context, in *SuspendAccountR
 	return out, nil
 }
 
+func (c *accountsClient) DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error) {
+	out := new(DeactivateAccountResponse)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Accounts/DeactivateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListAccountSuspensions(ctx context.Context, in *ListAccountSuspensionsRequest, opts ...grpc.CallOption) (*ListAccountSuspensionsResponse, error) {
+	out := new(ListAccountSuspensionsResponse)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Accounts/ListAccountSuspensions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListSuspensionReasons(ctx context.Context, in *ListSuspensionReasonsRequest, opts ...grpc.CallOption) (*ListSuspensionReasonsResponse, error) {
+	out := new(ListSuspensionReasonsResponse)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Accounts/ListSuspensionReasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountsClient) GetAccountStatus(ctx context.Context, in *GetAccountStatusRequest, opts ...grpc.CallOption) (*AccountStatus, error) {
 	out := new(AccountStatus)
 	err := c.cc.Invoke(ctx, "/chacerapp.v1.Accounts/GetAccountStatus", in, out, opts...)
@@ -2028,8 +3282,10 @@ type AccountsServer interface {
 	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
 	// CreateAccount will create a new account
 	//
-	// An AlreadyExists error will be returned when the resulting account's
-	// resource name conflicts with an existing account.
+	// Accounts created by an administrator are active immediately, while
+	// self-service accounts start in the PENDING phase until they have been
+	// activated. An AlreadyExists error will be returned when the resulting
+	// account's resource name conflicts with an existing account.
 	//
 	// (-- api-linter: core::0133::http-uri-parent=disabled
 	//     aip.dev/not-precedent: Accounts are top level resources and have no parent. --)
@@ -2053,27 +3309,63 @@ type AccountsServer interface {
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*Account, error)
 	// ActivateAccount will activate a pending account.
 	//
-	// An account can only be activated when it is in the PENDING or SUSPENDED phase. A
-	// FailedPrecondition error will be returned when an account is in any other phase.
-	// A NotFound error will be returned when the requested account does not exist.
+	// An account can only be activated when it is in the PENDING, SUSPENDED or INACTIVE
+	// phase. A FailedPrecondition error will be returned when an account is in any other
+	// phase. The owner of an account can only activate it when it is INACTIVE, or when
+	// it was suspended for a reason that allows the owner to reactivate it. A NotFound
+	// error will be returned when the requested account does not exist.
 	ActivateAccount(context.Context, *ActivateAccountRequest) (*ActivateAccountResponse, error)
 	// SuspendAccount will suspend an active account.
 	//
-	// An account can only be suspended when the account is active. If the account is
-	// not active, a FailedPrecondition error will be returned. A NotFound error is
-	// returned when the requested account does not exist.
+	// A suspension with an expire_time, or for a reason with an automatic
+	// reactivation time, is temporary and the account will be activated once
+	// it has expired.
+	//
+	// The account will be in the SUSPENDING phase while the devices of the account are
+	// disconnected and its outstanding messages are cancelled, and will then be moved to
+	// the SUSPENDED phase. An account can only be suspended when the account is active,
+	// or to resume a suspension that didn't complete. If the account is in any other
+	// phase, a FailedPrecondition error will be returned. A NotFound error is returned
+	// when the requested account does not exist.
 	SuspendAccount(context.Context, *SuspendAccountRequest) (*SuspendAccountResponse, error)
+	// DeactivateAccount will make an account inactive at the request of its owner.
+	//
+	// An account can be deactivated when it is in the PENDING, ACTIVE or SUSPENDED
+	// phase. A FailedPrecondition error will be returned when an account is in any
+	// other phase. A NotFound error is returned when the requested account does not
+	// exist.
+	DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error)
+	// ListAccountSuspensions will list the suspensions of an account, with
+	// the most recent suspension first.
+	//
+	// An empty result will be returned when the account does not exist or
+	// has never been suspended.
+	ListAccountSuspensions(context.Context, *ListAccountSuspensionsRequest) (*ListAccountSuspensionsResponse, error)
+	// ListSuspensionReasons will list the reasons an account can be suspended for.
+	//
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//     aip.dev/not-precedent: Suspension reasons are not resources. --)
+	ListSuspensionReasons(context.Context, *ListSuspensionReasonsRequest) (*ListSuspensionReasonsResponse, error)
 	// GetAccountStatus will retrieve the status for an account
 	//
 	// A NotFound error will be returned when the account does not exist.
 	GetAccountStatus(context.Context, *GetAccountStatusRequest) (*AccountStatus, error)
 	// UpdateAccountStatus will update the status for an account
 	//
-	// A NotFound error will be returned when the account does not exist.
+	// The phase can only be changed to a phase the account is allowed to move
+	// to from its current phase, otherwise a FailedPrecondition error will be
+	// returned. Accounts are suspended with SuspendAccount and suspended
+	// accounts are activated with ActivateAccount, and the reason of a
+	// suspended account can't be changed, which also return a FailedPrecondition
+	// error. The reason must be in the catalog of ListSuspensionReasons when it
+	// is set. A NotFound error will be returned when the account does not exist.
 	UpdateAccountStatus(context.Context, *UpdateAccountStatusRequest) (*AccountStatus, error)
 	// GetAccountQuotas will retrieve the quotas for an account
 	//
-	// A NotFound error will be returned when the account does not exist.
+	// The returned quotas include the defaults for the tier of the account
+	// in place of any quota that hasn't been set, along with the current
+	// usage of the account. A NotFound error will be returned when the
+	// account does not exist.
 	GetAccountQuotas(context.Context, *GetAccountQuotasRequest) (*AccountQuotas, error)
 	// UpdateAccountQuotas will update the quotas for an account
 	//
@@ -2106,6 +3398,15 @@ func (*UnimplementedAccountsServer) ActivateAccount(context.Context, *ActivateAc
 func (*UnimplementedAccountsServer) SuspendAccount(context.Context, *SuspendAccountRequest) (*SuspendAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuspendAccount not implemented")
 }
+func (*UnimplementedAccountsServer) DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAccount not implemented")
+}
+func (*UnimplementedAccountsServer) ListAccountSuspensions(context.Context, *ListAccountSuspensionsRequest) (*ListAccountSuspensionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountSuspensions not implemented")
+}
+func (*UnimplementedAccountsServer) ListSuspensionReasons(context.Context, *ListSuspensionReasonsRequest) (*ListSuspensionReasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuspensionReasons not implemented")
+}
 func (*UnimplementedAccountsServer) GetAccountStatus(context.Context, *GetAccountStatusRequest) (*AccountStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountStatus not implemented")
 }
@@ -2249,6 +3550,60 @@ func _Accounts_SuspendAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Accounts_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).DeactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Accounts/DeactivateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).DeactivateAccount(ctx, req.(*DeactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListAccountSuspensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountSuspensionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListAccountSuspensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Accounts/ListAccountSuspensions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListAccountSuspensions(ctx, req.(*ListAccountSuspensionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListSuspensionReasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuspensionReasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListSuspensionReasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Accounts/ListSuspensionReasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListSuspensionReasons(ctx, req.(*ListSuspensionReasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Accounts_GetAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAccountStatusRequest)
 	if err := dec(in); err != nil {
@@ -2353,6 +3708,18 @@ var _Accounts_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuspendAccount",
 			Handler:    _Accounts_SuspendAccount_Handler,
 		},
+		{
+			MethodName: "DeactivateAccount",
+			Handler:    _Accounts_DeactivateAccount_Handler,
+		},
+		{
+			MethodName: "ListAccountSuspensions",
+			Handler:    _Accounts_ListAccountSuspensions_Handler,
+		},
+		{
+			MethodName: "ListSuspensionReasons",
+			Handler:    _Accounts_ListSuspensionReasons_Handler,
+		},
 		{
 			MethodName: "GetAccountStatus",
 			Handler:    _Accounts_GetAccountStatus_Handler,
@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.24.0
+// 	protoc        v3.11.4
+// source: chacerapp/v1/escalations.proto
+
+package serverpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// EscalationAction is the action taken by an escalation step.
+type EscalationAction int32
+
+const (
+	// The action is not specified.
+	EscalationAction_ESCALATION_ACTION_UNSPECIFIED EscalationAction = 0
+	// The message is sent to its recipient again.
+	EscalationAction_ESCALATION_ACTION_RESEND EscalationAction = 1
+	// The message is sent again with a different sound.
+	EscalationAction_ESCALATION_ACTION_CHANGE_SOUND EscalationAction = 2
+	// The message is sent to a backup contact.
+	EscalationAction_ESCALATION_ACTION_REDIRECT EscalationAction = 3
+	// The message is sent to every device in the location.
+	EscalationAction_ESCALATION_ACTION_BROADCAST EscalationAction = 4
+)
+
+// Enum value maps for EscalationAction.
+var (
+	EscalationAction_name = map[int32]string{
+		0: "ESCALATION_ACTION_UNSPECIFIED",
+		1: "ESCALATION_ACTION_RESEND",
+		2: "ESCALATION_ACTION_CHANGE_SOUND",
+		3: "ESCALATION_ACTION_REDIRECT",
+		4: "ESCALATION_ACTION_BROADCAST",
+	}
+	EscalationAction_value = map[string]int32{
+		"ESCALATION_ACTION_UNSPECIFIED":  0,
+		"ESCALATION_ACTION_RESEND":       1,
+		"ESCALATION_ACTION_CHANGE_SOUND": 2,
+		"ESCALATION_ACTION_REDIRECT":     3,
+		"ESCALATION_ACTION_BROADCAST":    4,
+	}
+)
+
+func (x EscalationAction) Enum() *EscalationAction {
+	p := new(EscalationAction)
+	*p = x
+	return p
+}
+
+func (x EscalationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EscalationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_escalations_proto_enumTypes[0].Descriptor()
+}
+
+func (EscalationAction) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_escalations_proto_enumTypes[0]
+}
+
+func (x EscalationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EscalationAction.Descriptor instead.
+func (EscalationAction) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_escalations_proto_rawDescGZIP(), []int{0}
+}
+
+// An EscalationPolicy describes what happens to a message that hasn't been
+// acknowledged. The steps are taken in order until the message is
+// acknowledged, completed or canceled.
+type EscalationPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The steps of the policy, ordered by their delay. A policy can have at
+	// most 10 steps.
+	Steps []*EscalationStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *EscalationPolicy) Reset() {
+	*x = EscalationPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_escalations_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EscalationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationPolicy) ProtoMessage() {}
+
+func (x *EscalationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_escalations_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationPolicy.ProtoReflect.Descriptor instead.
+func (*EscalationPolicy) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_escalations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EscalationPolicy) GetSteps() []*EscalationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// An EscalationStep is a single action taken on a message that hasn't been
+// acknowledged in time.
+type EscalationStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time after the message was sent that the step is taken. Each step
+	// must have a longer delay than the step before it.
+	Delay *duration.Duration `protobuf:"bytes,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	// The action taken on the message.
+	Action EscalationAction `protobuf:"varint,2,opt,name=action,proto3,enum=chacerapp.v1.EscalationAction" json:"action,omitempty"`
+	// The resource name of the sound that devices play from this step on.
+	// Required for the `ESCALATION_ACTION_CHANGE_SOUND` action.
+	Sound string `protobuf:"bytes,3,opt,name=sound,proto3" json:"sound,omitempty"`
+	// The contact the message is redirected to. Required for the
+	// `ESCALATION_ACTION_REDIRECT` action.
+	Contact string `protobuf:"bytes,4,opt,name=contact,proto3" json:"contact,omitempty"`
+}
+
+func (x *EscalationStep) Reset() {
+	*x = EscalationStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_escalations_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EscalationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationStep) ProtoMessage() {}
+
+func (x *EscalationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_escalations_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationStep.ProtoReflect.Descriptor instead.
+func (*EscalationStep) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_escalations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EscalationStep) GetDelay() *duration.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *EscalationStep) GetAction() EscalationAction {
+	if x != nil {
+		return x.Action
+	}
+	return EscalationAction_ESCALATION_ACTION_UNSPECIFIED
+}
+
+func (x *EscalationStep) GetSound() string {
+	if x != nil {
+		return x.Sound
+	}
+	return ""
+}
+
+func (x *EscalationStep) GetContact() string {
+	if x != nil {
+		return x.Contact
+	}
+	return ""
+}
+
+var File_chacerapp_v1_escalations_proto protoreflect.FileDescriptor
+
+var file_chacerapp_v1_escalations_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0c, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x46, 0x0a, 0x10, 0x45, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x32,
+	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0e, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x35, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3c, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x02, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1e, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2a, 0xb8, 0x01, 0x0a, 0x10, 0x45,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x1d, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x01,
+	0x12, 0x22, 0x0a, 0x1e, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
+	0x41, 0x53, 0x54, 0x10, 0x04, 0x42, 0x74, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x45, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x70, 0x62, 0xaa, 0x02, 0x0c,
+	0x43, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chacerapp_v1_escalations_proto_rawDescOnce sync.Once
+	file_chacerapp_v1_escalations_proto_rawDescData = file_chacerapp_v1_escalations_proto_rawDesc
+)
+
+func file_chacerapp_v1_escalations_proto_rawDescGZIP() []byte {
+	file_chacerapp_v1_escalations_proto_rawDescOnce.Do(func() {
+		file_chacerapp_v1_escalations_proto_rawDescData = protoimpl.X.CompressGZIP(file_chacerapp_v1_escalations_proto_rawDescData)
+	})
+	return file_chacerapp_v1_escalations_proto_rawDescData
+}
+
+var file_chacerapp_v1_escalations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_chacerapp_v1_escalations_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_chacerapp_v1_escalations_proto_goTypes = []interface{}{
+	(EscalationAction)(0),     // 0: chacerapp.v1.EscalationAction
+	(*EscalationPolicy)(nil),  // 1: chacerapp.v1.EscalationPolicy
+	(*EscalationStep)(nil),    // 2: chacerapp.v1.EscalationStep
+	(*duration.Duration)(nil), // 3: google.protobuf.Duration
+}
+var file_chacerapp_v1_escalations_proto_depIdxs = []int32{
+	2, // 0: chacerapp.v1.EscalationPolicy.steps:type_name -> chacerapp.v1.EscalationStep
+	3, // 1: chacerapp.v1.EscalationStep.delay:type_name -> google.protobuf.Duration
+	0, // 2: chacerapp.v1.EscalationStep.action:type_name -> chacerapp.v1.EscalationAction
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_chacerapp_v1_escalations_proto_init() }
+func file_chacerapp_v1_escalations_proto_init() {
+	if File_chacerapp_v1_escalations_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chacerapp_v1_escalations_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EscalationPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_escalations_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EscalationStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_chacerapp_v1_escalations_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_chacerapp_v1_escalations_proto_goTypes,
+		DependencyIndexes: file_chacerapp_v1_escalations_proto_depIdxs,
+		EnumInfos:         file_chacerapp_v1_escalations_proto_enumTypes,
+		MessageInfos:      file_chacerapp_v1_escalations_proto_msgTypes,
+	}.Build()
+	File_chacerapp_v1_escalations_proto = out.File
+	file_chacerapp_v1_escalations_proto_rawDesc = nil
+	file_chacerapp_v1_escalations_proto_goTypes = nil
+	file_chacerapp_v1_escalations_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package serverpb
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -33,26 +34,344 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// MessagePriority is how urgent a message is.
+type MessagePriority int32
+
+const (
+	// The priority is not specified. Messages without a priority are normal.
+	MessagePriority_MESSAGE_PRIORITY_UNSPECIFIED MessagePriority = 0
+	// A message that can wait, such as a reminder.
+	MessagePriority_MESSAGE_PRIORITY_ROUTINE MessagePriority = 1
+	// A message that should be answered soon.
+	MessagePriority_MESSAGE_PRIORITY_NORMAL MessagePriority = 2
+	// A message that should be answered straight away. Urgent messages are
+	// shown to contacts even when they don't want to be disturbed.
+	MessagePriority_MESSAGE_PRIORITY_URGENT MessagePriority = 3
+	// A message about an emergency. Emergency messages are shown on every
+	// device in the location, whoever they are sent to, and sending them
+	// requires the `messenger.messages.sendEmergency` permission.
+	MessagePriority_MESSAGE_PRIORITY_EMERGENCY MessagePriority = 4
+)
+
+// Enum value maps for MessagePriority.
+var (
+	MessagePriority_name = map[int32]string{
+		0: "MESSAGE_PRIORITY_UNSPECIFIED",
+		1: "MESSAGE_PRIORITY_ROUTINE",
+		2: "MESSAGE_PRIORITY_NORMAL",
+		3: "MESSAGE_PRIORITY_URGENT",
+		4: "MESSAGE_PRIORITY_EMERGENCY",
+	}
+	MessagePriority_value = map[string]int32{
+		"MESSAGE_PRIORITY_UNSPECIFIED": 0,
+		"MESSAGE_PRIORITY_ROUTINE":     1,
+		"MESSAGE_PRIORITY_NORMAL":      2,
+		"MESSAGE_PRIORITY_URGENT":      3,
+		"MESSAGE_PRIORITY_EMERGENCY":   4,
+	}
+)
+
+func (x MessagePriority) Enum() *MessagePriority {
+	p := new(MessagePriority)
+	*p = x
+	return p
+}
+
+func (x MessagePriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessagePriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (MessagePriority) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_messages_proto_enumTypes[0]
+}
+
+func (x MessagePriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessagePriority.Descriptor instead.
+func (MessagePriority) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+// MessageClaimMode is how a message sent to a contact group is answered.
+type MessageClaimMode int32
+
+const (
+	// The claim mode is not specified.
+	MessageClaimMode_MESSAGE_CLAIM_MODE_UNSPECIFIED MessageClaimMode = 0
+	// The message is sent to every member of the group, and each member
+	// responds to it for themselves.
+	MessageClaimMode_MESSAGE_CLAIM_MODE_ALL_MEMBERS MessageClaimMode = 1
+	// The message is sent to every member of the group, and the first member to
+	// acknowledge it claims it for the group. The message is removed from the
+	// devices of the other members once it is claimed, and delivered to them
+	// again if the member that claimed it declines it.
+	MessageClaimMode_MESSAGE_CLAIM_MODE_ANY_MEMBER MessageClaimMode = 2
+)
+
+// Enum value maps for MessageClaimMode.
+var (
+	MessageClaimMode_name = map[int32]string{
+		0: "MESSAGE_CLAIM_MODE_UNSPECIFIED",
+		1: "MESSAGE_CLAIM_MODE_ALL_MEMBERS",
+		2: "MESSAGE_CLAIM_MODE_ANY_MEMBER",
+	}
+	MessageClaimMode_value = map[string]int32{
+		"MESSAGE_CLAIM_MODE_UNSPECIFIED": 0,
+		"MESSAGE_CLAIM_MODE_ALL_MEMBERS": 1,
+		"MESSAGE_CLAIM_MODE_ANY_MEMBER":  2,
+	}
+)
+
+func (x MessageClaimMode) Enum() *MessageClaimMode {
+	p := new(MessageClaimMode)
+	*p = x
+	return p
+}
+
+func (x MessageClaimMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageClaimMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (MessageClaimMode) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_messages_proto_enumTypes[1]
+}
+
+func (x MessageClaimMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageClaimMode.Descriptor instead.
+func (MessageClaimMode) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+// MessageDeliveryState is how far a message got to a contact.
+type MessageDeliveryState int32
+
+const (
+	// The delivery state is not specified.
+	MessageDeliveryState_MESSAGE_DELIVERY_STATE_UNSPECIFIED MessageDeliveryState = 0
+	// The message is waiting to be delivered to the contact.
+	MessageDeliveryState_MESSAGE_DELIVERY_STATE_PENDING MessageDeliveryState = 1
+	// The message was delivered to a device of the contact.
+	MessageDeliveryState_MESSAGE_DELIVERY_STATE_DELIVERED MessageDeliveryState = 2
+	// The message couldn't be delivered to any device of the contact.
+	MessageDeliveryState_MESSAGE_DELIVERY_STATE_FAILED MessageDeliveryState = 3
+	// The message was removed from the devices of the contact because another
+	// member of the group claimed it.
+	MessageDeliveryState_MESSAGE_DELIVERY_STATE_WITHDRAWN MessageDeliveryState = 4
+	// The message wasn't delivered because the contact didn't want to be
+	// disturbed when it was sent. Only routine and normal messages are held.
+	MessageDeliveryState_MESSAGE_DELIVERY_STATE_HELD MessageDeliveryState = 5
+)
+
+// Enum value maps for MessageDeliveryState.
+var (
+	MessageDeliveryState_name = map[int32]string{
+		0: "MESSAGE_DELIVERY_STATE_UNSPECIFIED",
+		1: "MESSAGE_DELIVERY_STATE_PENDING",
+		2: "MESSAGE_DELIVERY_STATE_DELIVERED",
+		3: "MESSAGE_DELIVERY_STATE_FAILED",
+		4: "MESSAGE_DELIVERY_STATE_WITHDRAWN",
+		5: "MESSAGE_DELIVERY_STATE_HELD",
+	}
+	MessageDeliveryState_value = map[string]int32{
+		"MESSAGE_DELIVERY_STATE_UNSPECIFIED": 0,
+		"MESSAGE_DELIVERY_STATE_PENDING":     1,
+		"MESSAGE_DELIVERY_STATE_DELIVERED":   2,
+		"MESSAGE_DELIVERY_STATE_FAILED":      3,
+		"MESSAGE_DELIVERY_STATE_WITHDRAWN":   4,
+		"MESSAGE_DELIVERY_STATE_HELD":        5,
+	}
+)
+
+func (x MessageDeliveryState) Enum() *MessageDeliveryState {
+	p := new(MessageDeliveryState)
+	*p = x
+	return p
+}
+
+func (x MessageDeliveryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageDeliveryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (MessageDeliveryState) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_messages_proto_enumTypes[2]
+}
+
+func (x MessageDeliveryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageDeliveryState.Descriptor instead.
+func (MessageDeliveryState) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+// MessageResponseState is how a responder answered a message.
+type MessageResponseState int32
+
+const (
+	// The response is not specified.
+	MessageResponseState_MESSAGE_RESPONSE_STATE_UNSPECIFIED MessageResponseState = 0
+	// The responder has seen the message and is on their way.
+	MessageResponseState_MESSAGE_RESPONSE_STATE_ACKNOWLEDGED MessageResponseState = 1
+	// The responder can't answer the message.
+	MessageResponseState_MESSAGE_RESPONSE_STATE_DECLINED MessageResponseState = 2
+)
+
+// Enum value maps for MessageResponseState.
+var (
+	MessageResponseState_name = map[int32]string{
+		0: "MESSAGE_RESPONSE_STATE_UNSPECIFIED",
+		1: "MESSAGE_RESPONSE_STATE_ACKNOWLEDGED",
+		2: "MESSAGE_RESPONSE_STATE_DECLINED",
+	}
+	MessageResponseState_value = map[string]int32{
+		"MESSAGE_RESPONSE_STATE_UNSPECIFIED":  0,
+		"MESSAGE_RESPONSE_STATE_ACKNOWLEDGED": 1,
+		"MESSAGE_RESPONSE_STATE_DECLINED":     2,
+	}
+)
+
+func (x MessageResponseState) Enum() *MessageResponseState {
+	p := new(MessageResponseState)
+	*p = x
+	return p
+}
+
+func (x MessageResponseState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageResponseState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_messages_proto_enumTypes[3].Descriptor()
+}
+
+func (MessageResponseState) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_messages_proto_enumTypes[3]
+}
+
+func (x MessageResponseState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageResponseState.Descriptor instead.
+func (MessageResponseState) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
+// The states a message can be in. Completed, cancelled and expired are
+// terminal states, and a message is shown on devices until it reaches one.
+//
+//	ACTIVE       -> ACKNOWLEDGED, DECLINED, COMPLETED, CANCELLED, EXPIRED
+//	ACKNOWLEDGED -> ACTIVE, DECLINED, COMPLETED, CANCELLED, EXPIRED
+//	DECLINED     -> ACTIVE, ACKNOWLEDGED, COMPLETED, CANCELLED, EXPIRED
+type Message_State int32
+
+const (
+	// The state of the message is unknown.
+	Message_STATE_UNSPECIFIED Message_State = 0
+	// The message is being shown on devices and is waiting for a response.
+	Message_ACTIVE Message_State = 1
+	// The message was completed by its recipient.
+	Message_COMPLETED Message_State = 2
+	// The message was cancelled by its sender.
+	Message_CANCELLED Message_State = 3
+	// The message reached its expire time without being completed or
+	// cancelled.
+	Message_EXPIRED Message_State = 4
+	// A responder has acknowledged the message and is on their way.
+	Message_ACKNOWLEDGED Message_State = 5
+	// Every responder declined the message, so nobody is on their way.
+	Message_DECLINED Message_State = 6
+)
+
+// Enum value maps for Message_State.
+var (
+	Message_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "ACTIVE",
+		2: "COMPLETED",
+		3: "CANCELLED",
+		4: "EXPIRED",
+		5: "ACKNOWLEDGED",
+		6: "DECLINED",
+	}
+	Message_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"ACTIVE":            1,
+		"COMPLETED":         2,
+		"CANCELLED":         3,
+		"EXPIRED":           4,
+		"ACKNOWLEDGED":      5,
+		"DECLINED":          6,
+	}
+)
+
+func (x Message_State) Enum() *Message_State {
+	p := new(Message_State)
+	*p = x
+	return p
+}
+
+func (x Message_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Message_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_messages_proto_enumTypes[4].Descriptor()
+}
+
+func (Message_State) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_messages_proto_enumTypes[4]
+}
+
+func (x Message_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Message_State.Descriptor instead.
+func (Message_State) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // A Message represents a message configuration that is sent to a recipient in a location.
 //
-// Each message contains a DisplayConfiguration that can be used by a device to determine
+// Each message contains a DisplayConfig that can be used by a device to determine
 // a message should be rendered to end-users.
 type Message struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Output Only. The name (account, location, message id) of the message.
+	// The name (account, location, message id) of the message.
 	// In the format 'accounts/*/locations/*/messages/*'.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The person the message should be sent to. It must be the resource name
-	// of the recipient.
+	// of the recipient, either a contact or a contact group in the account of
+	// the location. A message sent to a group is sent to each of its members
+	// as described by the `claim_mode`.
 	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	// The person that sent the message. It must be the resource name of the
 	// recipient. The sender must be exist within the same location that the
 	// message is being sent to.
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Create Only. The room in the location that the recipient is being
+	// The room in the location that the recipient is being
 	// requested in. It must be the resource name of the room.
 	RequestedRoom string `protobuf:"bytes,4,opt,name=requested_room,json=requestedRoom,proto3" json:"requested_room,omitempty"`
 	// The resource name of the location the message was created in.
@@ -65,8 +384,54 @@ type Message struct {
 	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 	// The display configuration that should be used to display the message
 	// on a device.
-	DisplayConfiguration *Message_DisplayConfiguration `protobuf:"bytes,8,opt,name=display_configuration,json=displayConfiguration,proto3" json:"display_configuration,omitempty"`
-	// Output Only. Server-defined URL for the resource.
+	DisplayConfig *Message_DisplayConfig `protobuf:"bytes,8,opt,name=display_config,json=displayConfig,proto3" json:"display_config,omitempty"`
+	// The responses to the message, one for each responder with the most
+	// recent response first.
+	Responses []*MessageResponse `protobuf:"bytes,9,rep,name=responses,proto3" json:"responses,omitempty"`
+	// The escalation steps that have been taken on the message, in the order
+	// they were taken.
+	Escalations []*MessageEscalation `protobuf:"bytes,10,rep,name=escalations,proto3" json:"escalations,omitempty"`
+	// The scheduled message that sent the message, if it was scheduled.
+	ScheduledMessage string `protobuf:"bytes,11,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	// The state of the message.
+	State Message_State `protobuf:"varint,12,opt,name=state,proto3,enum=chacerapp.v1.Message_State" json:"state,omitempty"`
+	// How urgent the message is. It decides the default display config of the
+	// message and where it is listed. (Default: MESSAGE_PRIORITY_NORMAL)
+	Priority MessagePriority `protobuf:"varint,15,opt,name=priority,proto3,enum=chacerapp.v1.MessagePriority" json:"priority,omitempty"`
+	// How a message sent to a contact group is answered. It can only be set
+	// when the recipient is a contact group. (Default: MESSAGE_CLAIM_MODE_ALL_MEMBERS)
+	ClaimMode MessageClaimMode `protobuf:"varint,16,opt,name=claim_mode,json=claimMode,proto3,enum=chacerapp.v1.MessageClaimMode" json:"claim_mode,omitempty"`
+	// The member that claimed a message sent to any member of a contact group,
+	// by being the first to acknowledge it.
+	ClaimedBy string `protobuf:"bytes,17,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
+	// The delivery of the message to each contact it was sent to. A message
+	// sent to a contact group has a delivery for each member of the group at
+	// the time it was sent.
+	Deliveries []*MessageDelivery `protobuf:"bytes,18,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// Warnings about the message being sent, such as the recipient not being
+	// checked in at the location of the message.
+	Warnings []string `protobuf:"bytes,19,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// The changes to the state of the message, the oldest first.
+	StateChanges []*MessageStateChange `protobuf:"bytes,20,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+	// The escalation policy of the location when the message was sent, which
+	// is followed until the message is acknowledged or ends.
+	EscalationPolicy *EscalationPolicy `protobuf:"bytes,21,opt,name=escalation_policy,json=escalationPolicy,proto3" json:"escalation_policy,omitempty"`
+	// The time the next step of the escalation policy is taken. Not set once
+	// the message has been acknowledged or has ended, or when every step has
+	// been taken.
+	NextEscalationTime *timestamp.Timestamp `protobuf:"bytes,22,opt,name=next_escalation_time,json=nextEscalationTime,proto3" json:"next_escalation_time,omitempty"`
+	// Whether the message is shown on every device in the location, whoever
+	// it was sent to. Emergency messages are always broadcast, and other
+	// messages can be broadcast by their escalation policy.
+	Broadcast bool `protobuf:"varint,23,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	// When the message expires. It defaults to the message TTL of its location,
+	// and the message doesn't expire when that isn't set.
+	//
+	// Types that are assignable to Expiration:
+	//	*Message_ExpireTime
+	//	*Message_Ttl
+	Expiration isMessage_Expiration `protobuf_oneof:"expiration"`
+	// Server-defined URL for the resource.
 	SelfLink string `protobuf:"bytes,100,opt,name=self_link,json=selfLink,proto3" json:"self_link,omitempty"`
 	// The time the resource was created.
 	CreateTime *timestamp.Timestamp `protobuf:"bytes,101,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
@@ -158,9 +523,121 @@ func (x *Message) GetDescription() string {
 	return ""
 }
 
-func (x *Message) GetDisplayConfiguration() *Message_DisplayConfiguration {
+func (x *Message) GetDisplayConfig() *Message_DisplayConfig {
+	if x != nil {
+		return x.DisplayConfig
+	}
+	return nil
+}
+
+func (x *Message) GetResponses() []*MessageResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *Message) GetEscalations() []*MessageEscalation {
+	if x != nil {
+		return x.Escalations
+	}
+	return nil
+}
+
+func (x *Message) GetScheduledMessage() string {
+	if x != nil {
+		return x.ScheduledMessage
+	}
+	return ""
+}
+
+func (x *Message) GetState() Message_State {
+	if x != nil {
+		return x.State
+	}
+	return Message_STATE_UNSPECIFIED
+}
+
+func (x *Message) GetPriority() MessagePriority {
+	if x != nil {
+		return x.Priority
+	}
+	return MessagePriority_MESSAGE_PRIORITY_UNSPECIFIED
+}
+
+func (x *Message) GetClaimMode() MessageClaimMode {
+	if x != nil {
+		return x.ClaimMode
+	}
+	return MessageClaimMode_MESSAGE_CLAIM_MODE_UNSPECIFIED
+}
+
+func (x *Message) GetClaimedBy() string {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return ""
+}
+
+func (x *Message) GetDeliveries() []*MessageDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *Message) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *Message) GetStateChanges() []*MessageStateChange {
+	if x != nil {
+		return x.StateChanges
+	}
+	return nil
+}
+
+func (x *Message) GetEscalationPolicy() *EscalationPolicy {
+	if x != nil {
+		return x.EscalationPolicy
+	}
+	return nil
+}
+
+func (x *Message) GetNextEscalationTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextEscalationTime
+	}
+	return nil
+}
+
+func (x *Message) GetBroadcast() bool {
 	if x != nil {
-		return x.DisplayConfiguration
+		return x.Broadcast
+	}
+	return false
+}
+
+func (m *Message) GetExpiration() isMessage_Expiration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (x *Message) GetExpireTime() *timestamp.Timestamp {
+	if x, ok := x.GetExpiration().(*Message_ExpireTime); ok {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *Message) GetTtl() *duration.Duration {
+	if x, ok := x.GetExpiration().(*Message_Ttl); ok {
+		return x.Ttl
 	}
 	return nil
 }
@@ -193,28 +670,68 @@ func (x *Message) GetDeleteTime() *timestamp.Timestamp {
 	return nil
 }
 
-// ListMessagesRequest will list all of the messages in a location.
-type ListMessagesRequest struct {
+type isMessage_Expiration interface {
+	isMessage_Expiration()
+}
+
+type Message_ExpireTime struct {
+	// The time the message expires. It must be in the future when the message
+	// is sent.
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,13,opt,name=expire_time,json=expireTime,proto3,oneof"`
+}
+
+type Message_Ttl struct {
+	// How long after it is sent the message expires. The expire time of the
+	// message is set from it when the message is sent.
+	Ttl *duration.Duration `protobuf:"bytes,14,opt,name=ttl,proto3,oneof"`
+}
+
+func (*Message_ExpireTime) isMessage_Expiration() {}
+
+func (*Message_Ttl) isMessage_Expiration() {}
+
+// A ScheduledMessage is a message that is sent at a later time, either once
+// or on a recurring schedule.
+type ScheduledMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The parent (account and location) where the messages will be listed
-	// Specified in the format 'accounts/*/locations/*'.
-	// Location "-" will get the messages for all locations in an account.
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The max number of results per page that should be returned. If the number
-	// of available results is larger than `page_size`, a `next_page_token` is
-	// returned which can be used to get the next page of results in subsequent
-	// requests. Acceptable values are 1 to 500, inclusive. (Default: 500)
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Specifies a page token to use. Set this to the nextPageToken returned by
-	// previous list requests to get the next page of results.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The name of the scheduled message.
+	// In the format 'accounts/*/locations/*/scheduledMessages/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The message that is sent each time the schedule is due.
+	Message *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The time the message is first sent. The time of day of a recurring
+	// message is kept in the time zone of the location, so it doesn't move
+	// when daylight saving time starts or ends.
+	StartTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// An RFC 5545 recurrence rule for a message that is sent more than once,
+	// e.g. `FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR`. FREQ can be DAILY, WEEKLY or
+	// MONTHLY, along with the INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL
+	// parts. A message without a rule is only sent once.
+	Recurrence string `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Whether the schedule has been paused.
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The next time the message will be sent. Not set once the schedule has
+	// ended.
+	NextSendTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=next_send_time,json=nextSendTime,proto3" json:"next_send_time,omitempty"`
+	// The last time the message was sent.
+	LastSendTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=last_send_time,json=lastSendTime,proto3" json:"last_send_time,omitempty"`
+	// The number of times the message has been sent.
+	SendCount int32 `protobuf:"varint,8,opt,name=send_count,json=sendCount,proto3" json:"send_count,omitempty"`
+	// Server-defined URL for the resource.
+	SelfLink string `protobuf:"bytes,100,opt,name=self_link,json=selfLink,proto3" json:"self_link,omitempty"`
+	// A unique identifier for the scheduled message.
+	Uid string `protobuf:"bytes,101,opt,name=uid,proto3" json:"uid,omitempty"`
+	// The time the scheduled message was created.
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,102,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the scheduled message was updated.
+	UpdateTime *timestamp.Timestamp `protobuf:"bytes,103,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 }
 
-func (x *ListMessagesRequest) Reset() {
-	*x = ListMessagesRequest{}
+func (x *ScheduledMessage) Reset() {
+	*x = ScheduledMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chacerapp_v1_messages_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -222,13 +739,13 @@ func (x *ListMessagesRequest) Reset() {
 	}
 }
 
-func (x *ListMessagesRequest) String() string {
+func (x *ScheduledMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMessagesRequest) ProtoMessage() {}
+func (*ScheduledMessage) ProtoMessage() {}
 
-func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *ScheduledMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_chacerapp_v1_messages_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -240,40 +757,1025 @@ func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMessagesRequest.ProtoReflect.Descriptor instead.
-func (*ListMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ScheduledMessage.ProtoReflect.Descriptor instead.
+func (*ScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduledMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ScheduledMessage) GetNextSendTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextSendTime
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetLastSendTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSendTime
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetSendCount() int32 {
+	if x != nil {
+		return x.SendCount
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetSelfLink() string {
+	if x != nil {
+		return x.SelfLink
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetCreateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetUpdateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// A MessageDelivery is the delivery of a message to a single contact.
+type MessageDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The contact the message was delivered to.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// How far the message got to the contact.
+	State MessageDeliveryState `protobuf:"varint,2,opt,name=state,proto3,enum=chacerapp.v1.MessageDeliveryState" json:"state,omitempty"`
+	// How the contact answered the message, if they have.
+	Response MessageResponseState `protobuf:"varint,3,opt,name=response,proto3,enum=chacerapp.v1.MessageResponseState" json:"response,omitempty"`
+	// The time the state of the delivery last changed.
+	UpdateTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *MessageDelivery) Reset() {
+	*x = MessageDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDelivery) ProtoMessage() {}
+
+func (x *MessageDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDelivery.ProtoReflect.Descriptor instead.
+func (*MessageDelivery) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MessageDelivery) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *MessageDelivery) GetState() MessageDeliveryState {
+	if x != nil {
+		return x.State
+	}
+	return MessageDeliveryState_MESSAGE_DELIVERY_STATE_UNSPECIFIED
+}
+
+func (x *MessageDelivery) GetResponse() MessageResponseState {
+	if x != nil {
+		return x.Response
+	}
+	return MessageResponseState_MESSAGE_RESPONSE_STATE_UNSPECIFIED
+}
+
+func (x *MessageDelivery) GetUpdateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// A MessageResponse is the answer of a single responder to a message. A
+// responder that answers again replaces their previous response.
+type MessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The contact that responded to the message.
+	Responder string `protobuf:"bytes,1,opt,name=responder,proto3" json:"responder,omitempty"`
+	// How the responder answered the message.
+	State MessageResponseState `protobuf:"varint,2,opt,name=state,proto3,enum=chacerapp.v1.MessageResponseState" json:"state,omitempty"`
+	// The time the responder expects to take to arrive in the requested room.
+	// Only set for acknowledged messages.
+	Eta *duration.Duration `protobuf:"bytes,3,opt,name=eta,proto3" json:"eta,omitempty"`
+	// The time of the response.
+	ResponseTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"`
+}
+
+func (x *MessageResponse) Reset() {
+	*x = MessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResponse) ProtoMessage() {}
+
+func (x *MessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageResponse.ProtoReflect.Descriptor instead.
+func (*MessageResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MessageResponse) GetResponder() string {
+	if x != nil {
+		return x.Responder
+	}
+	return ""
+}
+
+func (x *MessageResponse) GetState() MessageResponseState {
+	if x != nil {
+		return x.State
+	}
+	return MessageResponseState_MESSAGE_RESPONSE_STATE_UNSPECIFIED
+}
+
+func (x *MessageResponse) GetEta() *duration.Duration {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *MessageResponse) GetResponseTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ResponseTime
+	}
+	return nil
+}
+
+// A MessageEscalation records an escalation step taken on a message.
+type MessageEscalation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The position of the step in the escalation policy, starting at 0.
+	Step int32 `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	// The action that was taken.
+	Action EscalationAction `protobuf:"varint,2,opt,name=action,proto3,enum=chacerapp.v1.EscalationAction" json:"action,omitempty"`
+	// The time the step was taken.
+	EscalateTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=escalate_time,json=escalateTime,proto3" json:"escalate_time,omitempty"`
+	// The sound the message was changed to by a step that changed the sound.
+	Sound string `protobuf:"bytes,4,opt,name=sound,proto3" json:"sound,omitempty"`
+	// The contact the message was redirected to by a step that redirected it.
+	Contact string `protobuf:"bytes,5,opt,name=contact,proto3" json:"contact,omitempty"`
+}
+
+func (x *MessageEscalation) Reset() {
+	*x = MessageEscalation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageEscalation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEscalation) ProtoMessage() {}
+
+func (x *MessageEscalation) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEscalation.ProtoReflect.Descriptor instead.
+func (*MessageEscalation) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MessageEscalation) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *MessageEscalation) GetAction() EscalationAction {
+	if x != nil {
+		return x.Action
+	}
+	return EscalationAction_ESCALATION_ACTION_UNSPECIFIED
+}
+
+func (x *MessageEscalation) GetEscalateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EscalateTime
+	}
+	return nil
+}
+
+func (x *MessageEscalation) GetSound() string {
+	if x != nil {
+		return x.Sound
+	}
+	return ""
+}
+
+func (x *MessageEscalation) GetContact() string {
+	if x != nil {
+		return x.Contact
+	}
+	return ""
+}
+
+// A MessageStateChange records a change to the state of a message.
+type MessageStateChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state of the message before the change.
+	PreviousState Message_State `protobuf:"varint,1,opt,name=previous_state,json=previousState,proto3,enum=chacerapp.v1.Message_State" json:"previous_state,omitempty"`
+	// The state of the message after the change.
+	State Message_State `protobuf:"varint,2,opt,name=state,proto3,enum=chacerapp.v1.Message_State" json:"state,omitempty"`
+	// The caller that changed the state, or empty when the state was changed
+	// by the server, e.g. when the message expired.
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The time of the change.
+	ChangeTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+}
+
+func (x *MessageStateChange) Reset() {
+	*x = MessageStateChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageStateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageStateChange) ProtoMessage() {}
+
+func (x *MessageStateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageStateChange.ProtoReflect.Descriptor instead.
+func (*MessageStateChange) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MessageStateChange) GetPreviousState() Message_State {
+	if x != nil {
+		return x.PreviousState
+	}
+	return Message_STATE_UNSPECIFIED
+}
+
+func (x *MessageStateChange) GetState() Message_State {
+	if x != nil {
+		return x.State
+	}
+	return Message_STATE_UNSPECIFIED
+}
+
+func (x *MessageStateChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *MessageStateChange) GetChangeTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ChangeTime
+	}
+	return nil
+}
+
+// ListMessagesRequest will list all of the messages in a location.
+type ListMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parent (account and location) where the messages will be listed
+	// Specified in the format 'accounts/*/locations/*'.
+	// Location "-" will get the messages for all locations in an account.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The max number of results per page that should be returned. If the number
+	// of available results is larger than `page_size`, a `next_page_token` is
+	// returned which can be used to get the next page of results in subsequent
+	// requests. Acceptable values are 1 to 500, inclusive. (Default: 500)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Specifies a page token to use. Set this to the nextPageToken returned by
+	// previous list requests to get the next page of results.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListMessagesRequest) Reset() {
+	*x = ListMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesRequest) ProtoMessage() {}
+
+func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMessagesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListMessagesResponse will list the messages for a location.
+type ListMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A list of messages in the specified location.
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// This token allows you to get the next page of results for list requests.
+	// If the number of results is larger than `page_size`, use the
+	// `next_page_token` as a value for the query parameter `page_token` in the
+	// next request. The value will become empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMessagesResponse) Reset() {
+	*x = ListMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesResponse) ProtoMessage() {}
+
+func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// WatchMessagesRequest watches the messages of a location.
+type WatchMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The location to watch the messages of.
+	// Specified in the format 'accounts/*/locations/*'.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+}
+
+func (x *WatchMessagesRequest) Reset() {
+	*x = WatchMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMessagesRequest) ProtoMessage() {}
+
+func (x *WatchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*WatchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchMessagesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// WatchMessagesResponse holds the messages that changed.
+type WatchMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The messages that changed, or every message that hasn't reached a
+	// terminal state for the first response.
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *WatchMessagesResponse) Reset() {
+	*x = WatchMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMessagesResponse) ProtoMessage() {}
+
+func (x *WatchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*WatchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// SendMessageRequest will send a message to all the devices in a location.
+type SendMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parent (account and location) where the message will be sent.
+	// Specified in the format 'accounts/*/locations/*'.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The message that should be sent.
+	Message *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The time the message should be sent. The message is sent straight away
+	// when this isn't set or is in the past.
+	SendTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SendMessageRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetSendTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.SendTime
+	}
+	return nil
+}
+
+// CompleteMessageRequest will mark a message sent to a location as completed.
+type CompleteMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name (account, location, message id) of the message to complete.
+	// In the format 'accounts/*/locations/*/messages/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CompleteMessageRequest) Reset() {
+	*x = CompleteMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMessageRequest) ProtoMessage() {}
+
+func (x *CompleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CompleteMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CancelMessageRequest will mark a message sent to a location as canceled.
+type CancelMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name (account, location, message id) of the message to complete.
+	// In the format 'accounts/*/locations/*/messages/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CancelMessageRequest) Reset() {
+	*x = CancelMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMessageRequest) ProtoMessage() {}
+
+func (x *CancelMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// AcknowledgeMessageRequest records the response of a recipient to a message.
+type AcknowledgeMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name (account, location, message id) of the message to respond to.
+	// In the format 'accounts/*/locations/*/messages/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The contact that is responding to the message.
+	// In the format 'accounts/*/contacts/*'.
+	Responder string `protobuf:"bytes,2,opt,name=responder,proto3" json:"responder,omitempty"`
+	// How the responder is answering the message.
+	Response MessageResponseState `protobuf:"varint,3,opt,name=response,proto3,enum=chacerapp.v1.MessageResponseState" json:"response,omitempty"`
+	// The time the responder expects to take to arrive in the requested room.
+	// It can only be set when acknowledging a message and must be at most 24
+	// hours.
+	Eta *duration.Duration `protobuf:"bytes,4,opt,name=eta,proto3" json:"eta,omitempty"`
+}
+
+func (x *AcknowledgeMessageRequest) Reset() {
+	*x = AcknowledgeMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeMessageRequest) ProtoMessage() {}
+
+func (x *AcknowledgeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeMessageRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AcknowledgeMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcknowledgeMessageRequest) GetResponder() string {
+	if x != nil {
+		return x.Responder
+	}
+	return ""
+}
+
+func (x *AcknowledgeMessageRequest) GetResponse() MessageResponseState {
+	if x != nil {
+		return x.Response
+	}
+	return MessageResponseState_MESSAGE_RESPONSE_STATE_UNSPECIFIED
+}
+
+func (x *AcknowledgeMessageRequest) GetEta() *duration.Duration {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+// GenerateMessageRequest will generate a message from a pre-configured template
+type GenerateMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name (account, location, and template name) of the template that
+	// should be used to generate the message. Specified in the format
+	// 'accounts/*/locations/*/templates/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GenerateMessageRequest) Reset() {
+	*x = GenerateMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMessageRequest) ProtoMessage() {}
+
+func (x *GenerateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMessageRequest.ProtoReflect.Descriptor instead.
+func (*GenerateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GenerateMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ListScheduledMessagesRequest lists the scheduled messages of a location.
+type ListScheduledMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The location to list the scheduled messages of.
+	// Specified in the format 'accounts/*/locations/*'.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The max number of results per page that should be returned. If the number
+	// of available results is larger than `page_size`, a `next_page_token` is
+	// returned which can be used to get the next page of results in subsequent
+	// requests. Acceptable values are 1 to 500, inclusive. (Default: 500)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Specifies a page token to use. Set this to the nextPageToken returned by
+	// previous list requests to get the next page of results.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListScheduledMessagesRequest) Reset() {
+	*x = ListScheduledMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesRequest) ProtoMessage() {}
+
+func (x *ListScheduledMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListMessagesRequest) GetParent() string {
+func (x *ListScheduledMessagesRequest) GetParent() string {
 	if x != nil {
 		return x.Parent
 	}
 	return ""
 }
 
-func (x *ListMessagesRequest) GetPageSize() int32 {
+func (x *ListScheduledMessagesRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListMessagesRequest) GetPageToken() string {
+func (x *ListScheduledMessagesRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-// ListMessagesResponse will list the messages for a location.
-type ListMessagesResponse struct {
+// ListScheduledMessagesResponse lists the scheduled messages of a location.
+type ListScheduledMessagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A list of messages in the specified location.
-	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// A list of scheduled messages in the specified location.
+	ScheduledMessages []*ScheduledMessage `protobuf:"bytes,1,rep,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages,omitempty"`
 	// This token allows you to get the next page of results for list requests.
 	// If the number of results is larger than `page_size`, use the
 	// `next_page_token` as a value for the query parameter `page_token` in the
@@ -281,23 +1783,23 @@ type ListMessagesResponse struct {
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *ListMessagesResponse) Reset() {
-	*x = ListMessagesResponse{}
+func (x *ListScheduledMessagesResponse) Reset() {
+	*x = ListScheduledMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_messages_proto_msgTypes[2]
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListMessagesResponse) String() string {
+func (x *ListScheduledMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMessagesResponse) ProtoMessage() {}
+func (*ListScheduledMessagesResponse) ProtoMessage() {}
 
-func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_messages_proto_msgTypes[2]
+func (x *ListScheduledMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,55 +1810,58 @@ func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMessagesResponse.ProtoReflect.Descriptor instead.
-func (*ListMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListScheduledMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListMessagesResponse) GetMessages() []*Message {
+func (x *ListScheduledMessagesResponse) GetScheduledMessages() []*ScheduledMessage {
 	if x != nil {
-		return x.Messages
+		return x.ScheduledMessages
 	}
 	return nil
 }
 
-func (x *ListMessagesResponse) GetNextPageToken() string {
+func (x *ListScheduledMessagesResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-// SendMessageRequest will send a message to all the devices in a location.
-type SendMessageRequest struct {
+// CreateScheduledMessageRequest schedules a message.
+type CreateScheduledMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The parent (account and location) where the message will be sent.
+	// The location the message will be sent in.
 	// Specified in the format 'accounts/*/locations/*'.
 	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The message that should be sent.
-	Message *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The scheduled message that should be created.
+	ScheduledMessage *ScheduledMessage `protobuf:"bytes,2,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	// The ID that should be used as the resource ID of the scheduled message.
+	// An ID is generated when it isn't set.
+	ScheduledMessageId string `protobuf:"bytes,3,opt,name=scheduled_message_id,json=scheduledMessageId,proto3" json:"scheduled_message_id,omitempty"`
 }
 
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
+func (x *CreateScheduledMessageRequest) Reset() {
+	*x = CreateScheduledMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_messages_proto_msgTypes[3]
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendMessageRequest) String() string {
+func (x *CreateScheduledMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageRequest) ProtoMessage() {}
+func (*CreateScheduledMessageRequest) ProtoMessage() {}
 
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_messages_proto_msgTypes[3]
+func (x *CreateScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,53 +1872,60 @@ func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SendMessageRequest) GetParent() string {
+func (x *CreateScheduledMessageRequest) GetParent() string {
 	if x != nil {
 		return x.Parent
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetMessage() *Message {
+func (x *CreateScheduledMessageRequest) GetScheduledMessage() *ScheduledMessage {
 	if x != nil {
-		return x.Message
+		return x.ScheduledMessage
 	}
 	return nil
 }
 
-// CompleteMessageRequest will mark a message sent to a location as completed.
-type CompleteMessageRequest struct {
+func (x *CreateScheduledMessageRequest) GetScheduledMessageId() string {
+	if x != nil {
+		return x.ScheduledMessageId
+	}
+	return ""
+}
+
+// GetScheduledMessageRequest retrieves a scheduled message.
+type GetScheduledMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name (account, location, message id) of the message to complete.
-	// In the format 'accounts/*/locations/*/messages/*'.
+	// The name of the scheduled message.
+	// In the format 'accounts/*/locations/*/scheduledMessages/*'.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *CompleteMessageRequest) Reset() {
-	*x = CompleteMessageRequest{}
+func (x *GetScheduledMessageRequest) Reset() {
+	*x = GetScheduledMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_messages_proto_msgTypes[4]
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CompleteMessageRequest) String() string {
+func (x *GetScheduledMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteMessageRequest) ProtoMessage() {}
+func (*GetScheduledMessageRequest) ProtoMessage() {}
 
-func (x *CompleteMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_messages_proto_msgTypes[4]
+func (x *GetScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,46 +1936,46 @@ func (x *CompleteMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteMessageRequest.ProtoReflect.Descriptor instead.
-func (*CompleteMessageRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CompleteMessageRequest) GetName() string {
+func (x *GetScheduledMessageRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// CancelMessageRequest will mark a message sent to a location as canceled.
-type CancelMessageRequest struct {
+// PauseScheduledMessageRequest pauses a scheduled message.
+type PauseScheduledMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name (account, location, message id) of the message to complete.
-	// In the format 'accounts/*/locations/*/messages/*'.
+	// The name of the scheduled message.
+	// In the format 'accounts/*/locations/*/scheduledMessages/*'.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *CancelMessageRequest) Reset() {
-	*x = CancelMessageRequest{}
+func (x *PauseScheduledMessageRequest) Reset() {
+	*x = PauseScheduledMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_messages_proto_msgTypes[5]
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelMessageRequest) String() string {
+func (x *PauseScheduledMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelMessageRequest) ProtoMessage() {}
+func (*PauseScheduledMessageRequest) ProtoMessage() {}
 
-func (x *CancelMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_messages_proto_msgTypes[5]
+func (x *PauseScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,47 +1986,46 @@ func (x *CancelMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelMessageRequest.ProtoReflect.Descriptor instead.
-func (*CancelMessageRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PauseScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*PauseScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CancelMessageRequest) GetName() string {
+func (x *PauseScheduledMessageRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// GenerateMessageRequest will generate a message from a pre-configured template
-type GenerateMessageRequest struct {
+// ResumeScheduledMessageRequest resumes a paused scheduled message.
+type ResumeScheduledMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name (account, location, and template name) of the template that
-	// should be used to generate the message. Specified in the format
-	// 'accounts/*/locations/*/templates/*'.
+	// The name of the scheduled message.
+	// In the format 'accounts/*/locations/*/scheduledMessages/*'.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GenerateMessageRequest) Reset() {
-	*x = GenerateMessageRequest{}
+func (x *ResumeScheduledMessageRequest) Reset() {
+	*x = ResumeScheduledMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_messages_proto_msgTypes[6]
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateMessageRequest) String() string {
+func (x *ResumeScheduledMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateMessageRequest) ProtoMessage() {}
+func (*ResumeScheduledMessageRequest) ProtoMessage() {}
 
-func (x *GenerateMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_messages_proto_msgTypes[6]
+func (x *ResumeScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,12 +2036,62 @@ func (x *GenerateMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateMessageRequest.ProtoReflect.Descriptor instead.
-func (*GenerateMessageRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ResumeScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*ResumeScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GenerateMessageRequest) GetName() string {
+func (x *ResumeScheduledMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeleteScheduledMessageRequest deletes a scheduled message.
+type DeleteScheduledMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the scheduled message.
+	// In the format 'accounts/*/locations/*/scheduledMessages/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteScheduledMessageRequest) Reset() {
+	*x = DeleteScheduledMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteScheduledMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduledMessageRequest) ProtoMessage() {}
+
+func (x *DeleteScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteScheduledMessageRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
@@ -540,42 +2101,42 @@ func (x *GenerateMessageRequest) GetName() string {
 // The configuration settings for how the message should be displayed
 // on a device. This configuration is determined by the system based
 // on the recipient of the message.
-type Message_DisplayConfiguration struct {
+type Message_DisplayConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Output Only. The background color that should be used when displaying
+	// The background color that should be used when displaying
 	// the message.
 	BackgroundColor *color.Color `protobuf:"bytes,1,opt,name=background_color,json=backgroundColor,proto3" json:"background_color,omitempty"`
-	// Output Only. The foreground color that should be used for the message's
+	// The foreground color that should be used for the message's
 	// foreground text on the device.
 	ForegroundColor *color.Color `protobuf:"bytes,2,opt,name=foreground_color,json=foregroundColor,proto3" json:"foreground_color,omitempty"`
-	// Output Only. The color of the border that should be used for the border
+	// The color of the border that should be used for the border
 	// of the message.
 	BorderColor *color.Color `protobuf:"bytes,3,opt,name=border_color,json=borderColor,proto3" json:"border_color,omitempty"`
-	// Output Only. The resource name of the sound that should be played
+	// The resource name of the sound that should be played
 	// when the message is received by the device.
 	Sound string `protobuf:"bytes,4,opt,name=sound,proto3" json:"sound,omitempty"`
 }
 
-func (x *Message_DisplayConfiguration) Reset() {
-	*x = Message_DisplayConfiguration{}
+func (x *Message_DisplayConfig) Reset() {
+	*x = Message_DisplayConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_messages_proto_msgTypes[7]
+		mi := &file_chacerapp_v1_messages_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Message_DisplayConfiguration) String() string {
+func (x *Message_DisplayConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message_DisplayConfiguration) ProtoMessage() {}
+func (*Message_DisplayConfig) ProtoMessage() {}
 
-func (x *Message_DisplayConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_messages_proto_msgTypes[7]
+func (x *Message_DisplayConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_messages_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,33 +2147,33 @@ func (x *Message_DisplayConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message_DisplayConfiguration.ProtoReflect.Descriptor instead.
-func (*Message_DisplayConfiguration) Descriptor() ([]byte, []int) {
+// Deprecated: Use Message_DisplayConfig.ProtoReflect.Descriptor instead.
+func (*Message_DisplayConfig) Descriptor() ([]byte, []int) {
 	return file_chacerapp_v1_messages_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *Message_DisplayConfiguration) GetBackgroundColor() *color.Color {
+func (x *Message_DisplayConfig) GetBackgroundColor() *color.Color {
 	if x != nil {
 		return x.BackgroundColor
 	}
 	return nil
 }
 
-func (x *Message_DisplayConfiguration) GetForegroundColor() *color.Color {
+func (x *Message_DisplayConfig) GetForegroundColor() *color.Color {
 	if x != nil {
 		return x.ForegroundColor
 	}
 	return nil
 }
 
-func (x *Message_DisplayConfiguration) GetBorderColor() *color.Color {
+func (x *Message_DisplayConfig) GetBorderColor() *color.Color {
 	if x != nil {
 		return x.BorderColor
 	}
 	return nil
 }
 
-func (x *Message_DisplayConfiguration) GetSound() string {
+func (x *Message_DisplayConfig) GetSound() string {
 	if x != nil {
 		return x.Sound
 	}
@@ -624,162 +2185,604 @@ var File_chacerapp_v1_messages_proto protoreflect.FileDescriptor
 var file_chacerapp_v1_messages_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x63,
-	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61,
-	0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x07, 0x0a,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x09,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x22, 0xe0, 0x41, 0x05, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68,
-	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3a,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x22, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x68,
+	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x63, 0x68,
+	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9,
+	0x10, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe2, 0x41, 0x01, 0x05, 0xfa, 0x41, 0x03,
+	0x0a, 0x01, 0x2a, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3a,
 	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22,
-	0xe0, 0x41, 0x05, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0xe2, 0x41, 0x01, 0x05, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x61,
 	0x63, 0x74, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0e, 0x72, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1f, 0xe0, 0x41, 0x05, 0xfa, 0x41, 0x19, 0x0a, 0x17, 0x61, 0x70, 0x69, 0x73,
-	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52,
+	0x28, 0x09, 0x42, 0x1f, 0xe2, 0x41, 0x01, 0x05, 0xfa, 0x41, 0x18, 0x0a, 0x16, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52,
 	0x6f, 0x6f, 0x6d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x6f,
 	0x6f, 0x6d, 0x12, 0x3f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe0, 0x41, 0x03, 0xfa, 0x41, 0x1d, 0x0a, 0x1b, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x6f, 0x6d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0x41, 0x01, 0x03, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a,
-	0x15, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63,
-	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x05, 0x52, 0x14, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6c,
-	0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a,
+	0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03,
+	0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x41, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0b,
+	0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x62, 0x0a, 0x11, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0x41, 0x01, 0x03, 0xfa, 0x41, 0x2e, 0x0a,
+	0x2c, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x10, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x05, 0x52, 0x09, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe2, 0x41,
+	0x01, 0x03, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x12, 0x43, 0x0a, 0x0a, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x13, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x03, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x51, 0x0a, 0x11, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03,
+	0x52, 0x10, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x52, 0x0a, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52,
+	0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x04, 0x48, 0x00, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x21,
+	0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
-	0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xe1, 0x01, 0x0a, 0x14,
-	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x0b, 0x62, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6f, 0x75,
-	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x22,
-	0x69, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5d, 0x0a,
-	0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xf2, 0x01, 0x0a, 0x0d, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x10,
+	0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03,
+	0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x12, 0x43, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x22,
+	0x75, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4c, 0x45, 0x44, 0x47, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x4c,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x06, 0x3a, 0x64, 0xea, 0x41, 0x61, 0x0a, 0x23, 0x6d, 0x65, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x3a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x7d, 0x42, 0x0c, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdb, 0x05, 0x0a, 0x10, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12,
+	0x46, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x41, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x66,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x80, 0x01, 0xea, 0x41, 0x7d, 0x0a, 0x2c, 0x6d, 0x65, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x7d, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x7d, 0x22, 0x9c, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x09,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x22, 0xe2, 0x41, 0x01, 0x03, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3e,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22,
+	0xe2, 0x41, 0x01, 0x03, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63,
 	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x16,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x54, 0x0a, 0x14, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x28, 0xfa, 0x41, 0x25, 0x0a, 0x23, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
-	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xfa, 0x41, 0x25, 0x0a, 0x23, 0x6d,
-	0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xcc, 0x05, 0x0a, 0x09, 0x4d, 0x65, 0x73,
-	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x8b, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
-	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
-	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x8e, 0x01, 0x0a, 0x0f, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24,
-	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a,
+	0x03, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x03, 0x65, 0x74, 0x61,
+	0x12, 0x45, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8c, 0x02, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x03, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0d, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0c,
+	0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05,
+	0x73, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x03, 0x52, 0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe2, 0x41, 0x01, 0x03, 0xfa,
+	0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x48, 0x0a,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x0b,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x03, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x8e, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1c,
+	0x0a, 0x1a, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x71, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x53, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0x41, 0x01,
+	0x02, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xe2, 0x41, 0x01,
+	0x02, 0xfa, 0x41, 0x25, 0x12, 0x23, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x56, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xfa, 0x41, 0x25, 0x0a, 0x23,
+	0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x54, 0x0a, 0x14, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xfa, 0x41, 0x25, 0x0a, 0x23, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x92, 0x02, 0x0a, 0x19, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xe2, 0x41, 0x01,
+	0x02, 0xfa, 0x41, 0x25, 0x0a, 0x23, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x40, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x22, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1b, 0x0a, 0x19, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x03, 0x65, 0x74, 0x61, 0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xfa, 0x41,
+	0x25, 0x0a, 0x23, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x97, 0x01, 0x0a,
+	0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2,
+	0x41, 0x01, 0x02, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xe1, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x23, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x51,
+	0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x49, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x35, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x2e, 0x0a, 0x2c, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x1c,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0x41, 0x01, 0x02,
+	0xfa, 0x41, 0x2e, 0x0a, 0x2c, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x2e, 0x0a,
+	0x2c, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x35, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x2e, 0x0a, 0x2c, 0x6d, 0x65, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x2a,
+	0xab, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50,
+	0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50,
+	0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4f,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x52, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x1e, 0x0a,
+	0x1a, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x04, 0x2a, 0x7d, 0x0a,
+	0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x4c, 0x41,
+	0x49, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f,
+	0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x41, 0x4e, 0x59, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x2a, 0xf2, 0x01, 0x0a,
+	0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a,
+	0x1e, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52,
+	0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x10, 0x04,
+	0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x45, 0x4c, 0x44, 0x10,
+	0x05, 0x2a, 0x8c, 0x01, 0x0a, 0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02,
+	0x32, 0xf0, 0x11, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x94,
+	0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x21, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x71,
+	0xda, 0x41, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x92, 0x88, 0x27, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x22, 0x31,
+	0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x2a, 0x7d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x73, 0x65, 0x6e,
+	0x64, 0x12, 0x9f, 0x01, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0xda, 0x41,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f,
+	0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x2a, 0x7d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x30, 0x01, 0x12, 0x90, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a, 0x22,
+	0x35, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x2a, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a,
+	0x3a, 0x01, 0x2a, 0x22, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a,
+	0x7d, 0x3a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x0d, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38,
+	0x3a, 0x01, 0x2a, 0x22, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61,
 	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f,
-	0x2a, 0x7d, 0x3a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x0f,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x24, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x22, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2f, 0x2a, 0x7d, 0x3a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x88, 0x01, 0x0a,
-	0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22,
-	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x35, 0x22, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63,
+	0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a,
+	0x7d, 0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0xb8, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0xda, 0x41, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x76,
+	0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a,
+	0x7d, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x8c, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b,
+	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68,
+	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa4, 0x01, 0xda, 0x41,
+	0x2d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x92, 0x88,
+	0x27, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x3a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x2f, 0x76, 0x31,
+	0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d,
+	0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x44, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x15, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x4d, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x3a,
+	0x01, 0x2a, 0x22, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d,
-	0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x70, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
-	0x70, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x70, 0x62, 0xaa, 0x02, 0x0c, 0x43, 0x68,
-	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0xb5, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4e, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x01, 0x2a, 0x22, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d,
+	0x3a, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x44, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x2a, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xb3, 0x01,
+	0x0a, 0x12, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0xda, 0x41, 0x17, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x2c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x3a, 0x01, 0x2a, 0x22, 0x38, 0x2f, 0x76, 0x31, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x42, 0x71, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x61,
+	0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x70, 0x62, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -794,45 +2797,119 @@ func file_chacerapp_v1_messages_proto_rawDescGZIP() []byte {
 	return file_chacerapp_v1_messages_proto_rawDescData
 }
 
-var file_chacerapp_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_chacerapp_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_chacerapp_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_chacerapp_v1_messages_proto_goTypes = []interface{}{
-	(*Message)(nil),                      // 0: chacerapp.v1.Message
-	(*ListMessagesRequest)(nil),          // 1: chacerapp.v1.ListMessagesRequest
-	(*ListMessagesResponse)(nil),         // 2: chacerapp.v1.ListMessagesResponse
-	(*SendMessageRequest)(nil),           // 3: chacerapp.v1.SendMessageRequest
-	(*CompleteMessageRequest)(nil),       // 4: chacerapp.v1.CompleteMessageRequest
-	(*CancelMessageRequest)(nil),         // 5: chacerapp.v1.CancelMessageRequest
-	(*GenerateMessageRequest)(nil),       // 6: chacerapp.v1.GenerateMessageRequest
-	(*Message_DisplayConfiguration)(nil), // 7: chacerapp.v1.Message.DisplayConfiguration
-	(*timestamp.Timestamp)(nil),          // 8: google.protobuf.Timestamp
-	(*color.Color)(nil),                  // 9: google.type.Color
-	(*empty.Empty)(nil),                  // 10: google.protobuf.Empty
+	(MessagePriority)(0),                  // 0: chacerapp.v1.MessagePriority
+	(MessageClaimMode)(0),                 // 1: chacerapp.v1.MessageClaimMode
+	(MessageDeliveryState)(0),             // 2: chacerapp.v1.MessageDeliveryState
+	(MessageResponseState)(0),             // 3: chacerapp.v1.MessageResponseState
+	(Message_State)(0),                    // 4: chacerapp.v1.Message.State
+	(*Message)(nil),                       // 5: chacerapp.v1.Message
+	(*ScheduledMessage)(nil),              // 6: chacerapp.v1.ScheduledMessage
+	(*MessageDelivery)(nil),               // 7: chacerapp.v1.MessageDelivery
+	(*MessageResponse)(nil),               // 8: chacerapp.v1.MessageResponse
+	(*MessageEscalation)(nil),             // 9: chacerapp.v1.MessageEscalation
+	(*MessageStateChange)(nil),            // 10: chacerapp.v1.MessageStateChange
+	(*ListMessagesRequest)(nil),           // 11: chacerapp.v1.ListMessagesRequest
+	(*ListMessagesResponse)(nil),          // 12: chacerapp.v1.ListMessagesResponse
+	(*WatchMessagesRequest)(nil),          // 13: chacerapp.v1.WatchMessagesRequest
+	(*WatchMessagesResponse)(nil),         // 14: chacerapp.v1.WatchMessagesResponse
+	(*SendMessageRequest)(nil),            // 15: chacerapp.v1.SendMessageRequest
+	(*CompleteMessageRequest)(nil),        // 16: chacerapp.v1.CompleteMessageRequest
+	(*CancelMessageRequest)(nil),          // 17: chacerapp.v1.CancelMessageRequest
+	(*AcknowledgeMessageRequest)(nil),     // 18: chacerapp.v1.AcknowledgeMessageRequest
+	(*GenerateMessageRequest)(nil),        // 19: chacerapp.v1.GenerateMessageRequest
+	(*ListScheduledMessagesRequest)(nil),  // 20: chacerapp.v1.ListScheduledMessagesRequest
+	(*ListScheduledMessagesResponse)(nil), // 21: chacerapp.v1.ListScheduledMessagesResponse
+	(*CreateScheduledMessageRequest)(nil), // 22: chacerapp.v1.CreateScheduledMessageRequest
+	(*GetScheduledMessageRequest)(nil),    // 23: chacerapp.v1.GetScheduledMessageRequest
+	(*PauseScheduledMessageRequest)(nil),  // 24: chacerapp.v1.PauseScheduledMessageRequest
+	(*ResumeScheduledMessageRequest)(nil), // 25: chacerapp.v1.ResumeScheduledMessageRequest
+	(*DeleteScheduledMessageRequest)(nil), // 26: chacerapp.v1.DeleteScheduledMessageRequest
+	(*Message_DisplayConfig)(nil),         // 27: chacerapp.v1.Message.DisplayConfig
+	(*EscalationPolicy)(nil),              // 28: chacerapp.v1.EscalationPolicy
+	(*timestamp.Timestamp)(nil),           // 29: google.protobuf.Timestamp
+	(*duration.Duration)(nil),             // 30: google.protobuf.Duration
+	(EscalationAction)(0),                 // 31: chacerapp.v1.EscalationAction
+	(*color.Color)(nil),                   // 32: google.type.Color
+	(*empty.Empty)(nil),                   // 33: google.protobuf.Empty
 }
 var file_chacerapp_v1_messages_proto_depIdxs = []int32{
-	7,  // 0: chacerapp.v1.Message.display_configuration:type_name -> chacerapp.v1.Message.DisplayConfiguration
-	8,  // 1: chacerapp.v1.Message.create_time:type_name -> google.protobuf.Timestamp
-	8,  // 2: chacerapp.v1.Message.update_time:type_name -> google.protobuf.Timestamp
-	8,  // 3: chacerapp.v1.Message.delete_time:type_name -> google.protobuf.Timestamp
-	0,  // 4: chacerapp.v1.ListMessagesResponse.messages:type_name -> chacerapp.v1.Message
-	0,  // 5: chacerapp.v1.SendMessageRequest.message:type_name -> chacerapp.v1.Message
-	9,  // 6: chacerapp.v1.Message.DisplayConfiguration.background_color:type_name -> google.type.Color
-	9,  // 7: chacerapp.v1.Message.DisplayConfiguration.foreground_color:type_name -> google.type.Color
-	9,  // 8: chacerapp.v1.Message.DisplayConfiguration.border_color:type_name -> google.type.Color
-	1,  // 9: chacerapp.v1.Messenger.ListMessages:input_type -> chacerapp.v1.ListMessagesRequest
-	3,  // 10: chacerapp.v1.Messenger.SendMessage:input_type -> chacerapp.v1.SendMessageRequest
-	6,  // 11: chacerapp.v1.Messenger.GenerateMessage:input_type -> chacerapp.v1.GenerateMessageRequest
-	4,  // 12: chacerapp.v1.Messenger.CompleteMessage:input_type -> chacerapp.v1.CompleteMessageRequest
-	5,  // 13: chacerapp.v1.Messenger.CancelMessage:input_type -> chacerapp.v1.CancelMessageRequest
-	2,  // 14: chacerapp.v1.Messenger.ListMessages:output_type -> chacerapp.v1.ListMessagesResponse
-	0,  // 15: chacerapp.v1.Messenger.SendMessage:output_type -> chacerapp.v1.Message
-	0,  // 16: chacerapp.v1.Messenger.GenerateMessage:output_type -> chacerapp.v1.Message
-	10, // 17: chacerapp.v1.Messenger.CompleteMessage:output_type -> google.protobuf.Empty
-	10, // 18: chacerapp.v1.Messenger.CancelMessage:output_type -> google.protobuf.Empty
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	27, // 0: chacerapp.v1.Message.display_config:type_name -> chacerapp.v1.Message.DisplayConfig
+	8,  // 1: chacerapp.v1.Message.responses:type_name -> chacerapp.v1.MessageResponse
+	9,  // 2: chacerapp.v1.Message.escalations:type_name -> chacerapp.v1.MessageEscalation
+	4,  // 3: chacerapp.v1.Message.state:type_name -> chacerapp.v1.Message.State
+	0,  // 4: chacerapp.v1.Message.priority:type_name -> chacerapp.v1.MessagePriority
+	1,  // 5: chacerapp.v1.Message.claim_mode:type_name -> chacerapp.v1.MessageClaimMode
+	7,  // 6: chacerapp.v1.Message.deliveries:type_name -> chacerapp.v1.MessageDelivery
+	10, // 7: chacerapp.v1.Message.state_changes:type_name -> chacerapp.v1.MessageStateChange
+	28, // 8: chacerapp.v1.Message.escalation_policy:type_name -> chacerapp.v1.EscalationPolicy
+	29, // 9: chacerapp.v1.Message.next_escalation_time:type_name -> google.protobuf.Timestamp
+	29, // 10: chacerapp.v1.Message.expire_time:type_name -> google.protobuf.Timestamp
+	30, // 11: chacerapp.v1.Message.ttl:type_name -> google.protobuf.Duration
+	29, // 12: chacerapp.v1.Message.create_time:type_name -> google.protobuf.Timestamp
+	29, // 13: chacerapp.v1.Message.update_time:type_name -> google.protobuf.Timestamp
+	29, // 14: chacerapp.v1.Message.delete_time:type_name -> google.protobuf.Timestamp
+	5,  // 15: chacerapp.v1.ScheduledMessage.message:type_name -> chacerapp.v1.Message
+	29, // 16: chacerapp.v1.ScheduledMessage.start_time:type_name -> google.protobuf.Timestamp
+	29, // 17: chacerapp.v1.ScheduledMessage.next_send_time:type_name -> google.protobuf.Timestamp
+	29, // 18: chacerapp.v1.ScheduledMessage.last_send_time:type_name -> google.protobuf.Timestamp
+	29, // 19: chacerapp.v1.ScheduledMessage.create_time:type_name -> google.protobuf.Timestamp
+	29, // 20: chacerapp.v1.ScheduledMessage.update_time:type_name -> google.protobuf.Timestamp
+	2,  // 21: chacerapp.v1.MessageDelivery.state:type_name -> chacerapp.v1.MessageDeliveryState
+	3,  // 22: chacerapp.v1.MessageDelivery.response:type_name -> chacerapp.v1.MessageResponseState
+	29, // 23: chacerapp.v1.MessageDelivery.update_time:type_name -> google.protobuf.Timestamp
+	3,  // 24: chacerapp.v1.MessageResponse.state:type_name -> chacerapp.v1.MessageResponseState
+	30, // 25: chacerapp.v1.MessageResponse.eta:type_name -> google.protobuf.Duration
+	29, // 26: chacerapp.v1.MessageResponse.response_time:type_name -> google.protobuf.Timestamp
+	31, // 27: chacerapp.v1.MessageEscalation.action:type_name -> chacerapp.v1.EscalationAction
+	29, // 28: chacerapp.v1.MessageEscalation.escalate_time:type_name -> google.protobuf.Timestamp
+	4,  // 29: chacerapp.v1.MessageStateChange.previous_state:type_name -> chacerapp.v1.Message.State
+	4,  // 30: chacerapp.v1.MessageStateChange.state:type_name -> chacerapp.v1.Message.State
+	29, // 31: chacerapp.v1.MessageStateChange.change_time:type_name -> google.protobuf.Timestamp
+	5,  // 32: chacerapp.v1.ListMessagesResponse.messages:type_name -> chacerapp.v1.Message
+	5,  // 33: chacerapp.v1.WatchMessagesResponse.messages:type_name -> chacerapp.v1.Message
+	5,  // 34: chacerapp.v1.SendMessageRequest.message:type_name -> chacerapp.v1.Message
+	29, // 35: chacerapp.v1.SendMessageRequest.send_time:type_name -> google.protobuf.Timestamp
+	3,  // 36: chacerapp.v1.AcknowledgeMessageRequest.response:type_name -> chacerapp.v1.MessageResponseState
+	30, // 37: chacerapp.v1.AcknowledgeMessageRequest.eta:type_name -> google.protobuf.Duration
+	6,  // 38: chacerapp.v1.ListScheduledMessagesResponse.scheduled_messages:type_name -> chacerapp.v1.ScheduledMessage
+	6,  // 39: chacerapp.v1.CreateScheduledMessageRequest.scheduled_message:type_name -> chacerapp.v1.ScheduledMessage
+	32, // 40: chacerapp.v1.Message.DisplayConfig.background_color:type_name -> google.type.Color
+	32, // 41: chacerapp.v1.Message.DisplayConfig.foreground_color:type_name -> google.type.Color
+	32, // 42: chacerapp.v1.Message.DisplayConfig.border_color:type_name -> google.type.Color
+	11, // 43: chacerapp.v1.Messenger.ListMessages:input_type -> chacerapp.v1.ListMessagesRequest
+	15, // 44: chacerapp.v1.Messenger.SendMessage:input_type -> chacerapp.v1.SendMessageRequest
+	13, // 45: chacerapp.v1.Messenger.WatchMessages:input_type -> chacerapp.v1.WatchMessagesRequest
+	19, // 46: chacerapp.v1.Messenger.GenerateMessage:input_type -> chacerapp.v1.GenerateMessageRequest
+	16, // 47: chacerapp.v1.Messenger.CompleteMessage:input_type -> chacerapp.v1.CompleteMessageRequest
+	17, // 48: chacerapp.v1.Messenger.CancelMessage:input_type -> chacerapp.v1.CancelMessageRequest
+	20, // 49: chacerapp.v1.Messenger.ListScheduledMessages:input_type -> chacerapp.v1.ListScheduledMessagesRequest
+	22, // 50: chacerapp.v1.Messenger.CreateScheduledMessage:input_type -> chacerapp.v1.CreateScheduledMessageRequest
+	23, // 51: chacerapp.v1.Messenger.GetScheduledMessage:input_type -> chacerapp.v1.GetScheduledMessageRequest
+	24, // 52: chacerapp.v1.Messenger.PauseScheduledMessage:input_type -> chacerapp.v1.PauseScheduledMessageRequest
+	25, // 53: chacerapp.v1.Messenger.ResumeScheduledMessage:input_type -> chacerapp.v1.ResumeScheduledMessageRequest
+	26, // 54: chacerapp.v1.Messenger.DeleteScheduledMessage:input_type -> chacerapp.v1.DeleteScheduledMessageRequest
+	18, // 55: chacerapp.v1.Messenger.AcknowledgeMessage:input_type -> chacerapp.v1.AcknowledgeMessageRequest
+	12, // 56: chacerapp.v1.Messenger.ListMessages:output_type -> chacerapp.v1.ListMessagesResponse
+	5,  // 57: chacerapp.v1.Messenger.SendMessage:output_type -> chacerapp.v1.Message
+	14, // 58: chacerapp.v1.Messenger.WatchMessages:output_type -> chacerapp.v1.WatchMessagesResponse
+	5,  // 59: chacerapp.v1.Messenger.GenerateMessage:output_type -> chacerapp.v1.Message
+	33, // 60: chacerapp.v1.Messenger.CompleteMessage:output_type -> google.protobuf.Empty
+	33, // 61: chacerapp.v1.Messenger.CancelMessage:output_type -> google.protobuf.Empty
+	21, // 62: chacerapp.v1.Messenger.ListScheduledMessages:output_type -> chacerapp.v1.ListScheduledMessagesResponse
+	6,  // 63: chacerapp.v1.Messenger.CreateScheduledMessage:output_type -> chacerapp.v1.ScheduledMessage
+	6,  // 64: chacerapp.v1.Messenger.GetScheduledMessage:output_type -> chacerapp.v1.ScheduledMessage
+	6,  // 65: chacerapp.v1.Messenger.PauseScheduledMessage:output_type -> chacerapp.v1.ScheduledMessage
+	6,  // 66: chacerapp.v1.Messenger.ResumeScheduledMessage:output_type -> chacerapp.v1.ScheduledMessage
+	33, // 67: chacerapp.v1.Messenger.DeleteScheduledMessage:output_type -> google.protobuf.Empty
+	5,  // 68: chacerapp.v1.Messenger.AcknowledgeMessage:output_type -> chacerapp.v1.Message
+	56, // [56:69] is the sub-list for method output_type
+	43, // [43:56] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_chacerapp_v1_messages_proto_init() }
@@ -840,6 +2917,11 @@ func file_chacerapp_v1_messages_proto_init() {
 	if File_chacerapp_v1_messages_proto != nil {
 		return
 	}
+	file_chacerapp_iam_v1_annotations_proto_init()
+	file_chacerapp_v1_contacts_proto_init()
+	file_chacerapp_v1_escalations_proto_init()
+	file_chacerapp_v1_locations_proto_init()
+	file_chacerapp_v1_rooms_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_chacerapp_v1_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Message); i {
@@ -853,8 +2935,152 @@ func file_chacerapp_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMessagesRequest); i {
+		file_chacerapp_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageEscalation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageStateChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -865,8 +3091,8 @@ func file_chacerapp_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMessagesResponse); i {
+		file_chacerapp_v1_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -877,8 +3103,8 @@ func file_chacerapp_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMessageRequest); i {
+		file_chacerapp_v1_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledMessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -889,8 +3115,8 @@ func file_chacerapp_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompleteMessageRequest); i {
+		file_chacerapp_v1_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledMessagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -901,8 +3127,8 @@ func file_chacerapp_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelMessageRequest); i {
+		file_chacerapp_v1_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduledMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -913,8 +3139,8 @@ func file_chacerapp_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateMessageRequest); i {
+		file_chacerapp_v1_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -925,8 +3151,44 @@ func file_chacerapp_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message_DisplayConfiguration); i {
+		file_chacerapp_v1_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseScheduledMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeScheduledMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteScheduledMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message_DisplayConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -938,18 +3200,23 @@ func file_chacerapp_v1_messages_proto_init() {
 			}
 		}
 	}
+	file_chacerapp_v1_messages_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Message_ExpireTime)(nil),
+		(*Message_Ttl)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chacerapp_v1_messages_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      5,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_chacerapp_v1_messages_proto_goTypes,
 		DependencyIndexes: file_chacerapp_v1_messages_proto_depIdxs,
+		EnumInfos:         file_chacerapp_v1_messages_proto_enumTypes,
 		MessageInfos:      file_chacerapp_v1_messages_proto_msgTypes,
 	}.Build()
 	File_chacerapp_v1_messages_proto = out.File
@@ -970,10 +3237,28 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MessengerClient interface {
-	// ListMessages will list all the messages in a location.
+	// ListMessages will list all the messages in a location. Messages are listed
+	// with the highest priority first, and then the oldest first.
 	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error)
 	// SendMessage will send a new message to all devices registered in a location.
+	//
+	// A message with a future `send_time` is not sent straight away. It is
+	// kept as a ScheduledMessage that is sent at that time.
+	//
+	// Sending an emergency message also requires the
+	// `messenger.messages.sendEmergency` permission.
+	//
+	// The recipient is expected to be checked in at the location. Depending on
+	// the `presence_check` of the location, the message is sent with a warning
+	// or a FailedPrecondition error is returned when they aren't.
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*Message, error)
+	// WatchMessages will stream the messages of a location. The first response
+	// holds every message that hasn't reached a terminal state, and each
+	// response after it holds the messages whose state or responses changed.
+	// Devices remove a message once it is completed, cancelled or expired. Use
+	// `-` as the location to watch the messages of every location of an
+	// account.
+	WatchMessages(ctx context.Context, in *WatchMessagesRequest, opts ...grpc.CallOption) (Messenger_WatchMessagesClient, error)
 	// Generates a new message based on a pre-configured template.
 	//
 	// The generated message will not be created in the system. The generated
@@ -984,10 +3269,52 @@ type MessengerClient interface {
 	GenerateMessage(ctx context.Context, in *GenerateMessageRequest, opts ...grpc.CallOption) (*Message, error)
 	// CompleteMessage will mark a sent message as completed and remove it
 	// from all devices.
+	//
+	// A NotFound error will be returned when the message does not exist, and a
+	// FailedPrecondition error when it has already been completed, cancelled
+	// or expired.
 	CompleteMessage(ctx context.Context, in *CompleteMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// CancelMessage will mark a sent message as canceled and remove it from
 	// all devices.
+	//
+	// A NotFound error will be returned when the message does not exist, and a
+	// FailedPrecondition error when it has already been completed, cancelled
+	// or expired.
 	CancelMessage(ctx context.Context, in *CancelMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListScheduledMessages will list the scheduled messages of a location
+	// ordered by the time they are next sent.
+	ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error)
+	// CreateScheduledMessage will schedule a message to be sent once or on a
+	// recurring schedule.
+	//
+	// A NotFound error will be returned when the location does not exist.
+	CreateScheduledMessage(ctx context.Context, in *CreateScheduledMessageRequest, opts ...grpc.CallOption) (*ScheduledMessage, error)
+	// GetScheduledMessage will retrieve a scheduled message.
+	//
+	// A NotFound error will be returned when the scheduled message does not exist.
+	GetScheduledMessage(ctx context.Context, in *GetScheduledMessageRequest, opts ...grpc.CallOption) (*ScheduledMessage, error)
+	// PauseScheduledMessage will stop a scheduled message from being sent
+	// until it is resumed.
+	PauseScheduledMessage(ctx context.Context, in *PauseScheduledMessageRequest, opts ...grpc.CallOption) (*ScheduledMessage, error)
+	// ResumeScheduledMessage will send a paused scheduled message again. The
+	// times it would have been sent while it was paused are skipped.
+	ResumeScheduledMessage(ctx context.Context, in *ResumeScheduledMessageRequest, opts ...grpc.CallOption) (*ScheduledMessage, error)
+	// DeleteScheduledMessage will delete a scheduled message. Messages that
+	// have already been sent are not affected.
+	DeleteScheduledMessage(ctx context.Context, in *DeleteScheduledMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// AcknowledgeMessage will record the response of a recipient to a sent
+	// message, such as acknowledging it with the time until they arrive or
+	// declining it.
+	//
+	// Acknowledging a message moves it to ACKNOWLEDGED, and it moves to
+	// DECLINED once every contact it was delivered to has declined it. Until
+	// then a message that nobody has acknowledged is ACTIVE. A NotFound error will be
+	// returned when the message does not exist, and an InvalidArgument error
+	// when the responder isn't a recipient of the message. A
+	// FailedPrecondition error will be returned when the message has already
+	// been completed, cancelled or expired, or when it was sent to any member
+	// of a contact group and another member has already claimed it.
+	AcknowledgeMessage(ctx context.Context, in *AcknowledgeMessageRequest, opts ...grpc.CallOption) (*Message, error)
 }
 
 type messengerClient struct {
@@ -1016,6 +3343,38 @@ func (c *messengerClient) SendMessage(ctx context.Context, in *SendMessageReques
 	return out, nil
 }
 
+func (c *messengerClient) WatchMessages(ctx context.Context, in *WatchMessagesRequest, opts ...grpc.CallOption) (Messenger_WatchMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Messenger_serviceDesc.Streams[0], "/chacerapp.v1.Messenger/WatchMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messengerWatchMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Messenger_WatchMessagesClient interface {
+	Recv() (*WatchMessagesResponse, error)
+	grpc.ClientStream
+}
+
+type messengerWatchMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *messengerWatchMessagesClient) Recv() (*WatchMessagesResponse, error) {
+	m := new(WatchMessagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *messengerClient) GenerateMessage(ctx context.Context, in *GenerateMessageRequest, opts ...grpc.CallOption) (*Message, error) {
 	out := new(Message)
 	err := c.cc.Invoke(ctx, "/chacerapp.v1.Messenger/GenerateMessage", in, out, opts...)
@@ -1043,12 +3402,93 @@ func (c *messengerClient) CancelMessage(ctx context.Context, in *CancelMessageRe
 	return out, nil
 }
 
+func (c *messengerClient) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error) {
+	out := new(ListScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Messenger/ListScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerClient) CreateScheduledMessage(ctx context.Context, in *CreateScheduledMessageRequest, opts ...grpc.CallOption) (*ScheduledMessage, error) {
+	out := new(ScheduledMessage)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Messenger/CreateScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerClient) GetScheduledMessage(ctx context.Context, in *GetScheduledMessageRequest, opts ...grpc.CallOption) (*ScheduledMessage, error) {
+	out := new(ScheduledMessage)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Messenger/GetScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerClient) PauseScheduledMessage(ctx context.Context, in *PauseScheduledMessageRequest, opts ...grpc.CallOption) (*ScheduledMessage, error) {
+	out := new(ScheduledMessage)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Messenger/PauseScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerClient) ResumeScheduledMessage(ctx context.Context, in *ResumeScheduledMessageRequest, opts ...grpc.CallOption) (*ScheduledMessage, error) {
+	out := new(ScheduledMessage)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Messenger/ResumeScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerClient) DeleteScheduledMessage(ctx context.Context, in *DeleteScheduledMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Messenger/DeleteScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerClient) AcknowledgeMessage(ctx context.Context, in *AcknowledgeMessageRequest, opts ...grpc.CallOption) (*Message, error) {
+	out := new(Message)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Messenger/AcknowledgeMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessengerServer is the server API for Messenger service.
 type MessengerServer interface {
-	// ListMessages will list all the messages in a location.
+	// ListMessages will list all the messages in a location. Messages are listed
+	// with the highest priority first, and then the oldest first.
 	ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error)
 	// SendMessage will send a new message to all devices registered in a location.
+	//
+	// A message with a future `send_time` is not sent straight away. It is
+	// kept as a ScheduledMessage that is sent at that time.
+	//
+	// Sending an emergency message also requires the
+	// `messenger.messages.sendEmergency` permission.
+	//
+	// The recipient is expected to be checked in at the location. Depending on
+	// the `presence_check` of the location, the message is sent with a warning
+	// or a FailedPrecondition error is returned when they aren't.
 	SendMessage(context.Context, *SendMessageRequest) (*Message, error)
+	// WatchMessages will stream the messages of a location. The first response
+	// holds every message that hasn't reached a terminal state, and each
+	// response after it holds the messages whose state or responses changed.
+	// Devices remove a message once it is completed, cancelled or expired. Use
+	// `-` as the location to watch the messages of every location of an
+	// account.
+	WatchMessages(*WatchMessagesRequest, Messenger_WatchMessagesServer) error
 	// Generates a new message based on a pre-configured template.
 	//
 	// The generated message will not be created in the system. The generated
@@ -1059,10 +3499,52 @@ type MessengerServer interface {
 	GenerateMessage(context.Context, *GenerateMessageRequest) (*Message, error)
 	// CompleteMessage will mark a sent message as completed and remove it
 	// from all devices.
+	//
+	// A NotFound error will be returned when the message does not exist, and a
+	// FailedPrecondition error when it has already been completed, cancelled
+	// or expired.
 	CompleteMessage(context.Context, *CompleteMessageRequest) (*empty.Empty, error)
 	// CancelMessage will mark a sent message as canceled and remove it from
 	// all devices.
+	//
+	// A NotFound error will be returned when the message does not exist, and a
+	// FailedPrecondition error when it has already been completed, cancelled
+	// or expired.
 	CancelMessage(context.Context, *CancelMessageRequest) (*empty.Empty, error)
+	// ListScheduledMessages will list the scheduled messages of a location
+	// ordered by the time they are next sent.
+	ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error)
+	// CreateScheduledMessage will schedule a message to be sent once or on a
+	// recurring schedule.
+	//
+	// A NotFound error will be returned when the location does not exist.
+	CreateScheduledMessage(context.Context, *CreateScheduledMessageRequest) (*ScheduledMessage, error)
+	// GetScheduledMessage will retrieve a scheduled message.
+	//
+	// A NotFound error will be returned when the scheduled message does not exist.
+	GetScheduledMessage(context.Context, *GetScheduledMessageRequest) (*ScheduledMessage, error)
+	// PauseScheduledMessage will stop a scheduled message from being sent
+	// until it is resumed.
+	PauseScheduledMessage(context.Context, *PauseScheduledMessageRequest) (*ScheduledMessage, error)
+	// ResumeScheduledMessage will send a paused scheduled message again. The
+	// times it would have been sent while it was paused are skipped.
+	ResumeScheduledMessage(context.Context, *ResumeScheduledMessageRequest) (*ScheduledMessage, error)
+	// DeleteScheduledMessage will delete a scheduled message. Messages that
+	// have already been sent are not affected.
+	DeleteScheduledMessage(context.Context, *DeleteScheduledMessageRequest) (*empty.Empty, error)
+	// AcknowledgeMessage will record the response of a recipient to a sent
+	// message, such as acknowledging it with the time until they arrive or
+	// declining it.
+	//
+	// Acknowledging a message moves it to ACKNOWLEDGED, and it moves to
+	// DECLINED once every contact it was delivered to has declined it. Until
+	// then a message that nobody has acknowledged is ACTIVE. A NotFound error will be
+	// returned when the message does not exist, and an InvalidArgument error
+	// when the responder isn't a recipient of the message. A
+	// FailedPrecondition error will be returned when the message has already
+	// been completed, cancelled or expired, or when it was sent to any member
+	// of a contact group and another member has already claimed it.
+	AcknowledgeMessage(context.Context, *AcknowledgeMessageRequest) (*Message, error)
 }
 
 // UnimplementedMessengerServer can be embedded to have forward compatible implementations.
@@ -1075,6 +3557,9 @@ func (*UnimplementedMessengerServer) ListMessages(context.Context, *ListMessages
 func (*UnimplementedMessengerServer) SendMessage(context.Context, *SendMessageRequest) (*Message, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (*UnimplementedMessengerServer) WatchMessages(*WatchMessagesRequest, Messenger_WatchMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMessages not implemented")
+}
 func (*UnimplementedMessengerServer) GenerateMessage(context.Context, *GenerateMessageRequest) (*Message, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateMessage not implemented")
 }
@@ -1084,6 +3569,27 @@ func (*UnimplementedMessengerServer) CompleteMessage(context.Context, *CompleteM
 func (*UnimplementedMessengerServer) CancelMessage(context.Context, *CancelMessageRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelMessage not implemented")
 }
+func (*UnimplementedMessengerServer) ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledMessages not implemented")
+}
+func (*UnimplementedMessengerServer) CreateScheduledMessage(context.Context, *CreateScheduledMessageRequest) (*ScheduledMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledMessage not implemented")
+}
+func (*UnimplementedMessengerServer) GetScheduledMessage(context.Context, *GetScheduledMessageRequest) (*ScheduledMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMessage not implemented")
+}
+func (*UnimplementedMessengerServer) PauseScheduledMessage(context.Context, *PauseScheduledMessageRequest) (*ScheduledMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseScheduledMessage not implemented")
+}
+func (*UnimplementedMessengerServer) ResumeScheduledMessage(context.Context, *ResumeScheduledMessageRequest) (*ScheduledMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeScheduledMessage not implemented")
+}
+func (*UnimplementedMessengerServer) DeleteScheduledMessage(context.Context, *DeleteScheduledMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledMessage not implemented")
+}
+func (*UnimplementedMessengerServer) AcknowledgeMessage(context.Context, *AcknowledgeMessageRequest) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeMessage not implemented")
+}
 
 func RegisterMessengerServer(s *grpc.Server, srv MessengerServer) {
 	s.RegisterService(&_Messenger_serviceDesc, srv)
@@ -1125,6 +3631,27 @@ func _Messenger_SendMessage_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Messenger_WatchMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessengerServer).WatchMessages(m, &messengerWatchMessagesServer{stream})
+}
+
+type Messenger_WatchMessagesServer interface {
+	Send(*WatchMessagesResponse) error
+	grpc.ServerStream
+}
+
+type messengerWatchMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *messengerWatchMessagesServer) Send(m *WatchMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Messenger_GenerateMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateMessageRequest)
 	if err := dec(in); err != nil {
@@ -1179,6 +3706,132 @@ func _Messenger_CancelMessage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Messenger_ListScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerServer).ListScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Messenger/ListScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerServer).ListScheduledMessages(ctx, req.(*ListScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Messenger_CreateScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerServer).CreateScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Messenger/CreateScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerServer).CreateScheduledMessage(ctx, req.(*CreateScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Messenger_GetScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerServer).GetScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Messenger/GetScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerServer).GetScheduledMessage(ctx, req.(*GetScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Messenger_PauseScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerServer).PauseScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Messenger/PauseScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerServer).PauseScheduledMessage(ctx, req.(*PauseScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Messenger_ResumeScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerServer).ResumeScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Messenger/ResumeScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerServer).ResumeScheduledMessage(ctx, req.(*ResumeScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Messenger_DeleteScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerServer).DeleteScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Messenger/DeleteScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerServer).DeleteScheduledMessage(ctx, req.(*DeleteScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Messenger_AcknowledgeMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerServer).AcknowledgeMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Messenger/AcknowledgeMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerServer).AcknowledgeMessage(ctx, req.(*AcknowledgeMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Messenger_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chacerapp.v1.Messenger",
 	HandlerType: (*MessengerServer)(nil),
@@ -1203,7 +3856,41 @@ var _Messenger_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelMessage",
 			Handler:    _Messenger_CancelMessage_Handler,
 		},
+		{
+			MethodName: "ListScheduledMessages",
+			Handler:    _Messenger_ListScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CreateScheduledMessage",
+			Handler:    _Messenger_CreateScheduledMessage_Handler,
+		},
+		{
+			MethodName: "GetScheduledMessage",
+			Handler:    _Messenger_GetScheduledMessage_Handler,
+		},
+		{
+			MethodName: "PauseScheduledMessage",
+			Handler:    _Messenger_PauseScheduledMessage_Handler,
+		},
+		{
+			MethodName: "ResumeScheduledMessage",
+			Handler:    _Messenger_ResumeScheduledMessage_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledMessage",
+			Handler:    _Messenger_DeleteScheduledMessage_Handler,
+		},
+		{
+			MethodName: "AcknowledgeMessage",
+			Handler:    _Messenger_AcknowledgeMessage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMessages",
+			Handler:       _Messenger_WatchMessages_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chacerapp/v1/messages.proto",
 }
@@ -33,6 +33,75 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// RoomState is the operational state of a room.
+//
+// The states a room can move between are:
+//
+//	AVAILABLE      -> OCCUPIED, OUT_OF_SERVICE
+//	OCCUPIED       -> AVAILABLE, NEEDS_CLEANING, OUT_OF_SERVICE
+//	NEEDS_CLEANING -> AVAILABLE, OUT_OF_SERVICE
+//	OUT_OF_SERVICE -> AVAILABLE, NEEDS_CLEANING
+type RoomState int32
+
+const (
+	// The state is not specified.
+	RoomState_ROOM_STATE_UNSPECIFIED RoomState = 0
+	// The room is free to be used.
+	RoomState_ROOM_STATE_AVAILABLE RoomState = 1
+	// The room is being used.
+	RoomState_ROOM_STATE_OCCUPIED RoomState = 2
+	// The room has been used and must be cleaned before it's available.
+	RoomState_ROOM_STATE_NEEDS_CLEANING RoomState = 3
+	// The room can't be used, e.g. while equipment is being repaired.
+	RoomState_ROOM_STATE_OUT_OF_SERVICE RoomState = 4
+)
+
+// Enum value maps for RoomState.
+var (
+	RoomState_name = map[int32]string{
+		0: "ROOM_STATE_UNSPECIFIED",
+		1: "ROOM_STATE_AVAILABLE",
+		2: "ROOM_STATE_OCCUPIED",
+		3: "ROOM_STATE_NEEDS_CLEANING",
+		4: "ROOM_STATE_OUT_OF_SERVICE",
+	}
+	RoomState_value = map[string]int32{
+		"ROOM_STATE_UNSPECIFIED":    0,
+		"ROOM_STATE_AVAILABLE":      1,
+		"ROOM_STATE_OCCUPIED":       2,
+		"ROOM_STATE_NEEDS_CLEANING": 3,
+		"ROOM_STATE_OUT_OF_SERVICE": 4,
+	}
+)
+
+func (x RoomState) Enum() *RoomState {
+	p := new(RoomState)
+	*p = x
+	return p
+}
+
+func (x RoomState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chacerapp_v1_rooms_proto_enumTypes[0].Descriptor()
+}
+
+func (RoomState) Type() protoreflect.EnumType {
+	return &file_chacerapp_v1_rooms_proto_enumTypes[0]
+}
+
+func (x RoomState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomState.Descriptor instead.
+func (RoomState) EnumDescriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{0}
+}
+
+// A room within a location where contact can be requested.
 type Room struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -48,7 +117,7 @@ type Room struct {
 	//
 	// This value should be at most 64 characters.
 	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// An optional description of this location.
+	// A human friendly description of this room.
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// Arbitrary key/value pairs that can be used to classify or
 	// tag a resource.
@@ -69,6 +138,17 @@ type Room struct {
 	// Annotations are not well documented resources and will have a shorter
 	// deprecation cycle than fields defined on a resource.
 	Annotations map[string]string `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The operational state of the room. New rooms are available. The state is
+	// changed with SetRoomState.
+	State RoomState `protobuf:"varint,6,opt,name=state,proto3,enum=chacerapp.v1.RoomState" json:"state,omitempty"`
+	// The time the state of the room last changed.
+	StateChangeTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=state_change_time,json=stateChangeTime,proto3" json:"state_change_time,omitempty"`
+	// The caller that last changed the state of the room.
+	StateChangedBy string `protobuf:"bytes,8,opt,name=state_changed_by,json=stateChangedBy,proto3" json:"state_changed_by,omitempty"`
+	// The position of the room when rooms that aren't in a room group are
+	// displayed, lowest first. Rooms with the same index are ordered by their
+	// display names, with the numbers in the names compared by their value.
+	SortIndex int32 `protobuf:"varint,9,opt,name=sort_index,json=sortIndex,proto3" json:"sort_index,omitempty"`
 	// Server-defined URL for the resource.
 	SelfLink string `protobuf:"bytes,100,opt,name=self_link,json=selfLink,proto3" json:"self_link,omitempty"`
 	// A unique identifer for the resource.
@@ -148,6 +228,34 @@ func (x *Room) GetAnnotations() map[string]string {
 	return nil
 }
 
+func (x *Room) GetState() RoomState {
+	if x != nil {
+		return x.State
+	}
+	return RoomState_ROOM_STATE_UNSPECIFIED
+}
+
+func (x *Room) GetStateChangeTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StateChangeTime
+	}
+	return nil
+}
+
+func (x *Room) GetStateChangedBy() string {
+	if x != nil {
+		return x.StateChangedBy
+	}
+	return ""
+}
+
+func (x *Room) GetSortIndex() int32 {
+	if x != nil {
+		return x.SortIndex
+	}
+	return 0
+}
+
 func (x *Room) GetSelfLink() string {
 	if x != nil {
 		return x.SelfLink
@@ -183,6 +291,218 @@ func (x *Room) GetDeleteTime() *timestamp.Timestamp {
 	return nil
 }
 
+// A group of rooms within a location, such as the rooms along a hallway or on
+// a floor, used to group the rooms when they are displayed.
+type RoomGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the resource in the format `accounts/*/locations/*/roomGroups/*`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name that should be used when displaying the group. This value
+	// should be at most 64 characters.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// A human friendly description of this group.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The names of the rooms in the group, in the order they should be
+	// displayed. The rooms must be in the same location as the group, and a
+	// room can only be in one group.
+	Rooms []string `protobuf:"bytes,4,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	// The position of the group when the groups of a location are displayed,
+	// lowest first. Groups with the same index are ordered by their display names.
+	SortIndex int32 `protobuf:"varint,5,opt,name=sort_index,json=sortIndex,proto3" json:"sort_index,omitempty"`
+	// Server-defined URL for the resource.
+	SelfLink string `protobuf:"bytes,100,opt,name=self_link,json=selfLink,proto3" json:"self_link,omitempty"`
+	// A unique identifier for the group.
+	Uid string `protobuf:"bytes,101,opt,name=uid,proto3" json:"uid,omitempty"`
+	// The time the group was created.
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,102,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the group was updated.
+	UpdateTime *timestamp.Timestamp `protobuf:"bytes,103,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *RoomGroup) Reset() {
+	*x = RoomGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomGroup) ProtoMessage() {}
+
+func (x *RoomGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomGroup.ProtoReflect.Descriptor instead.
+func (*RoomGroup) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RoomGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoomGroup) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RoomGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoomGroup) GetRooms() []string {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *RoomGroup) GetSortIndex() int32 {
+	if x != nil {
+		return x.SortIndex
+	}
+	return 0
+}
+
+func (x *RoomGroup) GetSelfLink() string {
+	if x != nil {
+		return x.SelfLink
+	}
+	return ""
+}
+
+func (x *RoomGroup) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RoomGroup) GetCreateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RoomGroup) GetUpdateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// RoomStateChange is a record of a change to the state of a room.
+type RoomStateChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the resource in the format `accounts/*/locations/*/rooms/*/stateChanges/*`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The state of the room before the change.
+	PreviousState RoomState `protobuf:"varint,2,opt,name=previous_state,json=previousState,proto3,enum=chacerapp.v1.RoomState" json:"previous_state,omitempty"`
+	// The state of the room after the change.
+	State RoomState `protobuf:"varint,3,opt,name=state,proto3,enum=chacerapp.v1.RoomState" json:"state,omitempty"`
+	// The time the state changed.
+	ChangeTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	// The caller that changed the state.
+	ChangedBy string `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+}
+
+func (x *RoomStateChange) Reset() {
+	*x = RoomStateChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomStateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomStateChange) ProtoMessage() {}
+
+func (x *RoomStateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomStateChange.ProtoReflect.Descriptor instead.
+func (*RoomStateChange) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoomStateChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoomStateChange) GetPreviousState() RoomState {
+	if x != nil {
+		return x.PreviousState
+	}
+	return RoomState_ROOM_STATE_UNSPECIFIED
+}
+
+func (x *RoomStateChange) GetState() RoomState {
+	if x != nil {
+		return x.State
+	}
+	return RoomState_ROOM_STATE_UNSPECIFIED
+}
+
+func (x *RoomStateChange) GetChangeTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ChangeTime
+	}
+	return nil
+}
+
+func (x *RoomStateChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+// List the rooms in a parent.
 type ListRoomsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -199,12 +519,18 @@ type ListRoomsRequest struct {
 	// Specifies a page token to use. Set this to the nextPageToken returned by
 	// previous list requests to get the next page of results.
 	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The order the rooms are listed in. Rooms are listed by name by default.
+	// Use `display` to list the rooms of a single location in the order they
+	// should be displayed: the rooms of each room group, in the order of the
+	// groups, followed by the rooms that aren't in a group ordered by their
+	// `sort_index`.
+	Order string `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (x *ListRoomsRequest) Reset() {
 	*x = ListRoomsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_rooms_proto_msgTypes[1]
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -217,7 +543,7 @@ func (x *ListRoomsRequest) String() string {
 func (*ListRoomsRequest) ProtoMessage() {}
 
 func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_rooms_proto_msgTypes[1]
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,7 +556,7 @@ func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
 func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{1}
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListRoomsRequest) GetParent() string {
@@ -254,6 +580,13 @@ func (x *ListRoomsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListRoomsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
 // ListRoomsResponse will list the rooms for an account.
 type ListRoomsResponse struct {
 	state         protoimpl.MessageState
@@ -267,12 +600,15 @@ type ListRoomsResponse struct {
 	// `next_page_token` as a value for the query parameter `page_token` in the
 	// next request. The value will become empty when there are no more pages.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The room groups of the location in the order they should be displayed.
+	// Only set when the rooms are listed in the `display` order.
+	RoomGroups []*RoomGroup `protobuf:"bytes,3,rep,name=room_groups,json=roomGroups,proto3" json:"room_groups,omitempty"`
 }
 
 func (x *ListRoomsResponse) Reset() {
 	*x = ListRoomsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_rooms_proto_msgTypes[2]
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -285,7 +621,7 @@ func (x *ListRoomsResponse) String() string {
 func (*ListRoomsResponse) ProtoMessage() {}
 
 func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_rooms_proto_msgTypes[2]
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +634,7 @@ func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
 func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{2}
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListRoomsResponse) GetRooms() []*Room {
@@ -315,6 +651,13 @@ func (x *ListRoomsResponse) GetNextPageToken() string {
 	return ""
 }
 
+func (x *ListRoomsResponse) GetRoomGroups() []*RoomGroup {
+	if x != nil {
+		return x.RoomGroups
+	}
+	return nil
+}
+
 // CreateRoomRequest will create a new room.
 type CreateRoomRequest struct {
 	state         protoimpl.MessageState
@@ -325,14 +668,15 @@ type CreateRoomRequest struct {
 	// Specified in the format 'accounts/*/locations/*'.
 	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	// The room that should be created.
-	Room   *Room  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Room *Room `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	// The ID that should be used as the resource ID of the room.
 	RoomId string `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 }
 
 func (x *CreateRoomRequest) Reset() {
 	*x = CreateRoomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_rooms_proto_msgTypes[3]
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +689,7 @@ func (x *CreateRoomRequest) String() string {
 func (*CreateRoomRequest) ProtoMessage() {}
 
 func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_rooms_proto_msgTypes[3]
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +702,7 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
 func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{3}
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateRoomRequest) GetParent() string {
@@ -398,7 +742,7 @@ type UpdateRoomRequest struct {
 func (x *UpdateRoomRequest) Reset() {
 	*x = UpdateRoomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_rooms_proto_msgTypes[4]
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -411,7 +755,7 @@ func (x *UpdateRoomRequest) String() string {
 func (*UpdateRoomRequest) ProtoMessage() {}
 
 func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_rooms_proto_msgTypes[4]
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +768,7 @@ func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{4}
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateRoomRequest) GetRoom() *Room {
@@ -455,7 +799,7 @@ type GetRoomRequest struct {
 func (x *GetRoomRequest) Reset() {
 	*x = GetRoomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_rooms_proto_msgTypes[5]
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -468,7 +812,7 @@ func (x *GetRoomRequest) String() string {
 func (*GetRoomRequest) ProtoMessage() {}
 
 func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_rooms_proto_msgTypes[5]
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +825,7 @@ func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
 func (*GetRoomRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{5}
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetRoomRequest) GetName() string {
@@ -505,7 +849,7 @@ type DeleteRoomRequest struct {
 func (x *DeleteRoomRequest) Reset() {
 	*x = DeleteRoomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chacerapp_v1_rooms_proto_msgTypes[6]
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -518,7 +862,7 @@ func (x *DeleteRoomRequest) String() string {
 func (*DeleteRoomRequest) ProtoMessage() {}
 
 func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chacerapp_v1_rooms_proto_msgTypes[6]
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +875,7 @@ func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
-	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{6}
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteRoomRequest) GetName() string {
@@ -541,159 +885,1097 @@ func (x *DeleteRoomRequest) GetName() string {
 	return ""
 }
 
-var File_chacerapp_v1_rooms_proto protoreflect.FileDescriptor
+// SetRoomStateRequest changes the state of a room.
+type SetRoomStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_chacerapp_v1_rooms_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x72,
-	0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x63, 0x68, 0x61, 0x63,
-	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x22, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
-	0x61, 0x70, 0x70, 0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68,
-	0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x05, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
-	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x6f, 0x6f, 0x6d, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x64,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0,
-	0x41, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40,
-	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x68, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x5f, 0xea, 0x41, 0x5c,
-	0x0a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x34, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x7d, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x6f, 0x6d, 0x7d, 0x2a,
-	0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x32, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x66, 0x0a, 0x10,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x65, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x6f, 0x6f,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65,
-	0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f,
-	0x6f, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6c, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
-	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
-	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x11, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
-	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x73, 0x6b, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x32, 0x91, 0x06, 0x0a, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x9d, 0x01, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
-	0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
-	0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x8a, 0x88, 0x27,
-	0x1a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x94, 0x01, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1f, 0x2e, 0x63, 0x68,
-	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63,
-	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
-	0x22, 0x51, 0x8a, 0x88, 0x27, 0x1c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a,
-	0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x72, 0x6f,
-	0x6f, 0x6d, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6f, 0x6d, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x64, 0x8a, 0x88, 0x27, 0x1c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f,
-	0x6d, 0x73, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x32,
-	0x32, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6e,
-	0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73,
-	0x2f, 0x2a, 0x7d, 0x3a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8b, 0x01,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x61, 0x63,
-	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
-	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x4e, 0x8a, 0x88, 0x27,
-	0x19, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b,
-	0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x51, 0x8a, 0x88, 0x27, 0x1c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x76, 0x31,
+	// The name of the room in the format 'accounts/*/locations/*/rooms/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The state the room should move to.
+	State RoomState `protobuf:"varint,2,opt,name=state,proto3,enum=chacerapp.v1.RoomState" json:"state,omitempty"`
+}
+
+func (x *SetRoomStateRequest) Reset() {
+	*x = SetRoomStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRoomStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomStateRequest) ProtoMessage() {}
+
+func (x *SetRoomStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomStateRequest.ProtoReflect.Descriptor instead.
+func (*SetRoomStateRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetRoomStateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetRoomStateRequest) GetState() RoomState {
+	if x != nil {
+		return x.State
+	}
+	return RoomState_ROOM_STATE_UNSPECIFIED
+}
+
+// ListRoomStateChangesRequest lists the changes to the state of a room.
+type ListRoomStateChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The room to list the state changes of.
+	// Specified in the format 'accounts/*/locations/*/rooms/*'.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The max number of results per page that should be returned. If the number
+	// of available results is larger than `page_size`, a `next_page_token` is
+	// returned which can be used to get the next page of results in subsequent
+	// requests. Acceptable values are 1 to 500, inclusive. (Default: 500)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Specifies a page token to use. Set this to the nextPageToken returned by
+	// previous list requests to get the next page of results.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListRoomStateChangesRequest) Reset() {
+	*x = ListRoomStateChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomStateChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomStateChangesRequest) ProtoMessage() {}
+
+func (x *ListRoomStateChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomStateChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomStateChangesRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListRoomStateChangesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRoomStateChangesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRoomStateChangesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListRoomStateChangesResponse lists the changes to the state of a room.
+type ListRoomStateChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A list of the state changes, with the most recent change first.
+	RoomStateChanges []*RoomStateChange `protobuf:"bytes,1,rep,name=room_state_changes,json=roomStateChanges,proto3" json:"room_state_changes,omitempty"`
+	// This token allows you to get the next page of results for list requests.
+	// If the number of results is larger than `page_size`, use the
+	// `next_page_token` as a value for the query parameter `page_token` in the
+	// next request. The value will become empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListRoomStateChangesResponse) Reset() {
+	*x = ListRoomStateChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomStateChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomStateChangesResponse) ProtoMessage() {}
+
+func (x *ListRoomStateChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomStateChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomStateChangesResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListRoomStateChangesResponse) GetRoomStateChanges() []*RoomStateChange {
+	if x != nil {
+		return x.RoomStateChanges
+	}
+	return nil
+}
+
+func (x *ListRoomStateChangesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// WatchRoomStatesRequest watches the state of the rooms in a location.
+type WatchRoomStatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The location to watch the rooms of.
+	// Specified in the format 'accounts/*/locations/*'.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+}
+
+func (x *WatchRoomStatesRequest) Reset() {
+	*x = WatchRoomStatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRoomStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRoomStatesRequest) ProtoMessage() {}
+
+func (x *WatchRoomStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRoomStatesRequest.ProtoReflect.Descriptor instead.
+func (*WatchRoomStatesRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchRoomStatesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// WatchRoomStatesResponse holds the rooms whose state changed.
+type WatchRoomStatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rooms whose state changed, or every room in the location for the
+	// first response.
+	Rooms []*Room `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+}
+
+func (x *WatchRoomStatesResponse) Reset() {
+	*x = WatchRoomStatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRoomStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRoomStatesResponse) ProtoMessage() {}
+
+func (x *WatchRoomStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRoomStatesResponse.ProtoReflect.Descriptor instead.
+func (*WatchRoomStatesResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchRoomStatesResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+// ListRoomGroupsRequest lists the room groups in a location.
+type ListRoomGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The location to list the room groups of.
+	// Specified in the format 'accounts/*/locations/*'.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The max number of results per page that should be returned. If the number
+	// of available results is larger than `page_size`, a `next_page_token` is
+	// returned which can be used to get the next page of results in subsequent
+	// requests. Acceptable values are 1 to 500, inclusive. (Default: 500)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Specifies a page token to use. Set this to the nextPageToken returned by
+	// previous list requests to get the next page of results.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListRoomGroupsRequest) Reset() {
+	*x = ListRoomGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomGroupsRequest) ProtoMessage() {}
+
+func (x *ListRoomGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRoomGroupsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRoomGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRoomGroupsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListRoomGroupsResponse lists the room groups in a location.
+type ListRoomGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A list of room groups in the order they should be displayed.
+	RoomGroups []*RoomGroup `protobuf:"bytes,1,rep,name=room_groups,json=roomGroups,proto3" json:"room_groups,omitempty"`
+	// This token allows you to get the next page of results for list requests.
+	// If the number of results is larger than `page_size`, use the
+	// `next_page_token` as a value for the query parameter `page_token` in the
+	// next request. The value will become empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListRoomGroupsResponse) Reset() {
+	*x = ListRoomGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomGroupsResponse) ProtoMessage() {}
+
+func (x *ListRoomGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListRoomGroupsResponse) GetRoomGroups() []*RoomGroup {
+	if x != nil {
+		return x.RoomGroups
+	}
+	return nil
+}
+
+func (x *ListRoomGroupsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CreateRoomGroupRequest will create a new room group.
+type CreateRoomGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The location where the room group will be created.
+	// Specified in the format 'accounts/*/locations/*'.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The room group that should be created.
+	RoomGroup *RoomGroup `protobuf:"bytes,2,opt,name=room_group,json=roomGroup,proto3" json:"room_group,omitempty"`
+	// The ID that should be used as the resource ID of the room group.
+	RoomGroupId string `protobuf:"bytes,3,opt,name=room_group_id,json=roomGroupId,proto3" json:"room_group_id,omitempty"`
+}
+
+func (x *CreateRoomGroupRequest) Reset() {
+	*x = CreateRoomGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoomGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomGroupRequest) ProtoMessage() {}
+
+func (x *CreateRoomGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomGroupRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateRoomGroupRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateRoomGroupRequest) GetRoomGroup() *RoomGroup {
+	if x != nil {
+		return x.RoomGroup
+	}
+	return nil
+}
+
+func (x *CreateRoomGroupRequest) GetRoomGroupId() string {
+	if x != nil {
+		return x.RoomGroupId
+	}
+	return ""
+}
+
+// GetRoomGroupRequest retrieves a room group.
+type GetRoomGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the room group to get.
+	// Specified in the format 'accounts/*/locations/*/roomGroups/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetRoomGroupRequest) Reset() {
+	*x = GetRoomGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoomGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomGroupRequest) ProtoMessage() {}
+
+func (x *GetRoomGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomGroupRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetRoomGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UpdateRoomGroupRequest will update an existing room group.
+type UpdateRoomGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The room group resource that should replace the one present on the server.
+	RoomGroup *RoomGroup `protobuf:"bytes,1,opt,name=room_group,json=roomGroup,proto3" json:"room_group,omitempty"`
+	// The update mask applies to the resource. For the `FieldMask` definition,
+	// see https://developers.google.com/protocol-buffers/docs/reference/google.protobuf#fieldmask
+	UpdateMask *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateRoomGroupRequest) Reset() {
+	*x = UpdateRoomGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomGroupRequest) ProtoMessage() {}
+
+func (x *UpdateRoomGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomGroupRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateRoomGroupRequest) GetRoomGroup() *RoomGroup {
+	if x != nil {
+		return x.RoomGroup
+	}
+	return nil
+}
+
+func (x *UpdateRoomGroupRequest) GetUpdateMask() *field_mask.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteRoomGroupRequest deletes a room group.
+type DeleteRoomGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the room group to delete.
+	// Specified in the format 'accounts/*/locations/*/roomGroups/*'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteRoomGroupRequest) Reset() {
+	*x = DeleteRoomGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chacerapp_v1_rooms_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRoomGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomGroupRequest) ProtoMessage() {}
+
+func (x *DeleteRoomGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chacerapp_v1_rooms_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomGroupRequest) Descriptor() ([]byte, []int) {
+	return file_chacerapp_v1_rooms_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteRoomGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_chacerapp_v1_rooms_proto protoreflect.FileDescriptor
+
+var file_chacerapp_v1_rooms_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x07,
+	0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68,
+	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x2e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x2e, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x4c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0e, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x09,
+	0x73, 0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x16, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a,
+	0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x68, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x5e, 0xea, 0x41, 0x5b,
+	0x0a, 0x16, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x34, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x7d, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x6f, 0x6d, 0x7d, 0x2a, 0x05,
+	0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x32, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0xfd, 0x03, 0x0a, 0x09,
+	0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1b, 0xfa,
+	0x41, 0x18, 0x0a, 0x16, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x21, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x4c,
+	0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41,
+	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x67, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x3a, 0x78, 0xea, 0x41, 0x75, 0x0a, 0x1b, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x3f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x72, 0x6f, 0x6f,
+	0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x7d, 0x2a, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x32, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x88, 0x03, 0x0a, 0x0f,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03,
+	0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x3a, 0x78, 0xea, 0x41,
+	0x75, 0x0a, 0x21, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x50, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x72, 0x6f,
+	0x6f, 0x6d, 0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x6f, 0x6d, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x7d, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0x41, 0x01,
+	0x02, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x97, 0x01, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x23, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x45, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x18, 0x0a,
+	0x16, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1f, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x18, 0x0a, 0x16, 0x63, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0x41,
+	0x01, 0x02, 0xfa, 0x41, 0x18, 0x0a, 0x16, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41,
+	0x18, 0x0a, 0x16, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x93, 0x01,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
+	0x0a, 0x12, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x10, 0x72, 0x6f, 0x6f, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x55, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2,
+	0x41, 0x01, 0x02, 0xfa, 0x41, 0x1c, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x17, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22,
+	0x90, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0x41, 0x01, 0x02, 0xfa,
+	0x41, 0x1c, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x7a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x6d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbd,
+	0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0x41, 0x01, 0x02, 0xfa,
+	0x41, 0x1c, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x4f,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x24, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x41, 0x1d, 0x0a, 0x1b, 0x63, 0x68,
+	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x93, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x72,
+	0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x52, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xe2,
+	0x41, 0x01, 0x02, 0xfa, 0x41, 0x1d, 0x0a, 0x1b, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x98, 0x01, 0x0a, 0x09, 0x52, 0x6f,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x4f, 0x4d, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x43, 0x43, 0x55,
+	0x50, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x45, 0x45, 0x44, 0x53, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x43, 0x45, 0x10, 0x04, 0x32, 0x8c, 0x13, 0x0a, 0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0xa6,
+	0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1e, 0x2e, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0xda,
+	0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x8a, 0x88, 0x27, 0x1a, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d,
+	0x73, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76,
+	0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a,
+	0x7d, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x6d, 0xda, 0x41, 0x13,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2c, 0x72, 0x6f, 0x6f, 0x6d, 0x2c, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x8a, 0x88, 0x27, 0x1c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22,
+	0x29, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x6f,
+	0xda, 0x41, 0x10, 0x72, 0x6f, 0x6f, 0x6d, 0x2c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x8a, 0x88, 0x27, 0x1c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x32,
+	0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x12,
+	0x92, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1c, 0x2e, 0x63, 0x68,
+	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x55, 0xda,
+	0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x8a, 0x88, 0x27, 0x19, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e,
+	0x67, 0x65, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6f, 0x6d,
+	0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x58, 0xda, 0x41,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x8a, 0x88, 0x27, 0x1c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x76, 0x31,
 	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f,
 	0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f,
-	0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x42, 0x70, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
-	0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x70, 0x62, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x61,
-	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x61, 0x63,
-	0x65, 0x72, 0x61, 0x70, 0x70, 0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x6c,
+	0xda, 0x41, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x8a, 0x88, 0x27,
+	0x1e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x22, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73,
+	0x2f, 0x2a, 0x7d, 0x3a, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0xd5, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0xda, 0x41,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x8a, 0x88, 0x27, 0x19, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73,
+	0x2e, 0x67, 0x65, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x76, 0x31, 0x2f,
+	0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72,
+	0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0xbf, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
+	0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x62, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x8a, 0x88, 0x27,
+	0x1f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x6c, 0x69, 0x73, 0x74,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x72, 0x6f, 0x6f, 0x6d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0xdc, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x61,
+	0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x89, 0x01, 0xda, 0x41, 0x1f, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x2c, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2c, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x8a, 0x88,
+	0x27, 0x21, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x3a, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x63,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0x5f, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x8a, 0x88, 0x27, 0x1e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72,
+	0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x2f, 0x2a, 0x7d, 0x12, 0xde, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f,
+	0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x8b, 0x01, 0xda, 0x41, 0x16, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x8a, 0x88, 0x27, 0x21, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x3a, 0x0a, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x32, 0x39, 0x2f, 0x76, 0x31, 0x2f, 0x7b,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x62, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x8a,
+	0x88, 0x27, 0x21, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x2a, 0x2e, 0x2f, 0x76, 0x31, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x2a,
+	0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6f,
+	0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xc6, 0x01, 0x0a, 0x0f, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x24,
+	0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0xda, 0x41, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x8a, 0x88, 0x27, 0x1a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2e,
+	0x6c, 0x69, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x76, 0x31, 0x2f,
+	0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x2f, 0x2a, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f,
+	0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x30, 0x01, 0x42, 0x6e, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x63, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0xaa, 0x02, 0x0c, 0x43, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70,
+	0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x43, 0x68, 0x61, 0x63, 0x65, 0x72, 0x61, 0x70, 0x70,
+	0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -708,46 +1990,92 @@ func file_chacerapp_v1_rooms_proto_rawDescGZIP() []byte {
 	return file_chacerapp_v1_rooms_proto_rawDescData
 }
 
-var file_chacerapp_v1_rooms_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_chacerapp_v1_rooms_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_chacerapp_v1_rooms_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_chacerapp_v1_rooms_proto_goTypes = []interface{}{
-	(*Room)(nil),                 // 0: chacerapp.v1.Room
-	(*ListRoomsRequest)(nil),     // 1: chacerapp.v1.ListRoomsRequest
-	(*ListRoomsResponse)(nil),    // 2: chacerapp.v1.ListRoomsResponse
-	(*CreateRoomRequest)(nil),    // 3: chacerapp.v1.CreateRoomRequest
-	(*UpdateRoomRequest)(nil),    // 4: chacerapp.v1.UpdateRoomRequest
-	(*GetRoomRequest)(nil),       // 5: chacerapp.v1.GetRoomRequest
-	(*DeleteRoomRequest)(nil),    // 6: chacerapp.v1.DeleteRoomRequest
-	nil,                          // 7: chacerapp.v1.Room.LabelsEntry
-	nil,                          // 8: chacerapp.v1.Room.AnnotationsEntry
-	(*timestamp.Timestamp)(nil),  // 9: google.protobuf.Timestamp
-	(*field_mask.FieldMask)(nil), // 10: google.protobuf.FieldMask
-	(*empty.Empty)(nil),          // 11: google.protobuf.Empty
+	(RoomState)(0),                       // 0: chacerapp.v1.RoomState
+	(*Room)(nil),                         // 1: chacerapp.v1.Room
+	(*RoomGroup)(nil),                    // 2: chacerapp.v1.RoomGroup
+	(*RoomStateChange)(nil),              // 3: chacerapp.v1.RoomStateChange
+	(*ListRoomsRequest)(nil),             // 4: chacerapp.v1.ListRoomsRequest
+	(*ListRoomsResponse)(nil),            // 5: chacerapp.v1.ListRoomsResponse
+	(*CreateRoomRequest)(nil),            // 6: chacerapp.v1.CreateRoomRequest
+	(*UpdateRoomRequest)(nil),            // 7: chacerapp.v1.UpdateRoomRequest
+	(*GetRoomRequest)(nil),               // 8: chacerapp.v1.GetRoomRequest
+	(*DeleteRoomRequest)(nil),            // 9: chacerapp.v1.DeleteRoomRequest
+	(*SetRoomStateRequest)(nil),          // 10: chacerapp.v1.SetRoomStateRequest
+	(*ListRoomStateChangesRequest)(nil),  // 11: chacerapp.v1.ListRoomStateChangesRequest
+	(*ListRoomStateChangesResponse)(nil), // 12: chacerapp.v1.ListRoomStateChangesResponse
+	(*WatchRoomStatesRequest)(nil),       // 13: chacerapp.v1.WatchRoomStatesRequest
+	(*WatchRoomStatesResponse)(nil),      // 14: chacerapp.v1.WatchRoomStatesResponse
+	(*ListRoomGroupsRequest)(nil),        // 15: chacerapp.v1.ListRoomGroupsRequest
+	(*ListRoomGroupsResponse)(nil),       // 16: chacerapp.v1.ListRoomGroupsResponse
+	(*CreateRoomGroupRequest)(nil),       // 17: chacerapp.v1.CreateRoomGroupRequest
+	(*GetRoomGroupRequest)(nil),          // 18: chacerapp.v1.GetRoomGroupRequest
+	(*UpdateRoomGroupRequest)(nil),       // 19: chacerapp.v1.UpdateRoomGroupRequest
+	(*DeleteRoomGroupRequest)(nil),       // 20: chacerapp.v1.DeleteRoomGroupRequest
+	nil,                                  // 21: chacerapp.v1.Room.LabelsEntry
+	nil,                                  // 22: chacerapp.v1.Room.AnnotationsEntry
+	(*timestamp.Timestamp)(nil),          // 23: google.protobuf.Timestamp
+	(*field_mask.FieldMask)(nil),         // 24: google.protobuf.FieldMask
+	(*empty.Empty)(nil),                  // 25: google.protobuf.Empty
 }
 var file_chacerapp_v1_rooms_proto_depIdxs = []int32{
-	7,  // 0: chacerapp.v1.Room.labels:type_name -> chacerapp.v1.Room.LabelsEntry
-	8,  // 1: chacerapp.v1.Room.annotations:type_name -> chacerapp.v1.Room.AnnotationsEntry
-	9,  // 2: chacerapp.v1.Room.create_time:type_name -> google.protobuf.Timestamp
-	9,  // 3: chacerapp.v1.Room.update_time:type_name -> google.protobuf.Timestamp
-	9,  // 4: chacerapp.v1.Room.delete_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: chacerapp.v1.ListRoomsResponse.rooms:type_name -> chacerapp.v1.Room
-	0,  // 6: chacerapp.v1.CreateRoomRequest.room:type_name -> chacerapp.v1.Room
-	0,  // 7: chacerapp.v1.UpdateRoomRequest.room:type_name -> chacerapp.v1.Room
-	10, // 8: chacerapp.v1.UpdateRoomRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 9: chacerapp.v1.Rooms.ListRooms:input_type -> chacerapp.v1.ListRoomsRequest
-	3,  // 10: chacerapp.v1.Rooms.CreateRoom:input_type -> chacerapp.v1.CreateRoomRequest
-	4,  // 11: chacerapp.v1.Rooms.UpdateRoom:input_type -> chacerapp.v1.UpdateRoomRequest
-	5,  // 12: chacerapp.v1.Rooms.GetRoom:input_type -> chacerapp.v1.GetRoomRequest
-	6,  // 13: chacerapp.v1.Rooms.DeleteRoom:input_type -> chacerapp.v1.DeleteRoomRequest
-	2,  // 14: chacerapp.v1.Rooms.ListRooms:output_type -> chacerapp.v1.ListRoomsResponse
-	0,  // 15: chacerapp.v1.Rooms.CreateRoom:output_type -> chacerapp.v1.Room
-	0,  // 16: chacerapp.v1.Rooms.UpdateRoom:output_type -> chacerapp.v1.Room
-	0,  // 17: chacerapp.v1.Rooms.GetRoom:output_type -> chacerapp.v1.Room
-	11, // 18: chacerapp.v1.Rooms.DeleteRoom:output_type -> google.protobuf.Empty
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	21, // 0: chacerapp.v1.Room.labels:type_name -> chacerapp.v1.Room.LabelsEntry
+	22, // 1: chacerapp.v1.Room.annotations:type_name -> chacerapp.v1.Room.AnnotationsEntry
+	0,  // 2: chacerapp.v1.Room.state:type_name -> chacerapp.v1.RoomState
+	23, // 3: chacerapp.v1.Room.state_change_time:type_name -> google.protobuf.Timestamp
+	23, // 4: chacerapp.v1.Room.create_time:type_name -> google.protobuf.Timestamp
+	23, // 5: chacerapp.v1.Room.update_time:type_name -> google.protobuf.Timestamp
+	23, // 6: chacerapp.v1.Room.delete_time:type_name -> google.protobuf.Timestamp
+	23, // 7: chacerapp.v1.RoomGroup.create_time:type_name -> google.protobuf.Timestamp
+	23, // 8: chacerapp.v1.RoomGroup.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 9: chacerapp.v1.RoomStateChange.previous_state:type_name -> chacerapp.v1.RoomState
+	0,  // 10: chacerapp.v1.RoomStateChange.state:type_name -> chacerapp.v1.RoomState
+	23, // 11: chacerapp.v1.RoomStateChange.change_time:type_name -> google.protobuf.Timestamp
+	1,  // 12: chacerapp.v1.ListRoomsResponse.rooms:type_name -> chacerapp.v1.Room
+	2,  // 13: chacerapp.v1.ListRoomsResponse.room_groups:type_name -> chacerapp.v1.RoomGroup
+	1,  // 14: chacerapp.v1.CreateRoomRequest.room:type_name -> chacerapp.v1.Room
+	1,  // 15: chacerapp.v1.UpdateRoomRequest.room:type_name -> chacerapp.v1.Room
+	24, // 16: chacerapp.v1.UpdateRoomRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 17: chacerapp.v1.SetRoomStateRequest.state:type_name -> chacerapp.v1.RoomState
+	3,  // 18: chacerapp.v1.ListRoomStateChangesResponse.room_state_changes:type_name -> chacerapp.v1.RoomStateChange
+	1,  // 19: chacerapp.v1.WatchRoomStatesResponse.rooms:type_name -> chacerapp.v1.Room
+	2,  // 20: chacerapp.v1.ListRoomGroupsResponse.room_groups:type_name -> chacerapp.v1.RoomGroup
+	2,  // 21: chacerapp.v1.CreateRoomGroupRequest.room_group:type_name -> chacerapp.v1.RoomGroup
+	2,  // 22: chacerapp.v1.UpdateRoomGroupRequest.room_group:type_name -> chacerapp.v1.RoomGroup
+	24, // 23: chacerapp.v1.UpdateRoomGroupRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 24: chacerapp.v1.Rooms.ListRooms:input_type -> chacerapp.v1.ListRoomsRequest
+	6,  // 25: chacerapp.v1.Rooms.CreateRoom:input_type -> chacerapp.v1.CreateRoomRequest
+	7,  // 26: chacerapp.v1.Rooms.UpdateRoom:input_type -> chacerapp.v1.UpdateRoomRequest
+	8,  // 27: chacerapp.v1.Rooms.GetRoom:input_type -> chacerapp.v1.GetRoomRequest
+	9,  // 28: chacerapp.v1.Rooms.DeleteRoom:input_type -> chacerapp.v1.DeleteRoomRequest
+	10, // 29: chacerapp.v1.Rooms.SetRoomState:input_type -> chacerapp.v1.SetRoomStateRequest
+	11, // 30: chacerapp.v1.Rooms.ListRoomStateChanges:input_type -> chacerapp.v1.ListRoomStateChangesRequest
+	15, // 31: chacerapp.v1.Rooms.ListRoomGroups:input_type -> chacerapp.v1.ListRoomGroupsRequest
+	17, // 32: chacerapp.v1.Rooms.CreateRoomGroup:input_type -> chacerapp.v1.CreateRoomGroupRequest
+	18, // 33: chacerapp.v1.Rooms.GetRoomGroup:input_type -> chacerapp.v1.GetRoomGroupRequest
+	19, // 34: chacerapp.v1.Rooms.UpdateRoomGroup:input_type -> chacerapp.v1.UpdateRoomGroupRequest
+	20, // 35: chacerapp.v1.Rooms.DeleteRoomGroup:input_type -> chacerapp.v1.DeleteRoomGroupRequest
+	13, // 36: chacerapp.v1.Rooms.WatchRoomStates:input_type -> chacerapp.v1.WatchRoomStatesRequest
+	5,  // 37: chacerapp.v1.Rooms.ListRooms:output_type -> chacerapp.v1.ListRoomsResponse
+	1,  // 38: chacerapp.v1.Rooms.CreateRoom:output_type -> chacerapp.v1.Room
+	1,  // 39: chacerapp.v1.Rooms.UpdateRoom:output_type -> chacerapp.v1.Room
+	1,  // 40: chacerapp.v1.Rooms.GetRoom:output_type -> chacerapp.v1.Room
+	25, // 41: chacerapp.v1.Rooms.DeleteRoom:output_type -> google.protobuf.Empty
+	1,  // 42: chacerapp.v1.Rooms.SetRoomState:output_type -> chacerapp.v1.Room
+	12, // 43: chacerapp.v1.Rooms.ListRoomStateChanges:output_type -> chacerapp.v1.ListRoomStateChangesResponse
+	16, // 44: chacerapp.v1.Rooms.ListRoomGroups:output_type -> chacerapp.v1.ListRoomGroupsResponse
+	2,  // 45: chacerapp.v1.Rooms.CreateRoomGroup:output_type -> chacerapp.v1.RoomGroup
+	2,  // 46: chacerapp.v1.Rooms.GetRoomGroup:output_type -> chacerapp.v1.RoomGroup
+	2,  // 47: chacerapp.v1.Rooms.UpdateRoomGroup:output_type -> chacerapp.v1.RoomGroup
+	25, // 48: chacerapp.v1.Rooms.DeleteRoomGroup:output_type -> google.protobuf.Empty
+	14, // 49: chacerapp.v1.Rooms.WatchRoomStates:output_type -> chacerapp.v1.WatchRoomStatesResponse
+	37, // [37:50] is the sub-list for method output_type
+	24, // [24:37] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_chacerapp_v1_rooms_proto_init() }
@@ -755,6 +2083,7 @@ func file_chacerapp_v1_rooms_proto_init() {
 	if File_chacerapp_v1_rooms_proto != nil {
 		return
 	}
+	file_chacerapp_v1_locations_proto_init()
 	file_chacerapp_iam_v1_annotations_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_chacerapp_v1_rooms_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -769,8 +2098,116 @@ func file_chacerapp_v1_rooms_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_rooms_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRoomsRequest); i {
+		file_chacerapp_v1_rooms_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomStateChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRoomStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomStateChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -781,8 +2218,8 @@ func file_chacerapp_v1_rooms_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_rooms_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRoomsResponse); i {
+		file_chacerapp_v1_rooms_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomStateChangesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -793,8 +2230,8 @@ func file_chacerapp_v1_rooms_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_rooms_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoomRequest); i {
+		file_chacerapp_v1_rooms_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRoomStatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -805,8 +2242,8 @@ func file_chacerapp_v1_rooms_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_rooms_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoomRequest); i {
+		file_chacerapp_v1_rooms_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRoomStatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -817,8 +2254,8 @@ func file_chacerapp_v1_rooms_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_rooms_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoomRequest); i {
+		file_chacerapp_v1_rooms_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomGroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -829,8 +2266,56 @@ func file_chacerapp_v1_rooms_proto_init() {
 				return nil
 			}
 		}
-		file_chacerapp_v1_rooms_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRoomRequest); i {
+		file_chacerapp_v1_rooms_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoomGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chacerapp_v1_rooms_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRoomGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -847,13 +2332,14 @@ func file_chacerapp_v1_rooms_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chacerapp_v1_rooms_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_chacerapp_v1_rooms_proto_goTypes,
 		DependencyIndexes: file_chacerapp_v1_rooms_proto_depIdxs,
+		EnumInfos:         file_chacerapp_v1_rooms_proto_enumTypes,
 		MessageInfos:      file_chacerapp_v1_rooms_proto_msgTypes,
 	}.Build()
 	File_chacerapp_v1_rooms_proto = out.File
@@ -877,7 +2363,9 @@ type RoomsClient interface {
 	// ListRooms will list all of the rooms in a location on an account.
 	//
 	// An empty result will be returned when the location or account does not
-	// exist or if no rooms exist in the location on the account.
+	// exist or if no rooms exist in the location on the account. Set `order`
+	// to `display` to list the rooms of a location in the order they should be
+	// displayed, grouped by their room groups.
 	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
 	// CreateRoom will create a new room in a location on an account.
 	//
@@ -900,6 +2388,42 @@ type RoomsClient interface {
 	// still exist for a location. To delete a location, fist delete all
 	// rooms for the location. This operation cannot be undone.
 	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// SetRoomState will change the operational state of a room, e.g. when a
+	// patient is seated in it.
+	//
+	// A NotFound error will be returned when a room does not exist. A
+	// FailedPrecondition error will be returned when the room can't move from
+	// its current state to the requested state.
+	SetRoomState(ctx context.Context, in *SetRoomStateRequest, opts ...grpc.CallOption) (*Room, error)
+	// ListRoomStateChanges will list the changes to the state of a room, with
+	// the most recent change first.
+	ListRoomStateChanges(ctx context.Context, in *ListRoomStateChangesRequest, opts ...grpc.CallOption) (*ListRoomStateChangesResponse, error)
+	// ListRoomGroups will list the room groups in a location, in the order they
+	// should be displayed.
+	ListRoomGroups(ctx context.Context, in *ListRoomGroupsRequest, opts ...grpc.CallOption) (*ListRoomGroupsResponse, error)
+	// CreateRoomGroup will create a new room group in a location.
+	//
+	// A NotFound error will be returned when the location does not exist. An
+	// InvalidArgument error will be returned when a member room is not in the
+	// location or is already in another group.
+	CreateRoomGroup(ctx context.Context, in *CreateRoomGroupRequest, opts ...grpc.CallOption) (*RoomGroup, error)
+	// GetRoomGroup will retrieve a room group.
+	//
+	// A NotFound error will be returned when the room group does not exist.
+	GetRoomGroup(ctx context.Context, in *GetRoomGroupRequest, opts ...grpc.CallOption) (*RoomGroup, error)
+	// UpdateRoomGroup will update the properties and member rooms of a room group.
+	//
+	// A NotFound error will be returned when the room group does not exist.
+	UpdateRoomGroup(ctx context.Context, in *UpdateRoomGroupRequest, opts ...grpc.CallOption) (*RoomGroup, error)
+	// DeleteRoomGroup will delete a room group. The member rooms are not deleted.
+	//
+	// A NotFound error will be returned when the room group does not exist.
+	DeleteRoomGroup(ctx context.Context, in *DeleteRoomGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// WatchRoomStates will stream the state of the rooms in a location. The
+	// first response holds every room in the location, and each response after
+	// it holds the rooms whose state changed. Use `-` as the location to watch
+	// the rooms in every location of an account.
+	WatchRoomStates(ctx context.Context, in *WatchRoomStatesRequest, opts ...grpc.CallOption) (Rooms_WatchRoomStatesClient, error)
 }
 
 type roomsClient struct {
@@ -955,12 +2479,109 @@ func (c *roomsClient) DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opt
 	return out, nil
 }
 
+func (c *roomsClient) SetRoomState(ctx context.Context, in *SetRoomStateRequest, opts ...grpc.CallOption) (*Room, error) {
+	out := new(Room)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Rooms/SetRoomState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomsClient) ListRoomStateChanges(ctx context.Context, in *ListRoomStateChangesRequest, opts ...grpc.CallOption) (*ListRoomStateChangesResponse, error) {
+	out := new(ListRoomStateChangesResponse)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Rooms/ListRoomStateChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomsClient) ListRoomGroups(ctx context.Context, in *ListRoomGroupsRequest, opts ...grpc.CallOption) (*ListRoomGroupsResponse, error) {
+	out := new(ListRoomGroupsResponse)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Rooms/ListRoomGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomsClient) CreateRoomGroup(ctx context.Context, in *CreateRoomGroupRequest, opts ...grpc.CallOption) (*RoomGroup, error) {
+	out := new(RoomGroup)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Rooms/CreateRoomGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomsClient) GetRoomGroup(ctx context.Context, in *GetRoomGroupRequest, opts ...grpc.CallOption) (*RoomGroup, error) {
+	out := new(RoomGroup)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Rooms/GetRoomGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomsClient) UpdateRoomGroup(ctx context.Context, in *UpdateRoomGroupRequest, opts ...grpc.CallOption) (*RoomGroup, error) {
+	out := new(RoomGroup)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Rooms/UpdateRoomGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomsClient) DeleteRoomGroup(ctx context.Context, in *DeleteRoomGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chacerapp.v1.Rooms/DeleteRoomGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomsClient) WatchRoomStates(ctx context.Context, in *WatchRoomStatesRequest, opts ...grpc.CallOption) (Rooms_WatchRoomStatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Rooms_serviceDesc.Streams[0], "/chacerapp.v1.Rooms/WatchRoomStates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &roomsWatchRoomStatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Rooms_WatchRoomStatesClient interface {
+	Recv() (*WatchRoomStatesResponse, error)
+	grpc.ClientStream
+}
+
+type roomsWatchRoomStatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *roomsWatchRoomStatesClient) Recv() (*WatchRoomStatesResponse, error) {
+	m := new(WatchRoomStatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RoomsServer is the server API for Rooms service.
 type RoomsServer interface {
 	// ListRooms will list all of the rooms in a location on an account.
 	//
 	// An empty result will be returned when the location or account does not
-	// exist or if no rooms exist in the location on the account.
+	// exist or if no rooms exist in the location on the account. Set `order`
+	// to `display` to list the rooms of a location in the order they should be
+	// displayed, grouped by their room groups.
 	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
 	// CreateRoom will create a new room in a location on an account.
 	//
@@ -983,6 +2604,42 @@ type RoomsServer interface {
 	// still exist for a location. To delete a location, fist delete all
 	// rooms for the location. This operation cannot be undone.
 	DeleteRoom(context.Context, *DeleteRoomRequest) (*empty.Empty, error)
+	// SetRoomState will change the operational state of a room, e.g. when a
+	// patient is seated in it.
+	//
+	// A NotFound error will be returned when a room does not exist. A
+	// FailedPrecondition error will be returned when the room can't move from
+	// its current state to the requested state.
+	SetRoomState(context.Context, *SetRoomStateRequest) (*Room, error)
+	// ListRoomStateChanges will list the changes to the state of a room, with
+	// the most recent change first.
+	ListRoomStateChanges(context.Context, *ListRoomStateChangesRequest) (*ListRoomStateChangesResponse, error)
+	// ListRoomGroups will list the room groups in a location, in the order they
+	// should be displayed.
+	ListRoomGroups(context.Context, *ListRoomGroupsRequest) (*ListRoomGroupsResponse, error)
+	// CreateRoomGroup will create a new room group in a location.
+	//
+	// A NotFound error will be returned when the location does not exist. An
+	// InvalidArgument error will be returned when a member room is not in the
+	// location or is already in another group.
+	CreateRoomGroup(context.Context, *CreateRoomGroupRequest) (*RoomGroup, error)
+	// GetRoomGroup will retrieve a room group.
+	//
+	// A NotFound error will be returned when the room group does not exist.
+	GetRoomGroup(context.Context, *GetRoomGroupRequest) (*RoomGroup, error)
+	// UpdateRoomGroup will update the properties and member rooms of a room group.
+	//
+	// A NotFound error will be returned when the room group does not exist.
+	UpdateRoomGroup(context.Context, *UpdateRoomGroupRequest) (*RoomGroup, error)
+	// DeleteRoomGroup will delete a room group. The member rooms are not deleted.
+	//
+	// A NotFound error will be returned when the room group does not exist.
+	DeleteRoomGroup(context.Context, *DeleteRoomGroupRequest) (*empty.Empty, error)
+	// WatchRoomStates will stream the state of the rooms in a location. The
+	// first response holds every room in the location, and each response after
+	// it holds the rooms whose state changed. Use `-` as the location to watch
+	// the rooms in every location of an account.
+	WatchRoomStates(*WatchRoomStatesRequest, Rooms_WatchRoomStatesServer) error
 }
 
 // UnimplementedRoomsServer can be embedded to have forward compatible implementations.
@@ -1004,6 +2661,30 @@ func (*UnimplementedRoomsServer) GetRoom(context.Context, *GetRoomRequest) (*Roo
 func (*UnimplementedRoomsServer) DeleteRoom(context.Context, *DeleteRoomRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
 }
+func (*UnimplementedRoomsServer) SetRoomState(context.Context, *SetRoomStateRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoomState not implemented")
+}
+func (*UnimplementedRoomsServer) ListRoomStateChanges(context.Context, *ListRoomStateChangesRequest) (*ListRoomStateChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomStateChanges not implemented")
+}
+func (*UnimplementedRoomsServer) ListRoomGroups(context.Context, *ListRoomGroupsRequest) (*ListRoomGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomGroups not implemented")
+}
+func (*UnimplementedRoomsServer) CreateRoomGroup(context.Context, *CreateRoomGroupRequest) (*RoomGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoomGroup not implemented")
+}
+func (*UnimplementedRoomsServer) GetRoomGroup(context.Context, *GetRoomGroupRequest) (*RoomGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomGroup not implemented")
+}
+func (*UnimplementedRoomsServer) UpdateRoomGroup(context.Context, *UpdateRoomGroupRequest) (*RoomGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoomGroup not implemented")
+}
+func (*UnimplementedRoomsServer) DeleteRoomGroup(context.Context, *DeleteRoomGroupRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoomGroup not implemented")
+}
+func (*UnimplementedRoomsServer) WatchRoomStates(*WatchRoomStatesRequest, Rooms_WatchRoomStatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRoomStates not implemented")
+}
 
 func RegisterRoomsServer(s *grpc.Server, srv RoomsServer) {
 	s.RegisterService(&_Rooms_serviceDesc, srv)
@@ -1099,6 +2780,153 @@ func _Rooms_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rooms_SetRoomState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoomStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomsServer).SetRoomState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Rooms/SetRoomState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomsServer).SetRoomState(ctx, req.(*SetRoomStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rooms_ListRoomStateChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomStateChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomsServer).ListRoomStateChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Rooms/ListRoomStateChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomsServer).ListRoomStateChanges(ctx, req.(*ListRoomStateChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rooms_ListRoomGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomsServer).ListRoomGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Rooms/ListRoomGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomsServer).ListRoomGroups(ctx, req.(*ListRoomGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rooms_CreateRoomGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomsServer).CreateRoomGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Rooms/CreateRoomGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomsServer).CreateRoomGroup(ctx, req.(*CreateRoomGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rooms_GetRoomGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomsServer).GetRoomGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Rooms/GetRoomGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomsServer).GetRoomGroup(ctx, req.(*GetRoomGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rooms_UpdateRoomGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomsServer).UpdateRoomGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Rooms/UpdateRoomGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomsServer).UpdateRoomGroup(ctx, req.(*UpdateRoomGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rooms_DeleteRoomGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomsServer).DeleteRoomGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chacerapp.v1.Rooms/DeleteRoomGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomsServer).DeleteRoomGroup(ctx, req.(*DeleteRoomGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rooms_WatchRoomStates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRoomStatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoomsServer).WatchRoomStates(m, &roomsWatchRoomStatesServer{stream})
+}
+
+type Rooms_WatchRoomStatesServer interface {
+	Send(*WatchRoomStatesResponse) error
+	grpc.ServerStream
+}
+
+type roomsWatchRoomStatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *roomsWatchRoomStatesServer) Send(m *WatchRoomStatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Rooms_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chacerapp.v1.Rooms",
 	HandlerType: (*RoomsServer)(nil),
@@ -1123,7 +2951,41 @@ var _Rooms_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRoom",
 			Handler:    _Rooms_DeleteRoom_Handler,
 		},
+		{
+			MethodName: "SetRoomState",
+			Handler:    _Rooms_SetRoomState_Handler,
+		},
+		{
+			MethodName: "ListRoomStateChanges",
+			Handler:    _Rooms_ListRoomStateChanges_Handler,
+		},
+		{
+			MethodName: "ListRoomGroups",
+			Handler:    _Rooms_ListRoomGroups_Handler,
+		},
+		{
+			MethodName: "CreateRoomGroup",
+			Handler:    _Rooms_CreateRoomGroup_Handler,
+		},
+		{
+			MethodName: "GetRoomGroup",
+			Handler:    _Rooms_GetRoomGroup_Handler,
+		},
+		{
+			MethodName: "UpdateRoomGroup",
+			Handler:    _Rooms_UpdateRoomGroup_Handler,
+		},
+		{
+			MethodName: "DeleteRoomGroup",
+			Handler:    _Rooms_DeleteRoomGroup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRoomStates",
+			Handler:       _Rooms_WatchRoomStates_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chacerapp/v1/rooms.proto",
 }
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/chacerapp/apiserver/store"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	suspensionReasonsMu sync.RWMutex
+	suspensionReasons   []*serverpb.SuspensionReason
+)
+
+func init() {
+	RegisterSuspensionReason(&serverpb.SuspensionReason{
+		Code:           "Billing",
+		Description:    "The account has an outstanding balance.",
+		SelfReactivate: true,
+	})
+	RegisterSuspensionReason(&serverpb.SuspensionReason{
+		Code:        "Fraud",
+		Description: "The account has been used fraudulently.",
+	})
+	RegisterSuspensionReason(&serverpb.SuspensionReason{
+		Code:            "Abuse",
+		Description:     "The account has been used in a way that violates the terms of service.",
+		ReactivateAfter: ptypes.DurationProto(24 * time.Hour),
+	})
+	RegisterSuspensionReason(&serverpb.SuspensionReason{
+		Code:           "Security",
+		Description:    "The account may have been accessed by someone other than its owner.",
+		SelfReactivate: true,
+	})
+}
+
+// RegisterSuspensionReason adds a reason to the catalog of reasons that an
+// account can be suspended for. It will panic when the reason has no code, a
+// reason with the same code has already been registered, or the reactivation
+// time is not positive.
+func RegisterSuspensionReason(reason *serverpb.SuspensionReason) {
+	if reason.Code == "" {
+		panic("suspension reason must have a code")
+	}
+	if reason.ReactivateAfter != nil {
+		if d, err := ptypes.Duration(reason.ReactivateAfter); err != nil || d <= 0 {
+			panic(fmt.Sprintf("suspension reason %s must reactivate after a positive duration", reason.Code))
+		}
+	}
+
+	suspensionReasonsMu.Lock()
+	defer suspensionReasonsMu.Unlock()
+	for _, existing := range suspensionReasons {
+		if existing.Code == reason.Code {
+			panic(fmt.Sprintf("suspension reason %s is already registered", reason.Code))
+		}
+	}
+	suspensionReasons = append(suspensionReasons, proto.Clone(reason).(*serverpb.SuspensionReason))
+}
+
+// findSuspensionReason returns the reason with the code, or nil when the code
+// is not in the catalog.
+func findSuspensionReason(code string) *serverpb.SuspensionReason {
+	suspensionReasonsMu.RLock()
+	defer suspensionReasonsMu.RUnlock()
+	for _, reason := range suspensionReasons {
+		if reason.Code == code {
+			return reason
+		}
+	}
+	return nil
+}
+
+// suspensionReasonCodes returns the code of every reason in the catalog.
+func suspensionReasonCodes() []string {
+	suspensionReasonsMu.RLock()
+	defer suspensionReasonsMu.RUnlock()
+	codes := make([]string, len(suspensionReasons))
+	for i, reason := range suspensionReasons {
+		codes[i] = reason.Code
+	}
+	return codes
+}
+
+// suspensionExpireTime returns when a suspension for the reason should end.
+// The expire time of the request is used when it is set, otherwise the
+// reactivation time of the reason is used. Nil is returned when the
+// suspension doesn't expire.
+func suspensionExpireTime(req *serverpb.SuspendAccountRequest, now time.Time) *timestamp.Timestamp {
+	if req.ExpireTime != nil {
+		return req.ExpireTime
+	}
+	reason := findSuspensionReason(req.Reason)
+	if reason.GetReactivateAfter() == nil {
+		return nil
+	}
+	after, err := ptypes.Duration(reason.ReactivateAfter)
+	if err != nil {
+		return nil
+	}
+	expires, _ := ptypes.TimestampProto(now.Add(after))
+	return expires
+}
+
+// selfReactivation is a check for accountTransition that only allows the
+// owner of an account to activate it when it is inactive, or when it was
+// suspended for a reason that the owner can resolve themselves.
+func selfReactivation(existing *serverpb.Account) error {
+	switch existing.GetStatus().GetPhase() {
+	case serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE:
+		return nil
+	case serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED:
+		if findSuspensionReason(existing.Status.Reason).GetSelfReactivate() {
+			return nil
+		}
+		return errFailedPrecondition(fmt.Sprintf(
+			"account was suspended for %s and can only be activated by an administrator",
+			existing.Status.Reason,
+		))
+	}
+	return errFailedPrecondition("account can only be activated by an administrator")
+}
+
+func (s *server) ListSuspensionReasons(ctx context.Context, req *serverpb.ListSuspensionReasonsRequest) (*serverpb.ListSuspensionReasonsResponse, error) {
+	suspensionReasonsMu.RLock()
+	defer suspensionReasonsMu.RUnlock()
+	resp := &serverpb.ListSuspensionReasonsResponse{}
+	for _, reason := range suspensionReasons {
+		resp.SuspensionReasons = append(resp.SuspensionReasons, proto.Clone(reason).(*serverpb.SuspensionReason))
+	}
+	return resp, nil
+}
+
+func (s *server) ListAccountSuspensions(ctx context.Context, req *serverpb.ListAccountSuspensionsRequest) (*serverpb.ListAccountSuspensionsResponse, error) {
+	if _, err := name.ParseAccount(req.Parent); err != nil {
+		return nil, err
+	}
+
+	// Validate the pagination request
+	pageInfo, err := s.validatePageableRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	suspensions, err := s.store.ListAccountSuspensions(ctx, req.Parent, store.WithPageInfo(pageInfo), store.WithPageSize(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	// The next page token should only be generated when the number
+	// of results being returned is equal to the page size. The lack
+	// of a next page token is used to determine if a next page exists.
+	if len(suspensions) == int(req.PageSize) {
+		nextPageToken, err = s.store.GenerateNextPageToken(pageInfo, req.PageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverpb.ListAccountSuspensionsResponse{
+		AccountSuspensions: suspensions,
+		NextPageToken:      nextPageToken,
+	}, nil
+}
+
+// Reactivator activates accounts once their temporary suspension has expired.
+type Reactivator struct {
+	store store.Storage
+}
+
+// NewReactivator creates a Reactivator that finds expired suspensions in the
+// storage.
+func NewReactivator(storage store.Storage) *Reactivator {
+	return &Reactivator{store: storage}
+}
+
+// Run will activate the accounts with expired suspensions at the interval
+// until the context is done. Every server can run a Reactivator since an
+// account will only be activated once.
+func (r *Reactivator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		runCtx, cancel := context.WithTimeout(ctx, interval)
+		if err := r.Reactivate(runCtx, time.Now()); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to reactivate accounts: %v", err)
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reactivate activates every account with a suspension that has expired by
+// the given time. The first error will be returned after every account has
+// been attempted.
+func (r *Reactivator) Reactivate(ctx context.Context, now time.Time) error {
+	suspensions, err := r.store.ListExpiredAccountSuspensions(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, suspension := range suspensions {
+		suspensionName, err := name.ParseAccountSuspensionName(suspension.Name)
+		if err != nil {
+			return err
+		}
+		account := suspensionName.Parent().String()
+
+		// An account that is still SUSPENDING will be reactivated once its
+		// suspension has completed, and the expire time is checked again in
+		// case the account was suspended again since it was listed
+		_, err = r.store.UpdateAccountStatus(ctx, account, &serverpb.AccountStatus{
+			Phase: serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE,
+		}, accountTransition(serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE, func(existing *serverpb.Account) error {
+			expires, err := ptypes.Timestamp(existing.GetStatus().GetExpireTime())
+			if err != nil || expires.After(now) {
+				return errFailedPrecondition("suspension has not expired")
+			}
+			return nil
+		}), store.WithSuspensionEnd(serverpb.SuspensionEnd_SUSPENSION_END_EXPIRED))
+		if err != nil && status.Code(err) != codes.FailedPrecondition && firstErr == nil {
+			firstErr = fmt.Errorf("failed to reactivate %s: %v", account, err)
+		} else if err == nil {
+			log.Printf("Reactivated %s after its suspension expired", account)
+		}
+	}
+	return firstErr
+}
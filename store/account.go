@@ -24,19 +24,28 @@ type Account interface {
 	ListAccounts(ctx context.Context, opts ...ListOption) ([]*serverpb.Account, error)
 	CreateAccount(ctx context.Context, account *serverpb.Account) (*serverpb.Account, error)
 	UpdateAccount(ctx context.Context, account *serverpb.Account, opts ...UpdateOption) (*serverpb.Account, error)
-	UpdateAccountStatus(ctx context.Context, accountName string, status *serverpb.AccountStatus) (*serverpb.AccountStatus, error)
+	UpdateAccountStatus(ctx context.Context, accountName string, status *serverpb.AccountStatus, opts ...UpdateOption) (*serverpb.AccountStatus, error)
+	GetAccountQuotas(ctx context.Context, accountName string) (*serverpb.AccountQuotas, error)
 	UpdateAccountQuotas(ctx context.Context, accountName string, quotas *serverpb.AccountQuotas) (*serverpb.AccountQuotas, error)
 	DeleteAccount(ctx context.Context, name string) (*serverpb.Account, error)
+	// ListAccountSuspensions will list the suspensions of an account with
+	// the most recent suspension first.
+	ListAccountSuspensions(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.AccountSuspension, error)
+	// ListExpiredAccountSuspensions will list the suspensions that have not
+	// ended and were set to expire at or before the given time.
+	ListExpiredAccountSuspensions(ctx context.Context, now time.Time) ([]*serverpb.AccountSuspension, error)
 }
 
 func (s *store) GetAccount(ctx context.Context, name string) (*serverpb.Account, error) {
-	return doGetAccount(ctx, s.db, name)
+	return doGetAccount(ctx, tracedConn{s.db}, name)
 }
 
 // ListAccounts will list all of the accounts in storage
 func (s *store) ListAccounts(ctx context.Context, opts ...ListOption) ([]*serverpb.Account, error) {
 	options := getListOptions(opts...)
-	rows, err := s.db.Query(paginateQuery(selectAccountBaseQuery+" ORDER BY name", options.pageInfo, options.pageSize))
+	ctx, done := observe(ctx, "ListAccounts", "")
+	rows, err := tracedConn{s.db}.QueryContext(ctx, paginateQuery(selectAccountBaseQuery+" ORDER BY name", options.pageInfo, options.pageSize))
+	done(err)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +68,9 @@ func (s *store) ListAccounts(ctx context.Context, opts ...ListOption) ([]*server
 //
 // Only settable fields are respected when creating an account. All other fields
 // will be discarded or overwritten. The Quotas and Status of the returned account
-// will be guaranteed to be set. If an account with the provided name already exists
-// a nil account will be returned.
+// will be guaranteed to be set. The account will be created in the ACTIVE phase
+// unless the PENDING phase is set in its status. If an account with the provided
+// name already exists a nil account will be returned.
 func (s *store) CreateAccount(ctx context.Context, account *serverpb.Account) (*serverpb.Account, error) {
 	var newAccount *serverpb.Account
 
@@ -70,13 +80,18 @@ func (s *store) CreateAccount(ctx context.Context, account *serverpb.Account) (*
 	}
 
 	// Run in a transaction so we can atomically check if the account already exists
-	err = doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err = doTransaction(ctx, s.db, "CreateAccount", account.Name, func(ctx context.Context, tx tracedConn) error {
 		// Check that the account doesn't already exists, when it does
 		// then we should return without returning an account.
 		if existing, err := doGetAccount(ctx, tx, account.Name); err != nil || existing != nil {
 			return err
 		}
 
+		phase := serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE
+		if account.GetStatus().GetPhase() == serverpb.AccountPhase_ACCOUNT_PHASE_PENDING {
+			phase = serverpb.AccountPhase_ACCOUNT_PHASE_PENDING
+		}
+
 		// Create the new account with all the defaults that should be set
 		newAccount = &serverpb.Account{
 			Name:        account.Name,
@@ -87,7 +102,7 @@ func (s *store) CreateAccount(ctx context.Context, account *serverpb.Account) (*
 				Name: account.Name + "/quotas",
 			},
 			Status: &serverpb.AccountStatus{
-				Phase: serverpb.AccountPhase_ACCOUNT_PHASE_ACTIVE,
+				Phase: phase,
 			},
 		}
 
@@ -116,7 +131,10 @@ func (s *store) CreateAccount(ctx context.Context, account *serverpb.Account) (*
 		return err
 	})
 
-	if err != nil {
+	if isUniqueViolation(err) {
+		// The account was created by a concurrent request after we checked for it
+		return nil, nil
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -124,14 +142,30 @@ func (s *store) CreateAccount(ctx context.Context, account *serverpb.Account) (*
 }
 
 func (s *store) UpdateAccount(ctx context.Context, account *serverpb.Account, opts ...UpdateOption) (*serverpb.Account, error) {
-	return s.doUpdateAccount(ctx, account.Name, func(existing *serverpb.Account) error {
+	return s.doUpdateAccount(ctx, account.Name, func(ctx context.Context, tx tracedConn, existing *serverpb.Account) error {
 		existing.DisplayName = account.DisplayName
 		return nil
 	})
 }
 
-func (s *store) UpdateAccountStatus(ctx context.Context, name string, status *serverpb.AccountStatus) (*serverpb.AccountStatus, error) {
-	updated, err := s.doUpdateAccount(ctx, name, func(existing *serverpb.Account) error {
+// UpdateAccountStatus will replace the status of an account in storage
+//
+// A precondition set with WithPrecondition will be checked against the existing
+// account before the status is replaced. A suspension is recorded when the
+// account moves to the SUSPENDING phase, using the expire time of the status,
+// and the suspension is ended when the account leaves the SUSPENDED phase. The
+// end of the suspension can be set with WithSuspensionEnd.
+func (s *store) UpdateAccountStatus(ctx context.Context, name string, status *serverpb.AccountStatus, opts ...UpdateOption) (*serverpb.AccountStatus, error) {
+	options := getUpdateOptions(opts...)
+	updated, err := s.doUpdateAccount(ctx, name, func(ctx context.Context, tx tracedConn, existing *serverpb.Account) error {
+		if options.precondition != nil {
+			if err := options.precondition(existing); err != nil {
+				return err
+			}
+		}
+		if err := doRecordSuspension(ctx, tx, existing, status, options.suspensionEnd); err != nil {
+			return err
+		}
 		existing.Status.Phase = status.Phase
 		existing.Status.Reason = status.Reason
 		existing.Status.Message = status.Message
@@ -146,9 +180,12 @@ func (s *store) UpdateAccountStatus(ctx context.Context, name string, status *se
 }
 
 func (s *store) UpdateAccountQuotas(ctx context.Context, name string, Quotas *serverpb.AccountQuotas) (*serverpb.AccountQuotas, error) {
-	updated, err := s.doUpdateAccount(ctx, name, func(existing *serverpb.Account) error {
+	updated, err := s.doUpdateAccount(ctx, name, func(ctx context.Context, tx tracedConn, existing *serverpb.Account) error {
 		existing.Quotas.Devices = Quotas.Devices
 		existing.Quotas.Locations = Quotas.Locations
+		existing.Quotas.Rooms = Quotas.Rooms
+		existing.Quotas.RateLimitTier = Quotas.RateLimitTier
+		existing.Quotas.RateLimits = Quotas.RateLimits
 		return nil
 	})
 
@@ -164,21 +201,31 @@ func (s *store) UpdateAccountQuotas(ctx context.Context, name string, Quotas *se
 // If the requested account does not exist a nil account will be returned. Otherwise,
 // the returned account will be the account at the time of deletion. This operation
 // can not be undone.
-func (s *store) DeleteAccount(ctx context.Context, name string) (*serverpb.Account, error) {
+func (s *store) DeleteAccount(ctx context.Context, accountName string) (*serverpb.Account, error) {
 	var account *serverpb.Account
 
+	accountID, err := name.ParseAccount(accountName)
+	if err != nil {
+		return nil, err
+	}
+
 	// Run in a transaction so we can atomically check if the account already exists
-	err := doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err = doTransaction(ctx, s.db, "DeleteAccount", accountName, func(ctx context.Context, tx tracedConn) error {
 		var err error
 		// Check if the account exists
-		if account, err = doGetAccount(ctx, tx, name); err != nil {
+		if account, err = doGetAccount(ctx, tx, accountName); err != nil {
 			return err
 		} else if account == nil {
 			// return nil here so we can indicate the account does not exist in the system
 			return nil
 		}
 
-		_, err = tx.Exec(accountDeleteQuery, name)
+		if _, err = tx.ExecContext(ctx, accountDeleteQuery, accountID); err != nil {
+			return err
+		}
+		// The suspension history is removed with the account so it isn't
+		// inherited by a new account with the same name
+		_, err = tx.ExecContext(ctx, accountSuspensionDeleteQuery, accountID)
 		return err
 	})
 
@@ -195,8 +242,10 @@ func doGetAccount(ctx context.Context, query retriever, accountName string) (*se
 		return nil, err
 	}
 
+	ctx, done := observe(ctx, "GetAccount", name.BuildAccount(accountName))
 	rows := query.QueryRowContext(ctx, selectAccountBaseQuery+` WHERE name = $1`, accountName)
 	account, err := scanAccount(rows)
+	done(err)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -205,7 +254,7 @@ func doGetAccount(ctx context.Context, query retriever, accountName string) (*se
 	return account, nil
 }
 
-func (s *store) doUpdateAccount(ctx context.Context, account string, updater func(existing *serverpb.Account) error) (*serverpb.Account, error) {
+func (s *store) doUpdateAccount(ctx context.Context, account string, updater func(ctx context.Context, tx tracedConn, existing *serverpb.Account) error) (*serverpb.Account, error) {
 	var existing *serverpb.Account
 
 	accountName, err := name.ParseAccount(account)
@@ -213,14 +262,14 @@ func (s *store) doUpdateAccount(ctx context.Context, account string, updater fun
 		return nil, err
 	}
 
-	err = doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err = doTransaction(ctx, s.db, "UpdateAccount", account, func(ctx context.Context, tx tracedConn) error {
 		if existing, err = doGetAccount(ctx, tx, account); err != nil || existing == nil {
 			return err
 		}
 
 		// Override the values in the existing account
 		existing.UpdateTime = ptypes.TimestampNow()
-		if err := updater(existing); err != nil {
+		if err := updater(ctx, tx, existing); err != nil {
 			return err
 		}
 
@@ -240,6 +289,9 @@ func (s *store) doUpdateAccount(ctx context.Context, account string, updater fun
 		_, err = tx.ExecContext(ctx, updateAccountQuery, existing.DisplayName, status, quotas, updated, accountName)
 		return err
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	return existing, nil
 }
@@ -0,0 +1,208 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Audit provides an interface for recording the requests that could change
+// resources. Audit events are append-only, so they can't be changed or deleted
+// once they have been recorded.
+type Audit interface {
+	// CreateAuditEvent will record a new audit event. The name and event
+	// time of the returned event will be set.
+	CreateAuditEvent(ctx context.Context, event *serverpb.AuditEvent) (*serverpb.AuditEvent, error)
+	// ListAuditEvents will list the audit events that match the filter with
+	// the most recent event first.
+	ListAuditEvents(ctx context.Context, filter AuditEventFilter, opts ...ListOption) ([]*serverpb.AuditEvent, error)
+}
+
+// AuditEventFilter limits the audit events that are listed. Filters that
+// are not set match every event.
+type AuditEventFilter struct {
+	// Only match events for resources whose name starts with the prefix.
+	ResourcePrefix string
+	// Only match events of requests made by the actor.
+	Actor string
+	// Only match events that occurred at or after the time.
+	StartTime time.Time
+	// Only match events that occurred before the time.
+	EndTime time.Time
+}
+
+func (s *store) CreateAuditEvent(ctx context.Context, event *serverpb.AuditEvent) (*serverpb.AuditEvent, error) {
+	changes, err := marshalAuditChanges(event.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvent := &serverpb.AuditEvent{
+		Actor:     event.Actor,
+		Method:    event.Method,
+		Resource:  event.Resource,
+		RequestId: event.RequestId,
+		EventTime: ptypes.TimestampNow(),
+		Code:      event.Code,
+		Changes:   event.Changes,
+	}
+	eventTime, err := ptypes.Timestamp(newEvent.EventTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var id string
+	ctx, done := observe(ctx, "CreateAuditEvent", event.Resource)
+	err = tracedConn{s.db}.QueryRowContext(
+		ctx,
+		auditEventInsertQuery,
+		eventTime,
+		newEvent.Actor,
+		newEvent.Method,
+		newEvent.Resource,
+		newEvent.RequestId,
+		newEvent.Code,
+		changes,
+	).Scan(&id)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvent.Name = name.AuditEventName{AuditEvent: id}.String()
+	return newEvent, nil
+}
+
+func (s *store) ListAuditEvents(ctx context.Context, filter AuditEventFilter, opts ...ListOption) ([]*serverpb.AuditEvent, error) {
+	options := getListOptions(opts...)
+
+	// Build the conditions from the filters that are set
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, strings.Replace(condition, "?", "$"+strconv.Itoa(len(args)), 1))
+	}
+	if filter.ResourcePrefix != "" {
+		addCondition("resource LIKE ?", escapeLike(filter.ResourcePrefix)+"%")
+	}
+	if filter.Actor != "" {
+		addCondition("actor = ?", filter.Actor)
+	}
+	if !filter.StartTime.IsZero() {
+		addCondition("event_time >= ?", filter.StartTime.UTC())
+	}
+	if !filter.EndTime.IsZero() {
+		addCondition("event_time < ?", filter.EndTime.UTC())
+	}
+
+	query := auditEventSelectBaseQuery
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	ctx, done := observe(ctx, "ListAuditEvents", filter.ResourcePrefix)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, paginateQuery(query+" ORDER BY event_time DESC, id", options.pageInfo, options.pageSize), args...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var events []*serverpb.AuditEvent
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func scanAuditEvent(scan scanner) (*serverpb.AuditEvent, error) {
+	// Allocate all the variables we will need to scan
+	var id, actor, method, resource, code string
+	var requestID, changes sql.NullString
+	var eventTime time.Time
+	// Scan the row from the database
+	if err := scan.Scan(&id, &eventTime, &actor, &method, &resource, &requestID, &code, &changes); err != nil {
+		return nil, err
+	}
+
+	occurred, err := ptypes.TimestampProto(eventTime)
+	if err != nil {
+		return nil, err
+	}
+	changesProtobuf, err := unmarshalAuditChanges(changes.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serverpb.AuditEvent{
+		Name:      name.AuditEventName{AuditEvent: id}.String(),
+		Actor:     actor,
+		Method:    method,
+		Resource:  resource,
+		RequestId: requestID.String,
+		EventTime: occurred,
+		Code:      code,
+		Changes:   changesProtobuf,
+	}, nil
+}
+
+// marshalAuditChanges converts the changes into a JSON array for the changes
+// column.
+func marshalAuditChanges(changes []*serverpb.AuditFieldChange) (string, error) {
+	marshalled := make([]json.RawMessage, len(changes))
+	for i, change := range changes {
+		raw, err := protoMarshaller.MarshalToString(change)
+		if err != nil {
+			return "", err
+		}
+		marshalled[i] = json.RawMessage(raw)
+	}
+	raw, err := json.Marshal(marshalled)
+	return string(raw), err
+}
+
+func unmarshalAuditChanges(raw string) ([]*serverpb.AuditFieldChange, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var marshalled []json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &marshalled); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*serverpb.AuditFieldChange, len(marshalled))
+	for i := range marshalled {
+		changes[i] = &serverpb.AuditFieldChange{}
+		if err := protoUnmarshaller.Unmarshal(strings.NewReader(string(marshalled[i])), changes[i]); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// escapeLike escapes the wildcards in a value that is used in a LIKE pattern.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+const auditEventSelectBaseQuery = `
+SELECT id, event_time, actor, method, resource, request_id, code, changes FROM audit_event`
+
+const auditEventInsertQuery = `
+INSERT INTO audit_event (event_time, actor, method, resource, request_id, code, changes)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id`
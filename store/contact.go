@@ -0,0 +1,308 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lib/pq"
+)
+
+// Contact provides a storage implementation for managing contacts within
+// storage
+type Contact interface {
+	// GetContact will retrieve a Contact by name from storage, along with its
+	// current presence.
+	//
+	// This function will return a nil Contact when a Contact does not exist
+	// with the given name. An error will only be returned when the Contact
+	// failed to be retrieved.
+	GetContact(ctx context.Context, name string) (*serverpb.Contact, error)
+	// ListContacts will list the contacts of an account, along with their
+	// current presence, ordered by their display name.
+	ListContacts(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Contact, error)
+	// CreateContact will create a new contact within an account. A nil
+	// Contact will be returned when a contact with the same name already
+	// exists.
+	CreateContact(ctx context.Context, contact *serverpb.Contact) (*serverpb.Contact, error)
+	// UpdateContact will update the properties of a contact. A nil Contact
+	// will be returned when the contact does not exist.
+	UpdateContact(ctx context.Context, contact *serverpb.Contact, opts ...UpdateOption) (*serverpb.Contact, error)
+	// DeleteContact will delete a contact. A nil Contact will be returned when
+	// the contact does not exist.
+	DeleteContact(ctx context.Context, name string) (*serverpb.Contact, error)
+}
+
+func (s *store) GetContact(ctx context.Context, name string) (*serverpb.Contact, error) {
+	contact, err := doGetContact(ctx, tracedConn{s.db}, name)
+	if err != nil || contact == nil {
+		return nil, err
+	}
+
+	if contact.Presence, err = doGetContactPresence(ctx, tracedConn{s.db}, contact.Name); err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+func (s *store) ListContacts(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Contact, error) {
+	options := getListOptions(opts...)
+
+	accountName, err := name.ParseAccountName(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListContacts", parent)
+	query := paginateQuery(contactSelectBaseQuery+" WHERE account = $1 ORDER BY display_name, name", options.pageInfo, options.pageSize)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, accountName.Account)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := scanContacts(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contact := range contacts {
+		if contact.Presence, err = doGetContactPresence(ctx, tracedConn{s.db}, contact.Name); err != nil {
+			return nil, err
+		}
+	}
+	return contacts, nil
+}
+
+func (s *store) CreateContact(ctx context.Context, contact *serverpb.Contact) (*serverpb.Contact, error) {
+	var newContact *serverpb.Contact
+
+	contactName, err := name.ParseContactName(contact.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = doTransaction(ctx, s.db, "CreateContact", contact.Name, func(ctx context.Context, tx tracedConn) error {
+		if existing, err := doGetContact(ctx, tx, contact.Name); err != nil || existing != nil {
+			return err
+		}
+
+		newContact = &serverpb.Contact{
+			Name:         contact.Name,
+			SelfLink:     serviceName + contact.Name,
+			CreateTime:   ptypes.TimestampNow(),
+			DisplayName:  contact.DisplayName,
+			Labels:       contact.Labels,
+			Annotations:  contact.Annotations,
+			DoNotDisturb: contact.DoNotDisturb,
+		}
+
+		created, err := ptypes.Timestamp(newContact.CreateTime)
+		if err != nil {
+			return err
+		}
+		labels, annotations, err := marshalContactMetadata(newContact)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			contactInsertQuery,
+			contactName.Contact,
+			contactName.Account,
+			newContact.DisplayName,
+			labels,
+			annotations,
+			newContact.DoNotDisturb,
+			created,
+		)
+		return err
+	})
+
+	if isUniqueViolation(err) {
+		// The contact was created by a concurrent request after we checked for it
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newContact, nil
+}
+
+func (s *store) UpdateContact(ctx context.Context, contact *serverpb.Contact, opts ...UpdateOption) (*serverpb.Contact, error) {
+	var existing *serverpb.Contact
+
+	options := getUpdateOptions(opts...)
+
+	err := doTransaction(ctx, s.db, "UpdateContact", contact.Name, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if existing, err = doGetContact(ctx, tx, contact.Name); err != nil || existing == nil {
+			return err
+		}
+
+		merged, err := applyUpdateMask(existing, contact, options.fieldMask)
+		if err != nil {
+			return err
+		}
+
+		mergedContact := merged.(*serverpb.Contact)
+		existing.UpdateTime = ptypes.TimestampNow()
+		existing.DisplayName = mergedContact.DisplayName
+		existing.Labels = mergedContact.Labels
+		existing.Annotations = mergedContact.Annotations
+		existing.DoNotDisturb = mergedContact.DoNotDisturb
+
+		updated, err := ptypes.Timestamp(existing.UpdateTime)
+		if err != nil {
+			return err
+		}
+		labels, annotations, err := marshalContactMetadata(existing)
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, contactUpdateQuery, existing.DisplayName, labels, annotations, existing.DoNotDisturb, updated, existing.Uid); err != nil {
+			return err
+		}
+
+		existing.Presence, err = doGetContactPresence(ctx, tx, existing.Name)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func (s *store) DeleteContact(ctx context.Context, contactName string) (*serverpb.Contact, error) {
+	var contact *serverpb.Contact
+
+	err := doTransaction(ctx, s.db, "DeleteContact", contactName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if contact, err = doGetContact(ctx, tx, contactName); err != nil || contact == nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, contactDeleteQuery, contact.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+// marshalContactMetadata marshals the labels and annotations of a contact to
+// be stored as JSON.
+func marshalContactMetadata(contact *serverpb.Contact) (labels, annotations []byte, err error) {
+	if labels, err = json.Marshal(contact.Labels); err != nil {
+		return nil, nil, err
+	}
+	if annotations, err = json.Marshal(contact.Annotations); err != nil {
+		return nil, nil, err
+	}
+	return labels, annotations, nil
+}
+
+func doGetContact(ctx context.Context, query retriever, fullyQualifiedName string) (*serverpb.Contact, error) {
+	contactName, err := name.ParseContactName(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "GetContact", fullyQualifiedName)
+	row := query.QueryRowContext(ctx, contactSelectBaseQuery+" WHERE account = $1 AND name = $2", contactName.Account, contactName.Contact)
+	contact, err := scanContact(row)
+	done(err)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+func scanContacts(rows *sql.Rows) ([]*serverpb.Contact, error) {
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var contacts []*serverpb.Contact
+	for rows.Next() {
+		contact, err := scanContact(rows)
+		if err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, contact)
+	}
+	return contacts, rows.Err()
+}
+
+func scanContact(scan scanner) (*serverpb.Contact, error) {
+	var uid, contactID, account string
+	var displayName sql.NullString
+	var labels, annotations []byte
+	var doNotDisturb bool
+	var createdTime time.Time
+	var updateTime pq.NullTime
+	if err := scan.Scan(&uid, &contactID, &account, &displayName, &labels, &annotations, &doNotDisturb, &createdTime, &updateTime); err != nil {
+		return nil, err
+	}
+
+	created, err := ptypes.TimestampProto(createdTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *timestamp.Timestamp
+	if updateTime.Valid {
+		updated, err = ptypes.TimestampProto(updateTime.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	contact := &serverpb.Contact{
+		Uid:          uid,
+		Name:         name.ContactName{Account: account, Contact: contactID}.String(),
+		CreateTime:   created,
+		UpdateTime:   updated,
+		DisplayName:  displayName.String,
+		DoNotDisturb: doNotDisturb,
+	}
+	contact.SelfLink = serviceName + contact.Name
+
+	if len(labels) > 0 {
+		if err := json.Unmarshal(labels, &contact.Labels); err != nil {
+			return nil, err
+		}
+	}
+	if len(annotations) > 0 {
+		if err := json.Unmarshal(annotations, &contact.Annotations); err != nil {
+			return nil, err
+		}
+	}
+	return contact, nil
+}
+
+const contactSelectBaseQuery = `
+SELECT id, name, account, display_name, labels, annotations, do_not_disturb, created_time, updated_time FROM contact`
+
+const contactInsertQuery = `
+INSERT INTO contact (name, account, display_name, labels, annotations, do_not_disturb, created_time, updated_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NULL)`
+
+const contactUpdateQuery = `
+UPDATE contact SET display_name = $1, labels = $2, annotations = $3, do_not_disturb = $4, updated_time = $5 WHERE id = $6`
+
+const contactDeleteQuery = `
+DELETE FROM contact WHERE id = $1`
@@ -0,0 +1,362 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lib/pq"
+)
+
+// ContactGroup provides a storage implementation for managing groups of
+// contacts within storage
+type ContactGroup interface {
+	// GetContactGroup will retrieve a ContactGroup by name from storage
+	//
+	// This function will return a nil ContactGroup when a ContactGroup does
+	// not exist with the given name. An error will only be returned when
+	// the ContactGroup failed to be retrieved.
+	GetContactGroup(ctx context.Context, name string) (*serverpb.ContactGroup, error)
+	// ListContactGroups will list the contact groups of an account ordered by
+	// their display name.
+	ListContactGroups(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.ContactGroup, error)
+	// CreateContactGroup will create a new contact group without any members.
+	// A nil ContactGroup will be returned when a group with the same name
+	// already exists.
+	CreateContactGroup(ctx context.Context, group *serverpb.ContactGroup) (*serverpb.ContactGroup, error)
+	// UpdateContactGroup will update the properties of a contact group. The
+	// members are left as they are. A nil ContactGroup will be returned when
+	// the group does not exist.
+	UpdateContactGroup(ctx context.Context, group *serverpb.ContactGroup, opts ...UpdateOption) (*serverpb.ContactGroup, error)
+	DeleteContactGroup(ctx context.Context, name string) (*serverpb.ContactGroup, error)
+	// AddContactGroupMembers will add the contacts that aren't already members
+	// to the end of the members of a group. A nil ContactGroup will be
+	// returned when the group does not exist, and a *ContactGroupFullError
+	// when the group would have more than the limit of members.
+	AddContactGroupMembers(ctx context.Context, name string, members []string, limit int) (*serverpb.ContactGroup, error)
+	// RemoveContactGroupMembers will remove the contacts from the members of a
+	// group. A nil ContactGroup will be returned when the group does not
+	// exist.
+	RemoveContactGroupMembers(ctx context.Context, name string, members []string) (*serverpb.ContactGroup, error)
+}
+
+// ContactGroupFullError is returned when adding members to a contact group
+// would take it over its limit of members.
+type ContactGroupFullError struct {
+	// The name of the group in the format of `accounts/*/contactGroups/*`.
+	ContactGroup string
+	Limit        int
+}
+
+func (e *ContactGroupFullError) Error() string {
+	return fmt.Sprintf("%s can not have more than %d members", e.ContactGroup, e.Limit)
+}
+
+func (s *store) GetContactGroup(ctx context.Context, name string) (*serverpb.ContactGroup, error) {
+	return doGetContactGroup(ctx, tracedConn{s.db}, name)
+}
+
+func (s *store) ListContactGroups(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.ContactGroup, error) {
+	options := getListOptions(opts...)
+
+	accountName, err := name.ParseAccountName(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListContactGroups", parent)
+	query := paginateQuery(contactGroupSelectBaseQuery+" WHERE account = $1 ORDER BY display_name, name", options.pageInfo, options.pageSize)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, accountName.Account)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanContactGroups(rows)
+}
+
+func (s *store) CreateContactGroup(ctx context.Context, group *serverpb.ContactGroup) (*serverpb.ContactGroup, error) {
+	var newGroup *serverpb.ContactGroup
+
+	groupName, err := name.ParseContactGroupName(group.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = doTransaction(ctx, s.db, "CreateContactGroup", group.Name, func(ctx context.Context, tx tracedConn) error {
+		if existing, err := doGetContactGroup(ctx, tx, group.Name); err != nil || existing != nil {
+			return err
+		}
+
+		newGroup = &serverpb.ContactGroup{
+			Name:        group.Name,
+			SelfLink:    serviceName + group.Name,
+			CreateTime:  ptypes.TimestampNow(),
+			DisplayName: group.DisplayName,
+			Description: group.Description,
+		}
+
+		created, err := ptypes.Timestamp(newGroup.CreateTime)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			contactGroupInsertQuery,
+			groupName.ContactGroup,
+			groupName.Account,
+			newGroup.DisplayName,
+			newGroup.Description,
+			created,
+		)
+		return err
+	})
+
+	if isUniqueViolation(err) {
+		// The group was created by a concurrent request after we checked for it
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newGroup, nil
+}
+
+func (s *store) UpdateContactGroup(ctx context.Context, group *serverpb.ContactGroup, opts ...UpdateOption) (*serverpb.ContactGroup, error) {
+	var existing *serverpb.ContactGroup
+
+	options := getUpdateOptions(opts...)
+
+	err := doTransaction(ctx, s.db, "UpdateContactGroup", group.Name, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if existing, err = doGetContactGroup(ctx, tx, group.Name); err != nil || existing == nil {
+			return err
+		}
+
+		merged, err := applyUpdateMask(existing, group, options.fieldMask)
+		if err != nil {
+			return err
+		}
+
+		mergedGroup := merged.(*serverpb.ContactGroup)
+		existing.UpdateTime = ptypes.TimestampNow()
+		existing.DisplayName = mergedGroup.DisplayName
+		existing.Description = mergedGroup.Description
+
+		updated, err := ptypes.Timestamp(existing.UpdateTime)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, contactGroupUpdateQuery, existing.DisplayName, existing.Description, updated, existing.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func (s *store) DeleteContactGroup(ctx context.Context, groupName string) (*serverpb.ContactGroup, error) {
+	var group *serverpb.ContactGroup
+
+	err := doTransaction(ctx, s.db, "DeleteContactGroup", groupName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if group, err = doGetContactGroup(ctx, tx, groupName); err != nil || group == nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, contactGroupDeleteQuery, group.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *store) AddContactGroupMembers(ctx context.Context, groupName string, members []string, limit int) (*serverpb.ContactGroup, error) {
+	return s.updateContactGroupMembers(ctx, "AddContactGroupMembers", groupName, func(group *serverpb.ContactGroup) error {
+		current := map[string]bool{}
+		for _, member := range group.Members {
+			current[member] = true
+		}
+		for _, member := range members {
+			if !current[member] {
+				group.Members = append(group.Members, member)
+				current[member] = true
+			}
+		}
+		if len(group.Members) > limit {
+			return &ContactGroupFullError{ContactGroup: group.Name, Limit: limit}
+		}
+		return nil
+	})
+}
+
+func (s *store) RemoveContactGroupMembers(ctx context.Context, groupName string, members []string) (*serverpb.ContactGroup, error) {
+	return s.updateContactGroupMembers(ctx, "RemoveContactGroupMembers", groupName, func(group *serverpb.ContactGroup) error {
+		removed := map[string]bool{}
+		for _, member := range members {
+			removed[member] = true
+		}
+		remaining := make([]string, 0, len(group.Members))
+		for _, member := range group.Members {
+			if !removed[member] {
+				remaining = append(remaining, member)
+			}
+		}
+		group.Members = remaining
+		return nil
+	})
+}
+
+// updateContactGroupMembers changes the members of a group within a
+// transaction, so members added or removed by concurrent requests aren't
+// lost.
+func (s *store) updateContactGroupMembers(ctx context.Context, op, groupName string, change func(group *serverpb.ContactGroup) error) (*serverpb.ContactGroup, error) {
+	var group *serverpb.ContactGroup
+
+	err := doTransaction(ctx, s.db, op, groupName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if group, err = doGetContactGroup(ctx, tx, groupName); err != nil || group == nil {
+			return err
+		}
+		if err := change(group); err != nil {
+			return err
+		}
+		contactIDs, err := contactGroupMemberIDs(group.Members)
+		if err != nil {
+			return err
+		}
+
+		group.UpdateTime = ptypes.TimestampNow()
+		updated, err := ptypes.Timestamp(group.UpdateTime)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, contactGroupUpdateMembersQuery, pq.Array(contactIDs), updated, group.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// contactGroupMemberIDs returns the IDs of the contacts of a group, which are
+// stored in place of their full names.
+func contactGroupMemberIDs(members []string) ([]string, error) {
+	ids := make([]string, len(members))
+	for i, member := range members {
+		contactName, err := name.ParseContactName(member)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = contactName.Contact
+	}
+	return ids, nil
+}
+
+func doGetContactGroup(ctx context.Context, query retriever, fullyQualifiedName string) (*serverpb.ContactGroup, error) {
+	groupName, err := name.ParseContactGroupName(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "GetContactGroup", fullyQualifiedName)
+	row := query.QueryRowContext(ctx, contactGroupSelectBaseQuery+" WHERE account = $1 AND name = $2", groupName.Account, groupName.ContactGroup)
+	group, err := scanContactGroup(row)
+	done(err)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func scanContactGroups(rows *sql.Rows) ([]*serverpb.ContactGroup, error) {
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var groups []*serverpb.ContactGroup
+	for rows.Next() {
+		group, err := scanContactGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
+func scanContactGroup(scan scanner) (*serverpb.ContactGroup, error) {
+	var uid, groupID, account string
+	var displayName, description sql.NullString
+	var contactIDs []string
+	var createdTime time.Time
+	var updateTime pq.NullTime
+	if err := scan.Scan(&uid, &groupID, &account, &displayName, &description, pq.Array(&contactIDs), &createdTime, &updateTime); err != nil {
+		return nil, err
+	}
+
+	created, err := ptypes.TimestampProto(createdTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *timestamp.Timestamp
+	if updateTime.Valid {
+		updated, err = ptypes.TimestampProto(updateTime.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	members := make([]string, len(contactIDs))
+	for i, contactID := range contactIDs {
+		members[i] = name.ContactName{Account: account, Contact: contactID}.String()
+	}
+
+	fqn := name.ContactGroupName{Account: account, ContactGroup: groupID}.String()
+
+	return &serverpb.ContactGroup{
+		Uid:         uid,
+		Name:        fqn,
+		SelfLink:    serviceName + fqn,
+		CreateTime:  created,
+		UpdateTime:  updated,
+		DisplayName: displayName.String,
+		Description: description.String,
+		Members:     members,
+	}, nil
+}
+
+const contactGroupSelectBaseQuery = `
+SELECT id, name, account, display_name, description, members, created_time, updated_time FROM contact_group`
+
+const contactGroupInsertQuery = `
+INSERT INTO contact_group (name, account, display_name, description, created_time, updated_time)
+VALUES ($1, $2, $3, $4, $5, NULL)`
+
+const contactGroupUpdateQuery = `
+UPDATE contact_group SET display_name = $1, description = $2, updated_time = $3 WHERE id = $4`
+
+const contactGroupUpdateMembersQuery = `
+UPDATE contact_group SET members = $1, updated_time = $2 WHERE id = $3`
+
+const contactGroupDeleteQuery = `
+DELETE FROM contact_group WHERE id = $1`
@@ -0,0 +1,193 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ContactPresence provides a storage implementation for the presence of
+// contacts and the history of changes to it
+type ContactPresence interface {
+	// GetContactPresence will retrieve the presence of a contact from its most
+	// recent change.
+	//
+	// This function will return a nil ContactPresence when the presence of
+	// the contact has never changed.
+	GetContactPresence(ctx context.Context, contact string) (*serverpb.ContactPresence, error)
+	// SetContactPresence will change the presence of a contact and record the
+	// change, along with the state the contact was in before it. A nil
+	// ContactPresence will be returned when the contact does not exist.
+	SetContactPresence(ctx context.Context, contact string, state serverpb.PresenceState, location, changedBy string) (*serverpb.ContactPresence, error)
+	// ListContactPresenceChanges will list the changes to the presence of a
+	// contact with the most recent change first.
+	ListContactPresenceChanges(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.ContactPresenceChange, error)
+}
+
+func (s *store) GetContactPresence(ctx context.Context, contact string) (*serverpb.ContactPresence, error) {
+	return doGetContactPresence(ctx, tracedConn{s.db}, contact)
+}
+
+func (s *store) SetContactPresence(ctx context.Context, contact string, state serverpb.PresenceState, location, changedBy string) (*serverpb.ContactPresence, error) {
+	var presence *serverpb.ContactPresence
+
+	contactName, err := name.ParseContactName(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	var account, locationID sql.NullString
+	if location != "" {
+		locationName, err := name.ParseLocationName(location)
+		if err != nil {
+			return nil, err
+		}
+		account = sql.NullString{String: locationName.Account, Valid: true}
+		locationID = sql.NullString{String: locationName.Location, Valid: true}
+	}
+
+	err = doTransaction(ctx, s.db, "SetContactPresence", contact, func(ctx context.Context, tx tracedConn) error {
+		if existing, err := doGetContact(ctx, tx, contact); err != nil || existing == nil {
+			return err
+		}
+
+		previous, err := doGetContactPresence(ctx, tx, contact)
+		if err != nil {
+			return err
+		}
+		var previousState sql.NullString
+		if previous != nil {
+			previousState = sql.NullString{String: previous.State.String(), Valid: true}
+		}
+
+		presence = &serverpb.ContactPresence{
+			State:      state,
+			Location:   location,
+			ChangeTime: ptypes.TimestampNow(),
+			ChangedBy:  changedBy,
+		}
+		changed, err := ptypes.Timestamp(presence.ChangeTime)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			contactPresenceChangeInsertQuery,
+			contactName.Account,
+			contactName.Contact,
+			previousState,
+			state.String(),
+			account,
+			locationID,
+			changed,
+			changedBy,
+		)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presence, nil
+}
+
+func (s *store) ListContactPresenceChanges(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.ContactPresenceChange, error) {
+	options := getListOptions(opts...)
+
+	contactName, err := name.ParseContactName(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListContactPresenceChanges", parent)
+	query := paginateQuery(contactPresenceChangeSelectBaseQuery+" WHERE contact_account = $1 AND contact = $2 ORDER BY change_time DESC, id", options.pageInfo, options.pageSize)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, contactName.Account, contactName.Contact)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var changes []*serverpb.ContactPresenceChange
+	for rows.Next() {
+		change, err := scanContactPresenceChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+func doGetContactPresence(ctx context.Context, query retriever, contact string) (*serverpb.ContactPresence, error) {
+	contactName, err := name.ParseContactName(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "GetContactPresence", contact)
+	row := query.QueryRowContext(ctx, contactPresenceChangeSelectBaseQuery+" WHERE contact_account = $1 AND contact = $2 ORDER BY change_time DESC, id LIMIT 1", contactName.Account, contactName.Contact)
+	change, err := scanContactPresenceChange(row)
+	done(err)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &serverpb.ContactPresence{
+		State:      change.State,
+		Location:   change.Location,
+		ChangeTime: change.ChangeTime,
+		ChangedBy:  change.ChangedBy,
+	}, nil
+}
+
+func scanContactPresenceChange(scan scanner) (*serverpb.ContactPresenceChange, error) {
+	// Allocate all the variables we will need to scan
+	var id, contactAccount, contact, state string
+	var previousState, account, location, changedBy sql.NullString
+	var changeTime time.Time
+	// Scan the row from the database
+	if err := scan.Scan(&id, &contactAccount, &contact, &previousState, &state, &account, &location, &changeTime, &changedBy); err != nil {
+		return nil, err
+	}
+
+	changed, err := ptypes.TimestampProto(changeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var locationName string
+	if account.Valid && location.Valid {
+		locationName = name.LocationName{Account: account.String, Location: location.String}.String()
+	}
+
+	return &serverpb.ContactPresenceChange{
+		Name: name.ContactPresenceChangeName{
+			Account:        contactAccount,
+			Contact:        contact,
+			PresenceChange: id,
+		}.String(),
+		PreviousState: serverpb.PresenceState(serverpb.PresenceState_value[previousState.String]),
+		State:         serverpb.PresenceState(serverpb.PresenceState_value[state]),
+		Location:      locationName,
+		ChangeTime:    changed,
+		ChangedBy:     changedBy.String,
+	}, nil
+}
+
+const contactPresenceChangeSelectBaseQuery = `
+SELECT id, contact_account, contact, previous_state, state, account, location, change_time, changed_by FROM contact_presence_change`
+
+const contactPresenceChangeInsertQuery = `
+INSERT INTO contact_presence_change (contact_account, contact, previous_state, state, account, location, change_time, changed_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
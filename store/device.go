@@ -0,0 +1,191 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Device provides a storage implementation for managing the devices
+// registered in a location
+type Device interface {
+	// GetDevice will retrieve a Device by name from storage
+	//
+	// This function will return a nil Device when a Device does not exist
+	// with the given name. An error will only be returned when the Device
+	// failed to be retrieved.
+	GetDevice(ctx context.Context, name string) (*serverpb.Device, error)
+	// ListDevices will list the devices registered in a location ordered by
+	// name.
+	ListDevices(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Device, error)
+	// CreateDevice will register a new device. A nil Device will be returned
+	// when a device with the same name already exists. A *QuotaExceededError
+	// will be returned when the account has reached its device quota.
+	CreateDevice(ctx context.Context, device *serverpb.Device) (*serverpb.Device, error)
+	// DeleteDevice will remove a device. A nil Device will be returned when
+	// the device does not exist.
+	DeleteDevice(ctx context.Context, name string) (*serverpb.Device, error)
+}
+
+func (s *store) GetDevice(ctx context.Context, name string) (*serverpb.Device, error) {
+	return doGetDevice(ctx, tracedConn{s.db}, name)
+}
+
+func (s *store) ListDevices(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Device, error) {
+	options := getListOptions(opts...)
+
+	location, err := name.ParseLocationName(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListDevices", parent)
+	query := paginateQuery(deviceSelectBaseQuery+" WHERE account = $1 AND location = $2 ORDER BY name", options.pageInfo, options.pageSize)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, location.Account, location.Location)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var devices []*serverpb.Device
+	for rows.Next() {
+		device, err := scanDevice(rows)
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, device)
+	}
+	return devices, rows.Err()
+}
+
+func (s *store) CreateDevice(ctx context.Context, device *serverpb.Device) (*serverpb.Device, error) {
+	var newDevice *serverpb.Device
+
+	deviceName, err := name.ParseDeviceName(device.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run in a transaction so the quota can't be exceeded by concurrent
+	// registrations
+	err = doTransaction(ctx, s.db, "CreateDevice", device.Name, func(ctx context.Context, tx tracedConn) error {
+		if existing, err := doGetDevice(ctx, tx, device.Name); err != nil || existing != nil {
+			return err
+		}
+		if err := checkQuota(ctx, tx, deviceName.Account, QuotaDevices); err != nil {
+			return err
+		}
+
+		newDevice = &serverpb.Device{
+			Name:        device.Name,
+			SelfLink:    serviceName + device.Name,
+			CreateTime:  ptypes.TimestampNow(),
+			DisplayName: device.DisplayName,
+		}
+
+		created, err := ptypes.Timestamp(newDevice.CreateTime)
+		if err != nil {
+			return err
+		}
+
+		row := tx.QueryRowContext(
+			ctx,
+			deviceInsertQuery,
+			deviceName.Device,
+			deviceName.Account,
+			deviceName.Location,
+			newDevice.DisplayName,
+			created,
+		)
+		return row.Scan(&newDevice.Uid)
+	})
+
+	if isUniqueViolation(err) {
+		// The device was registered by a concurrent request after we checked
+		// for it
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newDevice, nil
+}
+
+func (s *store) DeleteDevice(ctx context.Context, deviceName string) (*serverpb.Device, error) {
+	var device *serverpb.Device
+
+	err := doTransaction(ctx, s.db, "DeleteDevice", deviceName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if device, err = doGetDevice(ctx, tx, deviceName); err != nil || device == nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, deviceDeleteQuery, device.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return device, nil
+}
+
+func doGetDevice(ctx context.Context, query retriever, fullyQualifiedName string) (*serverpb.Device, error) {
+	deviceName, err := name.ParseDeviceName(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "GetDevice", fullyQualifiedName)
+	row := query.QueryRowContext(ctx, deviceSelectBaseQuery+" WHERE account = $1 AND location = $2 AND name = $3", deviceName.Account, deviceName.Location, deviceName.Device)
+	device, err := scanDevice(row)
+	done(err)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+func scanDevice(scan scanner) (*serverpb.Device, error) {
+	var uid, deviceID, account, location string
+	var displayName sql.NullString
+	var createdTime time.Time
+	if err := scan.Scan(&uid, &deviceID, &account, &location, &displayName, &createdTime); err != nil {
+		return nil, err
+	}
+
+	created, err := ptypes.TimestampProto(createdTime)
+	if err != nil {
+		return nil, err
+	}
+
+	fqn := name.DeviceName{Account: account, Location: location, Device: deviceID}.String()
+
+	return &serverpb.Device{
+		Uid:         uid,
+		Name:        fqn,
+		SelfLink:    serviceName + fqn,
+		CreateTime:  created,
+		DisplayName: displayName.String,
+	}, nil
+}
+
+const deviceSelectBaseQuery = `
+SELECT id, name, account, location, display_name, created_time FROM device`
+
+const deviceInsertQuery = `
+INSERT INTO device (name, account, location, display_name, created_time)
+VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+const deviceDeleteQuery = `
+DELETE FROM device WHERE id = $1`
@@ -4,11 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/chacerapp/apiserver/metrics"
 	"github.com/chacerapp/apiserver/name"
 	"github.com/chacerapp/apiserver/server/serverpb"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/lib/pq"
 )
@@ -25,10 +28,13 @@ type Location interface {
 	CreateLocation(ctx context.Context, Location *serverpb.Location) (*serverpb.Location, error)
 	UpdateLocation(ctx context.Context, Location *serverpb.Location, opts ...UpdateOption) (*serverpb.Location, error)
 	DeleteLocation(ctx context.Context, name string) (*serverpb.Location, error)
+	// LocationStats will count the resources within every location that
+	// has at least one room or outstanding message.
+	LocationStats(ctx context.Context) ([]metrics.LocationStats, error)
 }
 
 func (s *store) GetLocation(ctx context.Context, name string) (*serverpb.Location, error) {
-	return doGetLocation(ctx, s.db, name)
+	return doGetLocation(ctx, tracedConn{s.db}, name)
 }
 
 func (s *store) ListLocations(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Location, error) {
@@ -36,13 +42,15 @@ func (s *store) ListLocations(ctx context.Context, parent string, opts ...ListOp
 
 	// Build the query based on the parent given
 	baseQuery := locationSelectBaseQuery
-	if accountName, err := name.ParseAccount(parent); err != nil {
+	if accountName, err := name.ParseAccount(parent, name.AllowWildcard()); err != nil {
 		return nil, err
-	} else if accountName != "-" {
+	} else if accountName != name.Wildcard {
 		baseQuery += fmt.Sprintf(" WHERE account = '%s' ", accountName)
 	}
 
-	rows, err := s.db.Query(paginateQuery(baseQuery+" ORDER BY account, name", options.pageInfo, options.pageSize))
+	ctx, done := observe(ctx, "ListLocations", parent)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, paginateQuery(baseQuery+" ORDER BY account, name", options.pageInfo, options.pageSize))
+	done(err)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +75,8 @@ func (s *store) ListLocations(ctx context.Context, parent string, opts ...ListOp
 // will be discarded or overwritten. The Quotas and Status of the returned location
 // will be guaranteed to be set. If an location with the provided name already exists
 // a nil location will be returned.
+// A *QuotaExceededError will be returned when the account has reached its
+// quota of locations.
 func (s *store) CreateLocation(ctx context.Context, location *serverpb.Location) (*serverpb.Location, error) {
 	var newLocation *serverpb.Location
 
@@ -76,22 +86,43 @@ func (s *store) CreateLocation(ctx context.Context, location *serverpb.Location)
 	}
 
 	// Run in a transaction so we can atomically check if the location already exists
-	err = doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err = doTransaction(ctx, s.db, "CreateLocation", location.Name, func(ctx context.Context, tx tracedConn) error {
 		// Check that the location doesn't already exists, when it does
 		// then we should return without returning an location.
 		if existing, err := doGetLocation(ctx, tx, location.Name); err != nil || existing != nil {
 			return err
 		}
+		if err := checkQuota(ctx, tx, accountName, QuotaLocations); err != nil {
+			return err
+		}
 
 		// Create the new location with all the defaults that should be set
 		newLocation = &serverpb.Location{
-			Name:        location.Name,
-			DisplayName: location.DisplayName,
-			Description: location.Description,
-			CreatedTime: ptypes.TimestampNow(),
-			SelfLink:    serviceName + location.Name,
+			Name:             location.Name,
+			DisplayName:      location.DisplayName,
+			Description:      location.Description,
+			TimeZone:         location.TimeZone,
+			BusinessHours:    location.BusinessHours,
+			Holidays:         location.Holidays,
+			EscalationPolicy: location.EscalationPolicy,
+			MessageTtl:       location.MessageTtl,
+			PresenceCheck:    location.PresenceCheck,
+			CreateTime:       ptypes.TimestampNow(),
+			SelfLink:         serviceName + location.Name,
+		}
+		created, err := ptypes.Timestamp(newLocation.CreateTime)
+		if err != nil {
+			return err
+		}
+		hours, err := marshalLocationHours(newLocation)
+		if err != nil {
+			return err
+		}
+		policy, err := marshalEscalationPolicy(newLocation.EscalationPolicy)
+		if err != nil {
+			return err
 		}
-		created, err := ptypes.Timestamp(newLocation.CreatedTime)
+		ttl, err := marshalMessageTTL(newLocation.MessageTtl)
 		if err != nil {
 			return err
 		}
@@ -103,12 +134,20 @@ func (s *store) CreateLocation(ctx context.Context, location *serverpb.Location)
 			accountName,
 			newLocation.DisplayName,
 			newLocation.Description,
+			newLocation.TimeZone,
+			hours,
+			policy,
+			ttl,
+			marshalPresenceCheck(newLocation.PresenceCheck),
 			created,
 		)
 		return err
 	})
 
-	if err != nil {
+	if isUniqueViolation(err) {
+		// The location was created by a concurrent request after we checked for it
+		return nil, nil
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -121,7 +160,7 @@ func (s *store) UpdateLocation(ctx context.Context, location *serverpb.Location,
 
 	options := getUpdateOptions(opts...)
 
-	err = doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err = doTransaction(ctx, s.db, "UpdateLocation", location.Name, func(ctx context.Context, tx tracedConn) error {
 		if existing, err = doGetLocation(ctx, tx, location.Name); err != nil || existing == nil {
 			return err
 		}
@@ -133,16 +172,34 @@ func (s *store) UpdateLocation(ctx context.Context, location *serverpb.Location,
 
 		mergedLocation := merged.(*serverpb.Location)
 		// Override the values in the existing account
-		existing.UpdatedTime = ptypes.TimestampNow()
+		existing.UpdateTime = ptypes.TimestampNow()
 		existing.DisplayName = mergedLocation.DisplayName
 		existing.Description = mergedLocation.Description
-
-		updated, err := ptypes.Timestamp(existing.UpdatedTime)
+		existing.TimeZone = mergedLocation.TimeZone
+		existing.BusinessHours = mergedLocation.BusinessHours
+		existing.Holidays = mergedLocation.Holidays
+		existing.EscalationPolicy = mergedLocation.EscalationPolicy
+		existing.MessageTtl = mergedLocation.MessageTtl
+		existing.PresenceCheck = mergedLocation.PresenceCheck
+
+		updated, err := ptypes.Timestamp(existing.UpdateTime)
+		if err != nil {
+			return err
+		}
+		hours, err := marshalLocationHours(existing)
+		if err != nil {
+			return err
+		}
+		policy, err := marshalEscalationPolicy(existing.EscalationPolicy)
+		if err != nil {
+			return err
+		}
+		ttl, err := marshalMessageTTL(existing.MessageTtl)
 		if err != nil {
 			return err
 		}
 
-		_, err = tx.ExecContext(ctx, locationUpdateQuery, existing.DisplayName, existing.Description, updated, existing.Name)
+		_, err = tx.ExecContext(ctx, locationUpdateQuery, existing.DisplayName, existing.Description, existing.TimeZone, hours, policy, ttl, marshalPresenceCheck(existing.PresenceCheck), updated, existing.Name)
 		return err
 	})
 
@@ -153,7 +210,7 @@ func (s *store) DeleteLocation(ctx context.Context, name string) (*serverpb.Loca
 	var location *serverpb.Location
 
 	// Run in a transaction so we can atomically check if the location already exists
-	err := doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err := doTransaction(ctx, s.db, "DeleteLocation", name, func(ctx context.Context, tx tracedConn) error {
 		var err error
 		// Check if the location exists
 		if location, err = doGetLocation(ctx, tx, name); err != nil {
@@ -163,7 +220,7 @@ func (s *store) DeleteLocation(ctx context.Context, name string) (*serverpb.Loca
 			return nil
 		}
 
-		_, err = tx.Exec(locationDeleteQuery, name)
+		_, err = tx.ExecContext(ctx, locationDeleteQuery, name)
 		return err
 	})
 
@@ -174,9 +231,33 @@ func (s *store) DeleteLocation(ctx context.Context, name string) (*serverpb.Loca
 	return location, nil
 }
 
+func (s *store) LocationStats(ctx context.Context) ([]metrics.LocationStats, error) {
+	ctx, done := observe(ctx, "LocationStats", "")
+	rows, err := tracedConn{s.db}.QueryContext(ctx, locationStatsQuery, pq.Array(terminalMessageStates))
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var stats []metrics.LocationStats
+	for rows.Next() {
+		var stat metrics.LocationStats
+		if err := rows.Scan(&stat.Account, &stat.Location, &stat.Rooms, &stat.Messages); err != nil {
+			return nil, err
+		}
+		stats = append(stats, stat)
+	}
+	return stats, rows.Err()
+}
+
 func doGetLocation(ctx context.Context, query retriever, name string) (*serverpb.Location, error) {
+	ctx, done := observe(ctx, "GetLocation", name)
 	rows := query.QueryRowContext(ctx, locationSelectBaseQuery+` WHERE name = $1`, name)
 	location, err := scanLocation(rows)
+	done(err)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -188,10 +269,13 @@ func doGetLocation(ctx context.Context, query retriever, name string) (*serverpb
 func scanLocation(scan scanner) (*serverpb.Location, error) {
 	// Allocate all the variables we will need to scan
 	var name, displayName, description string
+	var timeZone, hours, policy sql.NullString
+	var ttl sql.NullInt64
+	var presenceCheck sql.NullString
 	var createdTime time.Time
 	var updatedTime pq.NullTime
 	// Scan the row from the database
-	if err := scan.Scan(&name, &displayName, &description, &createdTime, &updatedTime); err != nil {
+	if err := scan.Scan(&name, &displayName, &description, &timeZone, &hours, &policy, &ttl, &presenceCheck, &createdTime, &updatedTime); err != nil {
 		return nil, err
 	}
 
@@ -208,25 +292,112 @@ func scanLocation(scan scanner) (*serverpb.Location, error) {
 		}
 	}
 
-	return &serverpb.Location{
-		Name:        name,
-		DisplayName: displayName,
-		Description: description,
-		SelfLink:    serviceName + name,
-		CreatedTime: created,
-		UpdatedTime: updated,
-	}, nil
+	location := &serverpb.Location{
+		Name:          name,
+		DisplayName:   displayName,
+		Description:   description,
+		TimeZone:      timeZone.String,
+		PresenceCheck: serverpb.PresenceCheck(serverpb.PresenceCheck_value[presenceCheck.String]),
+		SelfLink:      serviceName + name,
+		CreateTime:    created,
+		UpdateTime:    updated,
+	}
+	if err := unmarshalLocationHours(hours.String, location); err != nil {
+		return nil, err
+	}
+	if location.EscalationPolicy, err = unmarshalEscalationPolicy(policy.String); err != nil {
+		return nil, err
+	}
+	if ttl.Valid {
+		location.MessageTtl = ptypes.DurationProto(time.Duration(ttl.Int64) * time.Second)
+	}
+	return location, nil
+}
+
+// marshalLocationHours converts the business hours and holidays of the location
+// into a JSON object for the hours column.
+func marshalLocationHours(location *serverpb.Location) (string, error) {
+	return protoMarshaller.MarshalToString(&serverpb.Location{
+		BusinessHours: location.BusinessHours,
+		Holidays:      location.Holidays,
+	})
+}
+
+func unmarshalLocationHours(raw string, location *serverpb.Location) error {
+	if raw == "" {
+		return nil
+	}
+	hours := &serverpb.Location{}
+	if err := protoUnmarshaller.Unmarshal(strings.NewReader(raw), hours); err != nil {
+		return err
+	}
+	location.BusinessHours = hours.BusinessHours
+	location.Holidays = hours.Holidays
+	return nil
+}
+
+// marshalEscalationPolicy converts an escalation policy into a JSON object for
+// the escalation_policy column. A location without a policy is stored as NULL.
+func marshalEscalationPolicy(policy *serverpb.EscalationPolicy) (sql.NullString, error) {
+	if policy == nil {
+		return sql.NullString{}, nil
+	}
+	raw, err := protoMarshaller.MarshalToString(policy)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: raw, Valid: true}, nil
+}
+
+// marshalMessageTTL converts a message TTL into whole seconds for the
+// message_ttl column. A location without a TTL is stored as NULL.
+func marshalMessageTTL(ttl *duration.Duration) (sql.NullInt64, error) {
+	if ttl == nil {
+		return sql.NullInt64{}, nil
+	}
+	d, err := ptypes.Duration(ttl)
+	if err != nil {
+		return sql.NullInt64{}, err
+	}
+	return sql.NullInt64{Int64: int64(d / time.Second), Valid: true}, nil
+}
+
+// marshalPresenceCheck converts a presence check into its name for the
+// presence_check column. A location using the default is stored as NULL.
+func marshalPresenceCheck(check serverpb.PresenceCheck) sql.NullString {
+	if check == serverpb.PresenceCheck_PRESENCE_CHECK_UNSPECIFIED {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: check.String(), Valid: true}
+}
+
+func unmarshalEscalationPolicy(raw string) (*serverpb.EscalationPolicy, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	policy := &serverpb.EscalationPolicy{}
+	if err := protoUnmarshaller.Unmarshal(strings.NewReader(raw), policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
 }
 
 const locationSelectBaseQuery = `
-SELECT name, display_name, description, created_time, updated_time FROM location`
+SELECT name, display_name, description, time_zone, hours, escalation_policy, message_ttl, presence_check, created_time, updated_time FROM location`
 
 const locationInsertQuery = `
-INSERT INTO location (name, account, display_name, description, created_time, updated_time)
-VALUES ($1, $2, $3, $4, $5, NULL)`
+INSERT INTO location (name, account, display_name, description, time_zone, hours, escalation_policy, message_ttl, presence_check, created_time, updated_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULL)`
 
 const locationDeleteQuery = `
 DELETE FROM location WHERE name = $1`
 
 const locationUpdateQuery = `
-UPDATE location SET display_name = $1, description = $2, updated_time = $3 WHERE name = $4`
+UPDATE location SET display_name = $1, description = $2, time_zone = $3, hours = $4, escalation_policy = $5, message_ttl = $6, presence_check = $7, updated_time = $8 WHERE name = $9`
+
+const locationStatsQuery = `
+SELECT account, location, sum(rooms)::INT, sum(messages)::INT FROM (
+    SELECT account, location, count(*) AS rooms, 0 AS messages FROM room GROUP BY account, location
+    UNION ALL
+    SELECT account, location, 0 AS rooms, count(*) AS messages FROM message WHERE state != ALL ($1) GROUP BY account, location
+) AS stats GROUP BY account, location`
@@ -0,0 +1,347 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+)
+
+// Message provides a storage implementation for managing the messages sent
+// within a location
+type Message interface {
+	// GetMessage will retrieve a Message by name from storage
+	//
+	// This function will return a nil Message when a Message does not exist
+	// with the given name. An error will only be returned when the Message
+	// failed to be retrieved.
+	GetMessage(ctx context.Context, name string) (*serverpb.Message, error)
+	// ListMessages will list the messages within the parent with the highest
+	// priority first, and then the oldest first.
+	ListMessages(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Message, error)
+	// ListOutstandingMessages will list every message within the parent that
+	// hasn't been completed, cancelled or expired, the oldest first.
+	ListOutstandingMessages(ctx context.Context, parent string) ([]*serverpb.Message, error)
+	// ListChangedMessages will list the messages within the parent that were
+	// sent or changed at or after the time, in the order they changed.
+	ListChangedMessages(ctx context.Context, parent string, since time.Time) ([]*serverpb.Message, error)
+	// ListExpiredMessages will list the messages in every location that
+	// haven't been completed, cancelled or expired and whose expire time has
+	// passed by the time, the earliest first.
+	ListExpiredMessages(ctx context.Context, now time.Time) ([]*serverpb.Message, error)
+	// ListEscalatingMessages will list the messages in every location whose
+	// next escalation step is due by the time, the earliest first.
+	ListEscalatingMessages(ctx context.Context, now time.Time) ([]*serverpb.Message, error)
+	// CreateMessage will store a message that has been sent. A nil Message
+	// will be returned when a message with the same name already exists.
+	CreateMessage(ctx context.Context, message *serverpb.Message) (*serverpb.Message, error)
+	// UpdateMessage will change a message with update, which is called with
+	// the existing message within the transaction. The update is aborted with
+	// the error returned by update. A nil Message will be returned when the
+	// message does not exist.
+	UpdateMessage(ctx context.Context, name string, update func(existing *serverpb.Message) error) (*serverpb.Message, error)
+}
+
+// terminalMessageStates are the states a message can't leave, which are no
+// longer shown on devices.
+var terminalMessageStates = []string{
+	serverpb.Message_COMPLETED.String(),
+	serverpb.Message_CANCELLED.String(),
+	serverpb.Message_EXPIRED.String(),
+}
+
+func (s *store) GetMessage(ctx context.Context, name string) (*serverpb.Message, error) {
+	return doGetMessage(ctx, tracedConn{s.db}, name)
+}
+
+func (s *store) ListMessages(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Message, error) {
+	options := getListOptions(opts...)
+
+	queryParts, values, err := locationParentConditions(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	query := messageSelectBaseQuery
+	if len(queryParts) > 0 {
+		query += " WHERE " + strings.Join(queryParts, " AND ")
+	}
+
+	ctx, done := observe(ctx, "ListMessages", parent)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, paginateQuery(query+" ORDER BY priority DESC, created_time, name", options.pageInfo, options.pageSize), values...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanMessages(rows)
+}
+
+func (s *store) ListOutstandingMessages(ctx context.Context, parent string) ([]*serverpb.Message, error) {
+	queryParts, values, err := locationParentConditions(parent)
+	if err != nil {
+		return nil, err
+	}
+	values = append(values, pq.Array(terminalMessageStates))
+	queryParts = append(queryParts, fmt.Sprintf("state != ALL ($%d)", len(values)))
+
+	ctx, done := observe(ctx, "ListOutstandingMessages", parent)
+	query := messageSelectBaseQuery + " WHERE " + strings.Join(queryParts, " AND ") + " ORDER BY created_time, name"
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, values...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanMessages(rows)
+}
+
+func (s *store) ListChangedMessages(ctx context.Context, parent string, since time.Time) ([]*serverpb.Message, error) {
+	queryParts, values, err := locationParentConditions(parent)
+	if err != nil {
+		return nil, err
+	}
+	values = append(values, since.UTC())
+	queryParts = append(queryParts, fmt.Sprintf("updated_time >= $%d", len(values)))
+
+	ctx, done := observe(ctx, "ListChangedMessages", parent)
+	query := messageSelectBaseQuery + " WHERE " + strings.Join(queryParts, " AND ") + " ORDER BY updated_time, name"
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, values...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanMessages(rows)
+}
+
+func (s *store) ListExpiredMessages(ctx context.Context, now time.Time) ([]*serverpb.Message, error) {
+	ctx, done := observe(ctx, "ListExpiredMessages", "")
+	query := messageSelectBaseQuery + " WHERE state != ALL ($1) AND expire_time <= $2 ORDER BY expire_time, name"
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, pq.Array(terminalMessageStates), now.UTC())
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanMessages(rows)
+}
+
+func (s *store) ListEscalatingMessages(ctx context.Context, now time.Time) ([]*serverpb.Message, error) {
+	ctx, done := observe(ctx, "ListEscalatingMessages", "")
+	query := messageSelectBaseQuery + " WHERE state != ALL ($1) AND next_escalation_time <= $2 ORDER BY next_escalation_time, name"
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, pq.Array(terminalMessageStates), now.UTC())
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanMessages(rows)
+}
+
+func (s *store) CreateMessage(ctx context.Context, message *serverpb.Message) (*serverpb.Message, error) {
+	var newMessage *serverpb.Message
+
+	err := doTransaction(ctx, s.db, "CreateMessage", message.Name, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		newMessage, err = doCreateMessage(ctx, tx, message)
+		return err
+	})
+
+	if isUniqueViolation(err) {
+		// The message was created by a concurrent request after we checked for it
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newMessage, nil
+}
+
+func (s *store) UpdateMessage(ctx context.Context, messageName string, update func(existing *serverpb.Message) error) (*serverpb.Message, error) {
+	var message *serverpb.Message
+
+	err := doTransaction(ctx, s.db, "UpdateMessage", messageName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if message, err = doGetMessage(ctx, tx, messageName); err != nil || message == nil {
+			return err
+		}
+		if err := update(message); err != nil {
+			return err
+		}
+		return doUpdateMessage(ctx, tx, message)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// doCreateMessage stores a new message within the transaction. A nil Message
+// will be returned when a message with the same name already exists.
+func doCreateMessage(ctx context.Context, tx tracedConn, message *serverpb.Message) (*serverpb.Message, error) {
+	messageName, err := name.ParseMessageName(message.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing, err := doGetMessage(ctx, tx, message.Name); err != nil || existing != nil {
+		return nil, err
+	}
+
+	newMessage := proto.Clone(message).(*serverpb.Message)
+	newMessage.SelfLink = serviceName + message.Name
+	newMessage.CreateTime = ptypes.TimestampNow()
+	newMessage.UpdateTime = newMessage.CreateTime
+
+	created, err := ptypes.Timestamp(newMessage.CreateTime)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := nullTime(newMessage.GetExpireTime())
+	if err != nil {
+		return nil, err
+	}
+	escalates, err := nullTime(newMessage.NextEscalationTime)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := protoMarshaller.MarshalToString(newMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		messageInsertQuery,
+		messageName.Message,
+		messageName.Account,
+		messageName.Location,
+		raw,
+		newMessage.State.String(),
+		int32(newMessage.Priority),
+		expires,
+		escalates,
+		created,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newMessage, nil
+}
+
+// doUpdateMessage stores the changes made to an existing message within the
+// transaction.
+func doUpdateMessage(ctx context.Context, tx tracedConn, message *serverpb.Message) error {
+	messageName, err := name.ParseMessageName(message.Name)
+	if err != nil {
+		return err
+	}
+
+	message.UpdateTime = ptypes.TimestampNow()
+	updated, err := ptypes.Timestamp(message.UpdateTime)
+	if err != nil {
+		return err
+	}
+	expires, err := nullTime(message.GetExpireTime())
+	if err != nil {
+		return err
+	}
+	escalates, err := nullTime(message.NextEscalationTime)
+	if err != nil {
+		return err
+	}
+	raw, err := protoMarshaller.MarshalToString(message)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		messageUpdateQuery,
+		raw,
+		message.State.String(),
+		int32(message.Priority),
+		expires,
+		escalates,
+		updated,
+		messageName.Account,
+		messageName.Location,
+		messageName.Message,
+	)
+	return err
+}
+
+func doGetMessage(ctx context.Context, query retriever, fullyQualifiedName string) (*serverpb.Message, error) {
+	messageName, err := name.ParseMessageName(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "GetMessage", fullyQualifiedName)
+	row := query.QueryRowContext(ctx, messageSelectBaseQuery+" WHERE account = $1 AND location = $2 AND name = $3", messageName.Account, messageName.Location, messageName.Message)
+	message, err := scanMessage(row)
+	done(err)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+func scanMessages(rows *sql.Rows) ([]*serverpb.Message, error) {
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var messages []*serverpb.Message
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, rows.Err()
+}
+
+func scanMessage(scan scanner) (*serverpb.Message, error) {
+	var messageID, account, location, rawMessage, state string
+	var createdTime, updatedTime time.Time
+	if err := scan.Scan(&messageID, &account, &location, &rawMessage, &state, &createdTime, &updatedTime); err != nil {
+		return nil, err
+	}
+
+	message := &serverpb.Message{}
+	if err := protoUnmarshaller.Unmarshal(strings.NewReader(rawMessage), message); err != nil {
+		return nil, err
+	}
+
+	fqn := name.MessageName{Account: account, Location: location, Message: messageID}.String()
+	message.Name = fqn
+	message.SelfLink = serviceName + fqn
+	message.Location = name.BuildLocation(account, location)
+	message.State = serverpb.Message_State(serverpb.Message_State_value[state])
+
+	var err error
+	if message.CreateTime, err = ptypes.TimestampProto(createdTime); err != nil {
+		return nil, err
+	}
+	if message.UpdateTime, err = ptypes.TimestampProto(updatedTime); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+const messageSelectBaseQuery = `
+SELECT name, account, location, message, state, created_time, updated_time FROM message`
+
+const messageInsertQuery = `
+INSERT INTO message (name, account, location, message, state, priority, expire_time, next_escalation_time, created_time, updated_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)`
+
+const messageUpdateQuery = `
+UPDATE message SET message = $1, state = $2, priority = $3, expire_time = $4, next_escalation_time = $5, updated_time = $6 WHERE account = $7 AND location = $8 AND name = $9`
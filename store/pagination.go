@@ -9,6 +9,7 @@ import (
 	"io"
 	"strconv"
 
+	"github.com/chacerapp/apiserver/metrics"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -47,6 +48,12 @@ type PageInfo struct {
 }
 
 func (p *paginator) GenerateNextPageToken(pager PageInfo, pageSize int32) (string, error) {
+	token, err := p.generateNextPageToken(pager, pageSize)
+	metrics.PageTokenGenerated(err)
+	return token, err
+}
+
+func (p *paginator) generateNextPageToken(pager PageInfo, pageSize int32) (string, error) {
 	// default to setting to 0
 	if pager.EndCursor == "" {
 		pager.EndCursor = "0"
@@ -99,6 +106,14 @@ func (p *paginator) ParsePageToken(token string) (PageInfo, error) {
 		return PageInfo{}, nil
 	}
 
+	pageInfo, err := p.parsePageToken(token)
+	offset, _ := strconv.Atoi(pageInfo.EndCursor)
+	metrics.PageTokenParsed(offset, err)
+	return pageInfo, err
+}
+
+func (p *paginator) parsePageToken(token string) (PageInfo, error) {
+
 	aesCipher, err := aes.NewCipher(p.secret)
 	if err != nil {
 		return PageInfo{}, err
@@ -139,3 +154,28 @@ func paginateQuery(query string, info PageInfo, pageSize int32) string {
 	}
 	return query
 }
+
+// paginateSlice returns the page of the results that paginateQuery would
+// have returned for a query.
+func paginateSlice(count int, info PageInfo, pageSize int32) (int, int, error) {
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	start := 0
+	if info.EndCursor != "" {
+		offset, err := strconv.Atoi(info.EndCursor)
+		if err != nil {
+			return 0, 0, err
+		}
+		start = offset
+	}
+	if start > count {
+		start = count
+	}
+	end := start + int(pageSize)
+	if end > count {
+		end = count
+	}
+	return start, end, nil
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Quota is a limit on the number of resources of a type on an account.
+type Quota string
+
+const (
+	QuotaDevices   Quota = "devices"
+	QuotaLocations Quota = "locations"
+	QuotaRooms     Quota = "rooms"
+)
+
+// defaultQuotas are the quotas used for each tier in place of any quota that
+// hasn't been set on an account. A quota that isn't set by the tier either is
+// unlimited.
+var defaultQuotas = map[serverpb.RateLimitTier]*serverpb.AccountQuotas{
+	serverpb.RateLimitTier_RATE_LIMIT_TIER_STANDARD: {
+		Locations: proto.Int32(10),
+		Devices:   proto.Int32(50),
+		Rooms:     proto.Int32(100),
+	},
+	serverpb.RateLimitTier_RATE_LIMIT_TIER_PREMIUM: {
+		Locations: proto.Int32(100),
+		Devices:   proto.Int32(500),
+		Rooms:     proto.Int32(1000),
+	},
+	serverpb.RateLimitTier_RATE_LIMIT_TIER_UNLIMITED: {},
+}
+
+// QuotaExceededError is returned when creating a resource would exceed a
+// quota of the account.
+type QuotaExceededError struct {
+	// The name of the account in the format of `accounts/*`.
+	Account string
+	Quota   Quota
+	Limit   int32
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s has reached its limit of %d %s", e.Account, e.Limit, e.Quota)
+}
+
+// GetAccountQuotas will retrieve the quotas of an account with the defaults
+// of its tier applied, along with the current usage of the account.
+//
+// This function will return nil quotas when the account does not exist.
+func (s *store) GetAccountQuotas(ctx context.Context, accountName string) (*serverpb.AccountQuotas, error) {
+	var quotas *serverpb.AccountQuotas
+
+	// Run in a transaction so the usage is consistent with the quotas
+	err := doTransaction(ctx, s.db, "GetAccountQuotas", accountName, func(ctx context.Context, tx tracedConn) error {
+		account, err := doGetAccount(ctx, tx, accountName)
+		if err != nil || account == nil {
+			return err
+		}
+
+		accountID, err := name.ParseAccount(account.Name)
+		if err != nil {
+			return err
+		}
+		quotas = effectiveQuotas(account.Quotas)
+		quotas.Usage, err = doGetAccountUsage(ctx, tx, accountID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return quotas, nil
+}
+
+// effectiveQuotas returns a copy of the quotas where every quota that hasn't
+// been set uses the default of the tier.
+func effectiveQuotas(quotas *serverpb.AccountQuotas) *serverpb.AccountQuotas {
+	defaults, ok := defaultQuotas[quotas.GetRateLimitTier()]
+	if !ok {
+		defaults = defaultQuotas[serverpb.RateLimitTier_RATE_LIMIT_TIER_STANDARD]
+	}
+
+	// The quotas that are set on the account replace the defaults, including
+	// a quota of 0
+	effective := proto.Clone(defaults).(*serverpb.AccountQuotas)
+	if quotas != nil {
+		proto.Merge(effective, quotas)
+	}
+	return effective
+}
+
+func doGetAccountUsage(ctx context.Context, query retriever, accountID string) (*serverpb.AccountUsage, error) {
+	usage := &serverpb.AccountUsage{}
+	if err := query.QueryRowContext(ctx, locationCountQuery, accountID).Scan(&usage.Locations); err != nil {
+		return nil, err
+	}
+	if err := query.QueryRowContext(ctx, roomCountQuery, accountID).Scan(&usage.Rooms); err != nil {
+		return nil, err
+	}
+	if err := query.QueryRowContext(ctx, deviceCountQuery, accountID).Scan(&usage.Devices); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// checkQuota verifies that another resource can be created on the account
+// without exceeding the quota. It must be called within the transaction that
+// creates the resource so concurrent requests can't exceed the quota. No
+// error will be returned when the account does not exist.
+func checkQuota(ctx context.Context, tx tracedConn, accountID string, quota Quota) error {
+	account, err := doGetAccount(ctx, tx, name.BuildAccount(accountID))
+	if err != nil || account == nil {
+		return err
+	}
+
+	quotas := effectiveQuotas(account.Quotas)
+	limit, query := quotas.Locations, locationCountQuery
+	switch quota {
+	case QuotaDevices:
+		limit, query = quotas.Devices, deviceCountQuery
+	case QuotaRooms:
+		limit, query = quotas.Rooms, roomCountQuery
+	}
+	if limit == nil {
+		return nil
+	}
+
+	var count int32
+	if err := tx.QueryRowContext(ctx, query, accountID).Scan(&count); err != nil {
+		return err
+	}
+	if count >= *limit {
+		return &QuotaExceededError{Account: account.Name, Quota: quota, Limit: *limit}
+	}
+	return nil
+}
+
+const locationCountQuery = `
+SELECT count(*) FROM location WHERE account = $1`
+
+const roomCountQuery = `
+SELECT count(*) FROM room WHERE account = $1`
+
+const deviceCountQuery = `
+SELECT count(*) FROM device WHERE account = $1`
@@ -14,6 +14,10 @@ import (
 	"github.com/lib/pq"
 )
 
+// DisplayOrder is the order used to list rooms in the order they should be
+// displayed.
+const DisplayOrder = "display"
+
 // Room provides a storage implementation for managing rooms within storage
 type Room interface {
 	// GetRoom will retrieve an Room by name from storage
@@ -22,34 +26,41 @@ type Room interface {
 	// exist with the given name. An error will only be returned when
 	// the Room failed to be retrieved.
 	GetRoom(ctx context.Context, name string) (*serverpb.Room, error)
+	// ListRooms will list the rooms within the parent. The rooms are ordered
+	// by name unless the page info has the DisplayOrder order, which lists
+	// the rooms of a single location in the order they should be displayed.
 	ListRooms(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Room, error)
 	CreateRoom(ctx context.Context, Room *serverpb.Room) (*serverpb.Room, error)
 	UpdateRoom(ctx context.Context, Room *serverpb.Room, opts ...UpdateOption) (*serverpb.Room, error)
 	DeleteRoom(ctx context.Context, name string) (*serverpb.Room, error)
+	// SetRoomState will change the state of a room and record the change in
+	// the history of the room. A nil Room will be returned when the room does
+	// not exist. The precondition of the options is run against the existing
+	// room before its state is changed.
+	SetRoomState(ctx context.Context, name string, state serverpb.RoomState, changedBy string, opts ...UpdateOption) (*serverpb.Room, error)
+	// ListRoomStateChanges will list the changes to the state of a room with
+	// the most recent change first.
+	ListRoomStateChanges(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.RoomStateChange, error)
+	// ListChangedRooms will list the rooms within the parent whose state
+	// changed at or after the time, in the order they changed. New rooms are
+	// included since they start in the AVAILABLE state.
+	ListChangedRooms(ctx context.Context, parent string, since time.Time) ([]*serverpb.Room, error)
 }
 
 func (s *store) GetRoom(ctx context.Context, name string) (*serverpb.Room, error) {
-	return doGetRoom(ctx, s.db, name)
+	return doGetRoom(ctx, tracedConn{s.db}, name)
 }
 
 func (s *store) ListRooms(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.Room, error) {
 	options := getListOptions(opts...)
 
-	counter := 1
-	var queryParts []string
-	var values []interface{}
-	accountName, locationName, err := name.ParseLocation(parent)
+	queryParts, values, err := locationParentConditions(parent)
 	if err != nil {
 		return nil, err
 	}
-	if accountName != "-" {
-		queryParts = append(queryParts, fmt.Sprintf("account = $%d", counter))
-		values = append(values, accountName)
-		counter++
-	}
-	if locationName != "-" {
-		queryParts = append(queryParts, fmt.Sprintf("location = $%d", counter))
-		values = append(values, locationName)
+
+	if options.pageInfo.Order == DisplayOrder {
+		return s.listRoomsForDisplay(ctx, parent, options)
 	}
 
 	// Build the query based on the parent given
@@ -59,7 +70,9 @@ func (s *store) ListRooms(ctx context.Context, parent string, opts ...ListOption
 		query += fmt.Sprintf(" WHERE %s", strings.Join(queryParts, " AND "))
 	}
 
-	rows, err := s.db.Query(paginateQuery(query+" ORDER BY account, location, name", options.pageInfo, options.pageSize), values...)
+	ctx, done := observe(ctx, "ListRooms", parent)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, paginateQuery(query+" ORDER BY account, location, name", options.pageInfo, options.pageSize), values...)
+	done(err)
 	if err != nil {
 		return nil, err
 	}
@@ -78,12 +91,32 @@ func (s *store) ListRooms(ctx context.Context, parent string, opts ...ListOption
 	return rooms, nil
 }
 
+func (s *store) listRoomsForDisplay(ctx context.Context, parent string, options *listOptions) ([]*serverpb.Room, error) {
+	accountName, locationName, err := name.ParseLocation(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := doListRoomsForDisplay(ctx, tracedConn{s.db}, accountName, locationName)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := paginateSlice(len(rooms), options.pageInfo, options.pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return rooms[start:end], nil
+}
+
 // CreateRoom will create a new room in storage
 //
 // Only settable fields are respected when creating an room. All other fields
 // will be discarded or overwritten. The Quotas and Status of the returned room
 // will be guaranteed to be set. If an room with the provided name already exists
 // a nil room will be returned.
+// A *QuotaExceededError will be returned when the account has reached its
+// quota of rooms.
 func (s *store) CreateRoom(ctx context.Context, room *serverpb.Room) (*serverpb.Room, error) {
 	var newRoom *serverpb.Room
 
@@ -93,12 +126,15 @@ func (s *store) CreateRoom(ctx context.Context, room *serverpb.Room) (*serverpb.
 	}
 
 	// Run in a transaction so we can atomically check if the room already exists
-	err = doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err = doTransaction(ctx, s.db, "CreateRoom", room.Name, func(ctx context.Context, tx tracedConn) error {
 		// Check that the room doesn't already exists, when it does
 		// then we should return without returning an room.
 		if existing, err := doGetRoom(ctx, tx, room.Name); err != nil || existing != nil {
 			return err
 		}
+		if err := checkQuota(ctx, tx, accountName, QuotaRooms); err != nil {
+			return err
+		}
 
 		// Create the new room with all the defaults that should be set
 		newRoom = &serverpb.Room{
@@ -107,7 +143,10 @@ func (s *store) CreateRoom(ctx context.Context, room *serverpb.Room) (*serverpb.
 			SelfLink:    serviceName + room.Name,
 			DisplayName: room.DisplayName,
 			Description: room.Description,
+			SortIndex:   room.SortIndex,
+			State:       serverpb.RoomState_ROOM_STATE_AVAILABLE,
 		}
+		newRoom.StateChangeTime = newRoom.CreateTime
 
 		created, err := ptypes.Timestamp(newRoom.CreateTime)
 		if err != nil {
@@ -122,12 +161,17 @@ func (s *store) CreateRoom(ctx context.Context, room *serverpb.Room) (*serverpb.
 			locationName,
 			newRoom.DisplayName,
 			newRoom.Description,
+			newRoom.SortIndex,
+			newRoom.State.String(),
 			created,
 		)
 		return err
 	})
 
-	if err != nil {
+	if isUniqueViolation(err) {
+		// The room was created by a concurrent request after we checked for it
+		return nil, nil
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -140,7 +184,7 @@ func (s *store) UpdateRoom(ctx context.Context, room *serverpb.Room, opts ...Upd
 
 	options := getUpdateOptions(opts...)
 
-	err = doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err = doTransaction(ctx, s.db, "UpdateRoom", room.Name, func(ctx context.Context, tx tracedConn) error {
 		if existing, err = doGetRoom(ctx, tx, room.Name); err != nil || existing == nil {
 			return err
 		}
@@ -155,34 +199,48 @@ func (s *store) UpdateRoom(ctx context.Context, room *serverpb.Room, opts ...Upd
 		existing.UpdateTime = ptypes.TimestampNow()
 		existing.DisplayName = mergedRoom.DisplayName
 		existing.Description = mergedRoom.Description
+		existing.SortIndex = mergedRoom.SortIndex
 
 		updated, err := ptypes.Timestamp(existing.UpdateTime)
 		if err != nil {
 			return err
 		}
 
-		_, err = tx.ExecContext(ctx, updateRoomQuery, existing.DisplayName, existing.Description, updated, existing.Uid)
+		_, err = tx.ExecContext(ctx, updateRoomQuery, existing.DisplayName, existing.Description, existing.SortIndex, updated, existing.Uid)
 		return err
 	})
 
 	return existing, nil
 }
 
-func (s *store) DeleteRoom(ctx context.Context, name string) (*serverpb.Room, error) {
+func (s *store) DeleteRoom(ctx context.Context, roomName string) (*serverpb.Room, error) {
 	var room *serverpb.Room
 
+	accountName, locationName, roomID, err := name.ParseRoom(roomName)
+	if err != nil {
+		return nil, err
+	}
+
 	// Run in a transaction so we can atomically check if the room already exists
-	err := doTransaction(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+	err = doTransaction(ctx, s.db, "DeleteRoom", roomName, func(ctx context.Context, tx tracedConn) error {
 		var err error
 		// Check if the room exists
-		if room, err = doGetRoom(ctx, tx, name); err != nil {
+		if room, err = doGetRoom(ctx, tx, roomName); err != nil {
 			return err
 		} else if room == nil {
 			// return nil here so we can indicate the room does not exist in the system
 			return nil
 		}
 
-		_, err = tx.Exec(roomDeleteQuery, room.Uid)
+		if _, err = tx.ExecContext(ctx, roomDeleteQuery, room.Uid); err != nil {
+			return err
+		}
+		// The state history and group membership are removed with the room
+		// so they aren't inherited by a new room with the same name
+		if _, err = tx.ExecContext(ctx, roomStateChangeDeleteQuery, accountName, locationName, roomID); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, roomGroupRemoveRoomQuery, accountName, locationName, roomID)
 		return err
 	})
 
@@ -199,8 +257,10 @@ func doGetRoom(ctx context.Context, query retriever, fullyQualifiedName string)
 		return nil, err
 	}
 
+	ctx, done := observe(ctx, "GetRoom", fullyQualifiedName)
 	rows := query.QueryRowContext(ctx, selectRoomBaseQuery+` WHERE account = $1 AND location = $2 AND name = $3`, accountName, locationName, roomName)
 	room, err := scanRoom(rows)
+	done(err)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -212,10 +272,12 @@ func doGetRoom(ctx context.Context, query retriever, fullyQualifiedName string)
 func scanRoom(scan scanner) (*serverpb.Room, error) {
 	// Allocate all the variables we will need to scan
 	var uid, roomName, account, location, displayName, description string
+	var sortIndex int32
+	var state, stateChangedBy sql.NullString
 	var createdTime time.Time
-	var updateTime pq.NullTime
+	var updateTime, stateChangeTime pq.NullTime
 	// Scan the row from the database
-	if err := scan.Scan(&uid, &roomName, &account, &location, &displayName, &description, &createdTime, &updateTime); err != nil {
+	if err := scan.Scan(&uid, &roomName, &account, &location, &displayName, &description, &sortIndex, &createdTime, &updateTime, &state, &stateChangeTime, &stateChangedBy); err != nil {
 		return nil, err
 	}
 
@@ -232,28 +294,63 @@ func scanRoom(scan scanner) (*serverpb.Room, error) {
 		}
 	}
 
+	// Rooms that were created before they had a state are available
+	// from when they were created
+	stateChanged := created
+	if stateChangeTime.Valid {
+		stateChanged, err = ptypes.TimestampProto(stateChangeTime.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	fqn := name.BuildRoom(account, location, roomName)
 
 	return &serverpb.Room{
-		Uid:         uid,
-		SelfLink:    serviceName + fqn,
-		Name:        fqn,
-		CreateTime:  created,
-		UpdateTime:  updated,
-		DisplayName: displayName,
-		Description: description,
+		Uid:             uid,
+		SelfLink:        serviceName + fqn,
+		Name:            fqn,
+		CreateTime:      created,
+		UpdateTime:      updated,
+		DisplayName:     displayName,
+		Description:     description,
+		SortIndex:       sortIndex,
+		State:           parseRoomState(state.String),
+		StateChangeTime: stateChanged,
+		StateChangedBy:  stateChangedBy.String,
 	}, nil
 }
 
+// locationParentConditions returns the conditions that filter the resources
+// of a location, such as rooms or messages, to the ones within the parent
+// location, which can use a wildcard for the account or location.
+func locationParentConditions(parent string) ([]string, []interface{}, error) {
+	var queryParts []string
+	var values []interface{}
+	accountName, locationName, err := name.ParseLocation(parent, name.AllowWildcard())
+	if err != nil {
+		return nil, nil, err
+	}
+	if accountName != name.Wildcard {
+		values = append(values, accountName)
+		queryParts = append(queryParts, fmt.Sprintf("account = $%d", len(values)))
+	}
+	if locationName != name.Wildcard {
+		values = append(values, locationName)
+		queryParts = append(queryParts, fmt.Sprintf("location = $%d", len(values)))
+	}
+	return queryParts, values, nil
+}
+
 const selectRoomBaseQuery = `
-SELECT id, name, account, location, display_name, description, created_time, updated_time FROM room`
+SELECT id, name, account, location, display_name, description, sort_index, created_time, updated_time, state, state_change_time, state_changed_by FROM room`
 
 const roomInsertQuery = `
-INSERT INTO room (name, account, location, display_name, description, created_time, updated_time)
-VALUES ($1, $2, $3, $4, $5, $6, NULL)`
+INSERT INTO room (name, account, location, display_name, description, sort_index, state, created_time, state_change_time, updated_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8, NULL)`
 
 const roomDeleteQuery = `
 DELETE FROM room WHERE id = $1`
 
 const updateRoomQuery = `
-UPDATE room SET display_name = $1, description = $2, updated_time = $3 WHERE id = $4`
+UPDATE room SET display_name = $1, description = $2, sort_index = $3, updated_time = $4 WHERE id = $5`
@@ -0,0 +1,467 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+	"unicode"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lib/pq"
+)
+
+// RoomGroup provides a storage implementation for managing groups of rooms
+// within storage
+type RoomGroup interface {
+	// GetRoomGroup will retrieve a RoomGroup by name from storage
+	//
+	// This function will return a nil RoomGroup when a RoomGroup does not
+	// exist with the given name. An error will only be returned when
+	// the RoomGroup failed to be retrieved.
+	GetRoomGroup(ctx context.Context, name string) (*serverpb.RoomGroup, error)
+	// ListRoomGroups will list the room groups of a location in the order
+	// they should be displayed.
+	ListRoomGroups(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.RoomGroup, error)
+	// CreateRoomGroup will create a new room group. A nil RoomGroup will be
+	// returned when a group with the same name already exists. A
+	// *RoomInGroupError will be returned when one of the rooms is already in
+	// another group.
+	CreateRoomGroup(ctx context.Context, group *serverpb.RoomGroup) (*serverpb.RoomGroup, error)
+	// UpdateRoomGroup will update a room group. A nil RoomGroup will be
+	// returned when the group does not exist. A *RoomInGroupError will be
+	// returned when one of the rooms is already in another group.
+	UpdateRoomGroup(ctx context.Context, group *serverpb.RoomGroup, opts ...UpdateOption) (*serverpb.RoomGroup, error)
+	DeleteRoomGroup(ctx context.Context, name string) (*serverpb.RoomGroup, error)
+}
+
+// RoomInGroupError is returned when a room is added to a group while it is
+// already a member of another group.
+type RoomInGroupError struct {
+	// The name of the room in the format of `accounts/*/locations/*/rooms/*`.
+	Room string
+	// The name of the group the room is in, in the format of
+	// `accounts/*/locations/*/roomGroups/*`.
+	RoomGroup string
+}
+
+func (e *RoomInGroupError) Error() string {
+	return fmt.Sprintf("%s is already in %s", e.Room, e.RoomGroup)
+}
+
+func (s *store) GetRoomGroup(ctx context.Context, name string) (*serverpb.RoomGroup, error) {
+	return doGetRoomGroup(ctx, tracedConn{s.db}, name)
+}
+
+func (s *store) ListRoomGroups(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.RoomGroup, error) {
+	options := getListOptions(opts...)
+
+	accountName, locationName, err := name.ParseLocation(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListRoomGroups", parent)
+	query := paginateQuery(roomGroupSelectBaseQuery+" WHERE account = $1 AND location = $2 ORDER BY sort_index, display_name, name", options.pageInfo, options.pageSize)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, accountName, locationName)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanRoomGroups(rows)
+}
+
+func (s *store) CreateRoomGroup(ctx context.Context, group *serverpb.RoomGroup) (*serverpb.RoomGroup, error) {
+	var newGroup *serverpb.RoomGroup
+
+	groupName, err := name.ParseRoomGroupName(group.Name)
+	if err != nil {
+		return nil, err
+	}
+	roomIDs, err := roomGroupMemberIDs(group.Rooms)
+	if err != nil {
+		return nil, err
+	}
+
+	err = doTransaction(ctx, s.db, "CreateRoomGroup", group.Name, func(ctx context.Context, tx tracedConn) error {
+		if existing, err := doGetRoomGroup(ctx, tx, group.Name); err != nil || existing != nil {
+			return err
+		}
+		if err := checkRoomGroupMembers(ctx, tx, groupName, group.Rooms); err != nil {
+			return err
+		}
+
+		newGroup = &serverpb.RoomGroup{
+			Name:        group.Name,
+			SelfLink:    serviceName + group.Name,
+			CreateTime:  ptypes.TimestampNow(),
+			DisplayName: group.DisplayName,
+			Description: group.Description,
+			Rooms:       group.Rooms,
+			SortIndex:   group.SortIndex,
+		}
+
+		created, err := ptypes.Timestamp(newGroup.CreateTime)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			roomGroupInsertQuery,
+			groupName.RoomGroup,
+			groupName.Account,
+			groupName.Location,
+			newGroup.DisplayName,
+			newGroup.Description,
+			pq.Array(roomIDs),
+			newGroup.SortIndex,
+			created,
+		)
+		return err
+	})
+
+	if isUniqueViolation(err) {
+		// The group was created by a concurrent request after we checked for it
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newGroup, nil
+}
+
+func (s *store) UpdateRoomGroup(ctx context.Context, group *serverpb.RoomGroup, opts ...UpdateOption) (*serverpb.RoomGroup, error) {
+	var existing *serverpb.RoomGroup
+
+	options := getUpdateOptions(opts...)
+
+	groupName, err := name.ParseRoomGroupName(group.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = doTransaction(ctx, s.db, "UpdateRoomGroup", group.Name, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if existing, err = doGetRoomGroup(ctx, tx, group.Name); err != nil || existing == nil {
+			return err
+		}
+
+		merged, err := applyUpdateMask(existing, group, options.fieldMask)
+		if err != nil {
+			return err
+		}
+
+		mergedGroup := merged.(*serverpb.RoomGroup)
+		if err := checkRoomGroupMembers(ctx, tx, groupName, mergedGroup.Rooms); err != nil {
+			return err
+		}
+		roomIDs, err := roomGroupMemberIDs(mergedGroup.Rooms)
+		if err != nil {
+			return err
+		}
+
+		existing.UpdateTime = ptypes.TimestampNow()
+		existing.DisplayName = mergedGroup.DisplayName
+		existing.Description = mergedGroup.Description
+		existing.Rooms = mergedGroup.Rooms
+		existing.SortIndex = mergedGroup.SortIndex
+
+		updated, err := ptypes.Timestamp(existing.UpdateTime)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			roomGroupUpdateQuery,
+			existing.DisplayName,
+			existing.Description,
+			pq.Array(roomIDs),
+			existing.SortIndex,
+			updated,
+			existing.Uid,
+		)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func (s *store) DeleteRoomGroup(ctx context.Context, groupName string) (*serverpb.RoomGroup, error) {
+	var group *serverpb.RoomGroup
+
+	err := doTransaction(ctx, s.db, "DeleteRoomGroup", groupName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if group, err = doGetRoomGroup(ctx, tx, groupName); err != nil || group == nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, roomGroupDeleteQuery, group.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// checkRoomGroupMembers returns a *RoomInGroupError when one of the rooms is
+// already a member of a group other than the named group.
+func checkRoomGroupMembers(ctx context.Context, query retriever, groupName name.RoomGroupName, rooms []string) error {
+	groups, err := doListAllRoomGroups(ctx, query, groupName.Account, groupName.Location)
+	if err != nil {
+		return err
+	}
+
+	members := map[string]string{}
+	for _, group := range groups {
+		if group.Name == groupName.String() {
+			continue
+		}
+		for _, room := range group.Rooms {
+			members[room] = group.Name
+		}
+	}
+	for _, room := range rooms {
+		if other, ok := members[room]; ok {
+			return &RoomInGroupError{Room: room, RoomGroup: other}
+		}
+	}
+	return nil
+}
+
+// roomGroupMemberIDs returns the IDs of the rooms of a group, which are
+// stored in place of their full names.
+func roomGroupMemberIDs(rooms []string) ([]string, error) {
+	ids := make([]string, len(rooms))
+	for i, room := range rooms {
+		roomName, err := name.ParseRoomName(room)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = roomName.Room
+	}
+	return ids, nil
+}
+
+// doListAllRoomGroups lists every room group of a location in the order they
+// should be displayed.
+func doListAllRoomGroups(ctx context.Context, query retriever, accountName, locationName string) ([]*serverpb.RoomGroup, error) {
+	ctx, done := observe(ctx, "ListRoomGroups", name.BuildLocation(accountName, locationName))
+	rows, err := query.QueryContext(ctx, roomGroupSelectBaseQuery+" WHERE account = $1 AND location = $2 ORDER BY sort_index, display_name, name", accountName, locationName)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanRoomGroups(rows)
+}
+
+// doListRoomsForDisplay lists the rooms of a location in the order they should
+// be displayed. The rooms of each group come first, in the order of the
+// groups and of the rooms within them, followed by the rooms that aren't in
+// a group ordered by their sort index and display name.
+func doListRoomsForDisplay(ctx context.Context, query retriever, accountName, locationName string) ([]*serverpb.Room, error) {
+	groups, err := doListAllRoomGroups(ctx, query, accountName, locationName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListRooms", name.BuildLocation(accountName, locationName))
+	rows, err := query.QueryContext(ctx, selectRoomBaseQuery+" WHERE account = $1 AND location = $2", accountName, locationName)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rooms := map[string]*serverpb.Room{}
+	for rows.Next() {
+		room, err := scanRoom(rows)
+		if err != nil {
+			return nil, err
+		}
+		rooms[room.Name] = room
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ordered := make([]*serverpb.Room, 0, len(rooms))
+	for _, group := range groups {
+		for _, roomName := range group.Rooms {
+			if room, ok := rooms[roomName]; ok {
+				ordered = append(ordered, room)
+				delete(rooms, roomName)
+			}
+		}
+	}
+
+	ungrouped := make([]*serverpb.Room, 0, len(rooms))
+	for _, room := range rooms {
+		ungrouped = append(ungrouped, room)
+	}
+	sort.Slice(ungrouped, func(i, j int) bool {
+		a, b := ungrouped[i], ungrouped[j]
+		if a.SortIndex != b.SortIndex {
+			return a.SortIndex < b.SortIndex
+		}
+		if c := naturalCompare(a.DisplayName, b.DisplayName); c != 0 {
+			return c < 0
+		}
+		return naturalCompare(a.Name, b.Name) < 0
+	})
+
+	return append(ordered, ungrouped...), nil
+}
+
+// naturalCompare compares two strings with the runs of digits in them
+// compared by their value, so that "exam-2" is ordered before "exam-10". It
+// returns a negative number when a is before b, a positive number when a is
+// after b and 0 when they are equal.
+func naturalCompare(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	i, j := 0, 0
+	for i < len(ar) && j < len(br) {
+		if unicode.IsDigit(ar[i]) && unicode.IsDigit(br[j]) {
+			si, sj := i, j
+			for i < len(ar) && unicode.IsDigit(ar[i]) {
+				i++
+			}
+			for j < len(br) && unicode.IsDigit(br[j]) {
+				j++
+			}
+			// Compare the runs by their length once leading zeros are
+			// removed and then digit by digit
+			da := trimLeadingZeros(ar[si:i])
+			db := trimLeadingZeros(br[sj:j])
+			if len(da) != len(db) {
+				return len(da) - len(db)
+			}
+			for k := range da {
+				if da[k] != db[k] {
+					return int(da[k]) - int(db[k])
+				}
+			}
+			continue
+		}
+
+		ca, cb := unicode.ToLower(ar[i]), unicode.ToLower(br[j])
+		if ca != cb {
+			return int(ca) - int(cb)
+		}
+		i++
+		j++
+	}
+	return (len(ar) - i) - (len(br) - j)
+}
+
+func trimLeadingZeros(digits []rune) []rune {
+	for len(digits) > 1 && digits[0] == '0' {
+		digits = digits[1:]
+	}
+	return digits
+}
+
+func doGetRoomGroup(ctx context.Context, query retriever, fullyQualifiedName string) (*serverpb.RoomGroup, error) {
+	groupName, err := name.ParseRoomGroupName(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "GetRoomGroup", fullyQualifiedName)
+	row := query.QueryRowContext(ctx, roomGroupSelectBaseQuery+" WHERE account = $1 AND location = $2 AND name = $3", groupName.Account, groupName.Location, groupName.RoomGroup)
+	group, err := scanRoomGroup(row)
+	done(err)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func scanRoomGroups(rows *sql.Rows) ([]*serverpb.RoomGroup, error) {
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var groups []*serverpb.RoomGroup
+	for rows.Next() {
+		group, err := scanRoomGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
+func scanRoomGroup(scan scanner) (*serverpb.RoomGroup, error) {
+	var uid, groupID, account, location string
+	var displayName, description sql.NullString
+	var roomIDs []string
+	var sortIndex int32
+	var createdTime time.Time
+	var updateTime pq.NullTime
+	if err := scan.Scan(&uid, &groupID, &account, &location, &displayName, &description, pq.Array(&roomIDs), &sortIndex, &createdTime, &updateTime); err != nil {
+		return nil, err
+	}
+
+	created, err := ptypes.TimestampProto(createdTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *timestamp.Timestamp
+	if updateTime.Valid {
+		updated, err = ptypes.TimestampProto(updateTime.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rooms := make([]string, len(roomIDs))
+	for i, roomID := range roomIDs {
+		rooms[i] = name.BuildRoom(account, location, roomID)
+	}
+
+	fqn := name.RoomGroupName{Account: account, Location: location, RoomGroup: groupID}.String()
+
+	return &serverpb.RoomGroup{
+		Uid:         uid,
+		Name:        fqn,
+		SelfLink:    serviceName + fqn,
+		CreateTime:  created,
+		UpdateTime:  updated,
+		DisplayName: displayName.String,
+		Description: description.String,
+		Rooms:       rooms,
+		SortIndex:   sortIndex,
+	}, nil
+}
+
+const roomGroupSelectBaseQuery = `
+SELECT id, name, account, location, display_name, description, rooms, sort_index, created_time, updated_time FROM room_group`
+
+const roomGroupInsertQuery = `
+INSERT INTO room_group (name, account, location, display_name, description, rooms, sort_index, created_time, updated_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULL)`
+
+const roomGroupUpdateQuery = `
+UPDATE room_group SET display_name = $1, description = $2, rooms = $3, sort_index = $4, updated_time = $5 WHERE id = $6`
+
+const roomGroupDeleteQuery = `
+DELETE FROM room_group WHERE id = $1`
+
+const roomGroupRemoveRoomQuery = `
+UPDATE room_group SET rooms = array_remove(rooms, $3) WHERE account = $1 AND location = $2 AND $3 = ANY (rooms)`
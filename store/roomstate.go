@@ -0,0 +1,177 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func (s *store) SetRoomState(ctx context.Context, roomName string, state serverpb.RoomState, changedBy string, opts ...UpdateOption) (*serverpb.Room, error) {
+	var room *serverpb.Room
+	options := getUpdateOptions(opts...)
+
+	accountName, locationName, roomID, err := name.ParseRoom(roomName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = doTransaction(ctx, s.db, "SetRoomState", roomName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if room, err = doGetRoom(ctx, tx, roomName); err != nil || room == nil {
+			return err
+		}
+		if options.precondition != nil {
+			if err := options.precondition(room); err != nil {
+				return err
+			}
+		}
+
+		previous := room.State
+		room.State = state
+		room.StateChangeTime = ptypes.TimestampNow()
+		room.StateChangedBy = changedBy
+		changed, err := ptypes.Timestamp(room.StateChangeTime)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, roomStateUpdateQuery, state.String(), changed, changedBy, room.Uid); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(
+			ctx,
+			roomStateChangeInsertQuery,
+			accountName,
+			locationName,
+			roomID,
+			previous.String(),
+			state.String(),
+			changed,
+			changedBy,
+		)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return room, nil
+}
+
+func (s *store) ListRoomStateChanges(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.RoomStateChange, error) {
+	options := getListOptions(opts...)
+
+	accountName, locationName, roomID, err := name.ParseRoom(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListRoomStateChanges", parent)
+	query := paginateQuery(roomStateChangeSelectBaseQuery+" WHERE account = $1 AND location = $2 AND room = $3 ORDER BY change_time DESC, id", options.pageInfo, options.pageSize)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, accountName, locationName, roomID)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var changes []*serverpb.RoomStateChange
+	for rows.Next() {
+		change, err := scanRoomStateChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+func (s *store) ListChangedRooms(ctx context.Context, parent string, since time.Time) ([]*serverpb.Room, error) {
+	queryParts, values, err := locationParentConditions(parent)
+	if err != nil {
+		return nil, err
+	}
+	values = append(values, since.UTC())
+	queryParts = append(queryParts, fmt.Sprintf("state_change_time >= $%d", len(values)))
+
+	ctx, done := observe(ctx, "ListChangedRooms", parent)
+	query := selectRoomBaseQuery + " WHERE " + strings.Join(queryParts, " AND ") + " ORDER BY state_change_time, id"
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, values...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var rooms []*serverpb.Room
+	for rows.Next() {
+		room, err := scanRoom(rows)
+		if err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, room)
+	}
+	return rooms, rows.Err()
+}
+
+func scanRoomStateChange(scan scanner) (*serverpb.RoomStateChange, error) {
+	// Allocate all the variables we will need to scan
+	var id, account, location, room, state string
+	var previousState, changedBy sql.NullString
+	var changeTime time.Time
+	// Scan the row from the database
+	if err := scan.Scan(&id, &account, &location, &room, &previousState, &state, &changeTime, &changedBy); err != nil {
+		return nil, err
+	}
+
+	changed, err := ptypes.TimestampProto(changeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serverpb.RoomStateChange{
+		Name: name.RoomStateChangeName{
+			Account:     account,
+			Location:    location,
+			Room:        room,
+			StateChange: id,
+		}.String(),
+		PreviousState: parseRoomState(previousState.String),
+		State:         parseRoomState(state),
+		ChangeTime:    changed,
+		ChangedBy:     changedBy.String,
+	}, nil
+}
+
+// parseRoomState parses the name of a room state stored in the database.
+// Rooms without a state are available.
+func parseRoomState(state string) serverpb.RoomState {
+	if value, ok := serverpb.RoomState_value[state]; ok && value != 0 {
+		return serverpb.RoomState(value)
+	}
+	return serverpb.RoomState_ROOM_STATE_AVAILABLE
+}
+
+const roomStateUpdateQuery = `
+UPDATE room SET state = $1, state_change_time = $2, state_changed_by = $3 WHERE id = $4`
+
+const roomStateChangeSelectBaseQuery = `
+SELECT id, account, location, room, previous_state, state, change_time, changed_by FROM room_state_change`
+
+const roomStateChangeInsertQuery = `
+INSERT INTO room_state_change (account, location, room, previous_state, state, change_time, changed_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+const roomStateChangeDeleteQuery = `
+DELETE FROM room_state_change WHERE account = $1 AND location = $2 AND room = $3`
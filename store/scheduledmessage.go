@@ -0,0 +1,400 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lib/pq"
+)
+
+// ScheduledMessage provides a storage implementation for managing messages
+// that are sent at a later time
+type ScheduledMessage interface {
+	// GetScheduledMessage will retrieve a ScheduledMessage by name from storage
+	//
+	// This function will return a nil ScheduledMessage when a ScheduledMessage
+	// does not exist with the given name. An error will only be returned when
+	// the ScheduledMessage failed to be retrieved.
+	GetScheduledMessage(ctx context.Context, name string) (*serverpb.ScheduledMessage, error)
+	// ListScheduledMessages will list the scheduled messages of a location
+	// ordered by the time they are next sent, followed by the ones that have
+	// ended.
+	ListScheduledMessages(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.ScheduledMessage, error)
+	// CreateScheduledMessage will create a new scheduled message that is
+	// first sent at its NextSendTime. A nil ScheduledMessage will be returned
+	// when one with the same name already exists.
+	CreateScheduledMessage(ctx context.Context, scheduled *serverpb.ScheduledMessage) (*serverpb.ScheduledMessage, error)
+	// PauseScheduledMessage will stop a scheduled message from being due
+	// until it is resumed. A nil ScheduledMessage will be returned when it
+	// does not exist.
+	PauseScheduledMessage(ctx context.Context, name string) (*serverpb.ScheduledMessage, error)
+	// ResumeScheduledMessage will resume a paused scheduled message with the
+	// next send time returned by next, which is called with the existing
+	// scheduled message within the transaction. A nil ScheduledMessage will
+	// be returned when it does not exist.
+	ResumeScheduledMessage(ctx context.Context, name string, next func(existing *serverpb.ScheduledMessage) (*timestamp.Timestamp, error)) (*serverpb.ScheduledMessage, error)
+	DeleteScheduledMessage(ctx context.Context, name string) (*serverpb.ScheduledMessage, error)
+	// ListDueScheduledMessages will list the scheduled messages that aren't
+	// paused and are due to be sent by the time, the earliest first.
+	ListDueScheduledMessages(ctx context.Context, now time.Time) ([]*serverpb.ScheduledMessage, error)
+	// ClaimScheduledMessage will record that the scheduled message was sent
+	// for the time it was due and move it to the next send time, or end it
+	// when next is nil. The message that is sent is stored in the same
+	// transaction, so a claim is never recorded without its message, and a
+	// nil message only moves the schedule on. A nil ScheduledMessage will be
+	// returned when the scheduled message is no longer due at that time
+	// because it was claimed by another server, paused or deleted.
+	ClaimScheduledMessage(ctx context.Context, name string, due time.Time, next *time.Time, message *serverpb.Message) (*serverpb.ScheduledMessage, error)
+}
+
+func (s *store) GetScheduledMessage(ctx context.Context, name string) (*serverpb.ScheduledMessage, error) {
+	return doGetScheduledMessage(ctx, tracedConn{s.db}, name)
+}
+
+func (s *store) ListScheduledMessages(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.ScheduledMessage, error) {
+	options := getListOptions(opts...)
+
+	accountName, locationName, err := name.ParseLocation(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListScheduledMessages", parent)
+	query := paginateQuery(scheduledMessageSelectBaseQuery+" WHERE account = $1 AND location = $2 ORDER BY next_send_time IS NULL, next_send_time, name", options.pageInfo, options.pageSize)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, accountName, locationName)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanScheduledMessages(rows)
+}
+
+func (s *store) CreateScheduledMessage(ctx context.Context, scheduled *serverpb.ScheduledMessage) (*serverpb.ScheduledMessage, error) {
+	var newScheduled *serverpb.ScheduledMessage
+
+	scheduledName, err := name.ParseScheduledMessageName(scheduled.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = doTransaction(ctx, s.db, "CreateScheduledMessage", scheduled.Name, func(ctx context.Context, tx tracedConn) error {
+		if existing, err := doGetScheduledMessage(ctx, tx, scheduled.Name); err != nil || existing != nil {
+			return err
+		}
+
+		newScheduled = &serverpb.ScheduledMessage{
+			Name:         scheduled.Name,
+			SelfLink:     serviceName + scheduled.Name,
+			CreateTime:   ptypes.TimestampNow(),
+			Message:      scheduled.Message,
+			StartTime:    scheduled.StartTime,
+			Recurrence:   scheduled.Recurrence,
+			NextSendTime: scheduled.NextSendTime,
+		}
+
+		created, err := ptypes.Timestamp(newScheduled.CreateTime)
+		if err != nil {
+			return err
+		}
+		start, err := ptypes.Timestamp(newScheduled.StartTime)
+		if err != nil {
+			return err
+		}
+		next, err := nullTime(newScheduled.NextSendTime)
+		if err != nil {
+			return err
+		}
+		message, err := protoMarshaller.MarshalToString(newScheduled.Message)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			scheduledMessageInsertQuery,
+			scheduledName.ScheduledMessage,
+			scheduledName.Account,
+			scheduledName.Location,
+			message,
+			start,
+			newScheduled.Recurrence,
+			next,
+			created,
+		)
+		return err
+	})
+
+	if isUniqueViolation(err) {
+		// The scheduled message was created by a concurrent request after we checked for it
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newScheduled, nil
+}
+
+func (s *store) PauseScheduledMessage(ctx context.Context, scheduledName string) (*serverpb.ScheduledMessage, error) {
+	var scheduled *serverpb.ScheduledMessage
+
+	err := doTransaction(ctx, s.db, "PauseScheduledMessage", scheduledName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if scheduled, err = doGetScheduledMessage(ctx, tx, scheduledName); err != nil || scheduled == nil {
+			return err
+		}
+
+		scheduled.Paused = true
+		scheduled.UpdateTime = ptypes.TimestampNow()
+		updated, err := ptypes.Timestamp(scheduled.UpdateTime)
+		if err != nil {
+			return err
+		}
+		next, err := nullTime(scheduled.NextSendTime)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, scheduledMessagePauseQuery, scheduled.Paused, next, updated, scheduled.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+func (s *store) ResumeScheduledMessage(ctx context.Context, scheduledName string, next func(existing *serverpb.ScheduledMessage) (*timestamp.Timestamp, error)) (*serverpb.ScheduledMessage, error) {
+	var scheduled *serverpb.ScheduledMessage
+
+	err := doTransaction(ctx, s.db, "ResumeScheduledMessage", scheduledName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if scheduled, err = doGetScheduledMessage(ctx, tx, scheduledName); err != nil || scheduled == nil {
+			return err
+		}
+
+		if scheduled.NextSendTime, err = next(scheduled); err != nil {
+			return err
+		}
+		scheduled.Paused = false
+		scheduled.UpdateTime = ptypes.TimestampNow()
+		updated, err := ptypes.Timestamp(scheduled.UpdateTime)
+		if err != nil {
+			return err
+		}
+		nextSend, err := nullTime(scheduled.NextSendTime)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, scheduledMessagePauseQuery, scheduled.Paused, nextSend, updated, scheduled.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+func (s *store) DeleteScheduledMessage(ctx context.Context, scheduledName string) (*serverpb.ScheduledMessage, error) {
+	var scheduled *serverpb.ScheduledMessage
+
+	err := doTransaction(ctx, s.db, "DeleteScheduledMessage", scheduledName, func(ctx context.Context, tx tracedConn) error {
+		var err error
+		if scheduled, err = doGetScheduledMessage(ctx, tx, scheduledName); err != nil || scheduled == nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, scheduledMessageDeleteQuery, scheduled.Uid)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+func (s *store) ListDueScheduledMessages(ctx context.Context, now time.Time) ([]*serverpb.ScheduledMessage, error) {
+	ctx, done := observe(ctx, "ListDueScheduledMessages", "")
+	rows, err := tracedConn{s.db}.QueryContext(ctx, scheduledMessageSelectBaseQuery+" WHERE NOT paused AND next_send_time <= $1 ORDER BY next_send_time", now.UTC())
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanScheduledMessages(rows)
+}
+
+func (s *store) ClaimScheduledMessage(ctx context.Context, scheduledName string, due time.Time, next *time.Time, message *serverpb.Message) (*serverpb.ScheduledMessage, error) {
+	var scheduled *serverpb.ScheduledMessage
+
+	err := doTransaction(ctx, s.db, "ClaimScheduledMessage", scheduledName, func(ctx context.Context, tx tracedConn) error {
+		existing, err := doGetScheduledMessage(ctx, tx, scheduledName)
+		if err != nil || existing == nil || existing.Paused {
+			return err
+		}
+		// Only the server that moves the schedule on from the due time
+		// sends the message
+		if existingNext, err := ptypes.Timestamp(existing.NextSendTime); err != nil || !existingNext.Equal(due) {
+			return nil
+		}
+
+		var nextSend pq.NullTime
+		existing.NextSendTime = nil
+		if next != nil {
+			nextSend = pq.NullTime{Time: next.UTC(), Valid: true}
+			if existing.NextSendTime, err = ptypes.TimestampProto(*next); err != nil {
+				return err
+			}
+		}
+		if existing.LastSendTime, err = ptypes.TimestampProto(due); err != nil {
+			return err
+		}
+		existing.SendCount++
+
+		if _, err := tx.ExecContext(ctx, scheduledMessageClaimQuery, nextSend, due.UTC(), existing.SendCount, existing.Uid); err != nil {
+			return err
+		}
+		if message != nil {
+			// The claim is rolled back when the message can't be stored, so
+			// it is sent again on the next attempt
+			if created, err := doCreateMessage(ctx, tx, message); err != nil {
+				return err
+			} else if created == nil {
+				return fmt.Errorf("message %s already exists", message.Name)
+			}
+		}
+		scheduled = existing
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+// nullTime converts an optional timestamp into a value for a nullable column.
+func nullTime(t *timestamp.Timestamp) (pq.NullTime, error) {
+	if t == nil {
+		return pq.NullTime{}, nil
+	}
+	converted, err := ptypes.Timestamp(t)
+	if err != nil {
+		return pq.NullTime{}, err
+	}
+	return pq.NullTime{Time: converted, Valid: true}, nil
+}
+
+func doGetScheduledMessage(ctx context.Context, query retriever, fullyQualifiedName string) (*serverpb.ScheduledMessage, error) {
+	scheduledName, err := name.ParseScheduledMessageName(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "GetScheduledMessage", fullyQualifiedName)
+	row := query.QueryRowContext(ctx, scheduledMessageSelectBaseQuery+" WHERE account = $1 AND location = $2 AND name = $3", scheduledName.Account, scheduledName.Location, scheduledName.ScheduledMessage)
+	scheduled, err := scanScheduledMessage(row)
+	done(err)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return scheduled, nil
+}
+
+func scanScheduledMessages(rows *sql.Rows) ([]*serverpb.ScheduledMessage, error) {
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var scheduled []*serverpb.ScheduledMessage
+	for rows.Next() {
+		message, err := scanScheduledMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		scheduled = append(scheduled, message)
+	}
+	return scheduled, rows.Err()
+}
+
+func scanScheduledMessage(scan scanner) (*serverpb.ScheduledMessage, error) {
+	var uid, scheduledID, account, location, rawMessage string
+	var recurrence sql.NullString
+	var paused bool
+	var sendCount int32
+	var startTime, createdTime time.Time
+	var nextSendTime, lastSendTime, updatedTime pq.NullTime
+	if err := scan.Scan(&uid, &scheduledID, &account, &location, &rawMessage, &startTime, &recurrence, &paused, &nextSendTime, &lastSendTime, &sendCount, &createdTime, &updatedTime); err != nil {
+		return nil, err
+	}
+
+	message := &serverpb.Message{}
+	if err := protoUnmarshaller.Unmarshal(strings.NewReader(rawMessage), message); err != nil {
+		return nil, err
+	}
+
+	fqn := name.ScheduledMessageName{Account: account, Location: location, ScheduledMessage: scheduledID}.String()
+	scheduled := &serverpb.ScheduledMessage{
+		Uid:        uid,
+		Name:       fqn,
+		SelfLink:   serviceName + fqn,
+		Message:    message,
+		Recurrence: recurrence.String,
+		Paused:     paused,
+		SendCount:  sendCount,
+	}
+
+	var err error
+	if scheduled.StartTime, err = ptypes.TimestampProto(startTime); err != nil {
+		return nil, err
+	}
+	if scheduled.CreateTime, err = ptypes.TimestampProto(createdTime); err != nil {
+		return nil, err
+	}
+	for _, t := range []struct {
+		value pq.NullTime
+		dst   **timestamp.Timestamp
+	}{
+		{nextSendTime, &scheduled.NextSendTime},
+		{lastSendTime, &scheduled.LastSendTime},
+		{updatedTime, &scheduled.UpdateTime},
+	} {
+		if !t.value.Valid {
+			continue
+		}
+		if *t.dst, err = ptypes.TimestampProto(t.value.Time); err != nil {
+			return nil, err
+		}
+	}
+	return scheduled, nil
+}
+
+const scheduledMessageSelectBaseQuery = `
+SELECT id, name, account, location, message, start_time, recurrence, paused, next_send_time, last_send_time, send_count, created_time, updated_time FROM scheduled_message`
+
+const scheduledMessageInsertQuery = `
+INSERT INTO scheduled_message (name, account, location, message, start_time, recurrence, next_send_time, created_time, updated_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULL)`
+
+const scheduledMessagePauseQuery = `
+UPDATE scheduled_message SET paused = $1, next_send_time = $2, updated_time = $3 WHERE id = $4`
+
+const scheduledMessageClaimQuery = `
+UPDATE scheduled_message SET next_send_time = $1, last_send_time = $2, send_count = $3 WHERE id = $4`
+
+const scheduledMessageDeleteQuery = `
+DELETE FROM scheduled_message WHERE id = $1`
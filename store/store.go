@@ -4,18 +4,34 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/chacerapp/apiserver/metrics"
+	"github.com/chacerapp/apiserver/server/serverpb"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/protoc-gen-go/generator"
+	"github.com/lib/pq"
 	fieldmask "github.com/mennanov/fieldmask-utils"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
 type Storage interface {
 	Account
+	Audit
+	Contact
+	ContactGroup
+	ContactPresence
+	Device
 	Location
+	Message
 	Pagination
 	Room
+	RoomGroup
+	ScheduledMessage
+
+	// Ping verifies that the storage can be reached.
+	Ping(ctx context.Context) error
 }
 
 type store struct {
@@ -27,6 +43,10 @@ func New(db *sql.DB, paginator Pagination) Storage {
 	return &store{paginator, db}
 }
 
+func (s *store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
 const defaultPageSize = 25
 const serviceName = "//chacerappapis.com/"
 
@@ -43,6 +63,15 @@ func WithUpdateMask(mask *field_mask.FieldMask) UpdateOption {
 	}
 }
 
+// WithPrecondition sets a check that is run against the existing resource
+// within the transaction of the update. The update will be aborted with the
+// error returned by the check.
+func WithPrecondition(check func(existing proto.Message) error) UpdateOption {
+	return func(opts *updateOptions) {
+		opts.precondition = check
+	}
+}
+
 func WithPageSize(size int32) ListOption {
 	return func(opts *listOptions) {
 		opts.pageSize = size
@@ -81,7 +110,9 @@ type listOptions struct {
 }
 
 type updateOptions struct {
-	fieldMask *field_mask.FieldMask
+	fieldMask     *field_mask.FieldMask
+	precondition  func(existing proto.Message) error
+	suspensionEnd serverpb.SuspensionEnd
 }
 
 type inserter interface {
@@ -118,16 +149,55 @@ func applyUpdateMask(dst, src proto.Message, fieldMask *field_mask.FieldMask) (m
 	return merged, nil
 }
 
-func doTransaction(ctx context.Context, db *sql.DB, callback func(ctx context.Context, tx *sql.Tx) error) error {
+// maxTransactionAttempts is the number of times a transaction will be run
+// before a serialization conflict is returned to the caller.
+const maxTransactionAttempts = 5
+
+// doTransaction runs the callback within a transaction, recording a span and
+// the latency of the operation on the resource. CockroachDB will abort
+// transactions that conflict with each other, so the transaction will be
+// retried when that happens and the callback must be safe to run more than once.
+func doTransaction(ctx context.Context, db *sql.DB, operation, resource string, callback func(ctx context.Context, tx tracedConn) error) (err error) {
+	ctx, done := observe(ctx, operation, resource)
+	defer func() { done(err) }()
+
+	for attempt := 1; ; attempt++ {
+		err = runTransaction(ctx, db, callback)
+		if !isRetryable(err) || attempt == maxTransactionAttempts {
+			return err
+		}
+		metrics.TransactionRetried(operation)
+		trace.SpanFromContext(ctx).AddEvent("retrying transaction", trace.WithAttributes(
+			attribute.Int("attempt", attempt),
+			attribute.String("error", err.Error()),
+		))
+	}
+}
+
+func runTransaction(ctx context.Context, db *sql.DB, callback func(ctx context.Context, tx tracedConn) error) error {
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	if err := callback(ctx, tx); err != nil {
+	if err := callback(ctx, tracedConn{tx}); err != nil {
 		tx.Rollback()
 		return err
 	}
 
 	return tx.Commit()
 }
+
+// isRetryable reports whether the transaction was aborted because of a
+// conflict with another transaction and can be run again.
+func isRetryable(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "40001"
+}
+
+// isUniqueViolation reports whether the error was caused by a unique
+// constraint, such as when two resources with the same name are created.
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
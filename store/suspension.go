@@ -0,0 +1,169 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/chacerapp/apiserver/name"
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lib/pq"
+)
+
+// WithSuspensionEnd sets how the suspension of an account ended when the
+// status update moves the account out of suspension. When not set, moving
+// to the ACTIVE phase is recorded as SUSPENSION_END_ACTIVATED and moving to
+// the INACTIVE phase as SUSPENSION_END_DEACTIVATED.
+func WithSuspensionEnd(end serverpb.SuspensionEnd) UpdateOption {
+	return func(opts *updateOptions) {
+		opts.suspensionEnd = end
+	}
+}
+
+func (s *store) ListAccountSuspensions(ctx context.Context, parent string, opts ...ListOption) ([]*serverpb.AccountSuspension, error) {
+	options := getListOptions(opts...)
+
+	accountID, err := name.ParseAccount(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := observe(ctx, "ListAccountSuspensions", parent)
+	query := paginateQuery(accountSuspensionSelectBaseQuery+" WHERE account = $1 ORDER BY suspend_time DESC, id", options.pageInfo, options.pageSize)
+	rows, err := tracedConn{s.db}.QueryContext(ctx, query, accountID)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanAccountSuspensions(rows)
+}
+
+func (s *store) ListExpiredAccountSuspensions(ctx context.Context, now time.Time) ([]*serverpb.AccountSuspension, error) {
+	ctx, done := observe(ctx, "ListExpiredAccountSuspensions", "")
+	rows, err := tracedConn{s.db}.QueryContext(ctx, accountSuspensionSelectBaseQuery+" WHERE end_time IS NULL AND expire_time <= $1 ORDER BY expire_time", now.UTC())
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanAccountSuspensions(rows)
+}
+
+// doRecordSuspension keeps the suspension history of an account in step with
+// a change to its status. It must be called within the transaction that
+// updates the status, before the phase of the existing account is replaced.
+func doRecordSuspension(ctx context.Context, tx tracedConn, existing *serverpb.Account, status *serverpb.AccountStatus, end serverpb.SuspensionEnd) error {
+	from, to := existing.Status.Phase, status.Phase
+
+	accountID, err := name.ParseAccount(existing.Name)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+
+	switch {
+	case !suspendedPhase(from) && to == serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING:
+		var expires pq.NullTime
+		if status.ExpireTime != nil {
+			if expires.Time, err = ptypes.Timestamp(status.ExpireTime); err != nil {
+				return err
+			}
+			expires.Valid = true
+		}
+
+		existing.Status.ExpireTime = status.ExpireTime
+		_, err = tx.ExecContext(ctx, accountSuspensionInsertQuery, accountID, status.Reason, status.Message, now, expires)
+		return err
+
+	case suspendedPhase(from) && !suspendedPhase(to):
+		if end == serverpb.SuspensionEnd_SUSPENSION_END_UNSPECIFIED {
+			end = serverpb.SuspensionEnd_SUSPENSION_END_ACTIVATED
+			if to == serverpb.AccountPhase_ACCOUNT_PHASE_INACTIVE {
+				end = serverpb.SuspensionEnd_SUSPENSION_END_DEACTIVATED
+			}
+		}
+
+		existing.Status.ExpireTime = nil
+		_, err = tx.ExecContext(ctx, accountSuspensionEndQuery, now, end.String(), accountID)
+		return err
+	}
+	return nil
+}
+
+// suspendedPhase reports whether an account in the phase has a suspension
+// that hasn't ended.
+func suspendedPhase(phase serverpb.AccountPhase) bool {
+	return phase == serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDING ||
+		phase == serverpb.AccountPhase_ACCOUNT_PHASE_SUSPENDED
+}
+
+func scanAccountSuspensions(rows *sql.Rows) ([]*serverpb.AccountSuspension, error) {
+	// Close the rows once we are done retrieving results
+	defer rows.Close()
+
+	var suspensions []*serverpb.AccountSuspension
+	for rows.Next() {
+		suspension, err := scanAccountSuspension(rows)
+		if err != nil {
+			return nil, err
+		}
+		suspensions = append(suspensions, suspension)
+	}
+	return suspensions, rows.Err()
+}
+
+func scanAccountSuspension(scan scanner) (*serverpb.AccountSuspension, error) {
+	// Allocate all the variables we will need to scan
+	var id, accountID, reason string
+	var message, endReason sql.NullString
+	var suspendTime time.Time
+	var expireTime, endTime pq.NullTime
+	// Scan the row from the database
+	if err := scan.Scan(&id, &accountID, &reason, &message, &suspendTime, &expireTime, &endTime, &endReason); err != nil {
+		return nil, err
+	}
+
+	suspended, err := ptypes.TimestampProto(suspendTime)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := nullTimestamp(expireTime)
+	if err != nil {
+		return nil, err
+	}
+	ended, err := nullTimestamp(endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serverpb.AccountSuspension{
+		Name:        name.AccountSuspensionName{Account: accountID, Suspension: id}.String(),
+		Reason:      reason,
+		Message:     message.String,
+		SuspendTime: suspended,
+		ExpireTime:  expires,
+		EndTime:     ended,
+		End:         serverpb.SuspensionEnd(serverpb.SuspensionEnd_value[endReason.String]),
+	}, nil
+}
+
+func nullTimestamp(t pq.NullTime) (*timestamp.Timestamp, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return ptypes.TimestampProto(t.Time)
+}
+
+const accountSuspensionSelectBaseQuery = `
+SELECT id, account, reason, message, suspend_time, expire_time, end_time, end_reason FROM account_suspension`
+
+const accountSuspensionInsertQuery = `
+INSERT INTO account_suspension (account, reason, message, suspend_time, expire_time)
+VALUES ($1, $2, $3, $4, $5)`
+
+const accountSuspensionEndQuery = `
+UPDATE account_suspension SET end_time = $1, end_reason = $2 WHERE account = $3 AND end_time IS NULL`
+
+const accountSuspensionDeleteQuery = `
+DELETE FROM account_suspension WHERE account = $1`
@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/chacerapp/apiserver/metrics"
+	"github.com/chacerapp/apiserver/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// conn is a database, or a transaction, that statements can be run on.
+type conn interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// tracedConn records a span for every statement run on the connection.
+type tracedConn struct {
+	conn conn
+}
+
+func (c tracedConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startStatement(ctx, query)
+	result, err := c.conn.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (c tracedConn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startStatement(ctx, query)
+	rows, err := c.conn.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+	return rows, err
+}
+
+func (c tracedConn) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := startStatement(ctx, query)
+	row := c.conn.QueryRowContext(ctx, query, args...)
+	tracing.End(span, row.Err())
+	return row
+}
+
+// startStatement starts a span for a SQL statement that is named after the
+// operation of the statement, such as SELECT or INSERT.
+func startStatement(ctx context.Context, query string) (context.Context, trace.Span) {
+	query = strings.TrimSpace(query)
+	operation := query
+	if i := strings.IndexAny(query, " \n"); i >= 0 {
+		operation = query[:i]
+	}
+	operation = strings.ToUpper(operation)
+
+	return tracing.StartSpan(ctx, operation, "",
+		attribute.String("db.system", "cockroachdb"),
+		attribute.String("db.operation", operation),
+		attribute.String("db.statement", query),
+	)
+}
+
+// observe starts a span for a store operation on a resource and returns a
+// function that ends the span and records the metrics of the operation.
+func observe(ctx context.Context, operation, resource string) (context.Context, func(err error)) {
+	start := time.Now()
+	ctx, span := tracing.StartSpan(ctx, "store."+operation, resource)
+	return ctx, func(err error) {
+		metrics.ObserveQuery(operation, start, err)
+		if err == sql.ErrNoRows {
+			// A missing resource is reported as a nil result rather than a failure
+			err = nil
+		}
+		tracing.End(span, err)
+	}
+}
@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ExporterFactory creates a span exporter. The target is the part of the
+// exporter setting after the name, such as the path of a file.
+type ExporterFactory func(target string) (sdktrace.SpanExporter, error)
+
+var (
+	exportersMu sync.RWMutex
+	exporters   = map[string]ExporterFactory{
+		"stdout": newStdoutExporter,
+		"file":   newFileExporter,
+	}
+)
+
+// RegisterExporter makes a span exporter available under the given name.
+// Registering a name that already exists will replace it.
+func RegisterExporter(name string, factory ExporterFactory) {
+	exportersMu.Lock()
+	defer exportersMu.Unlock()
+	exporters[name] = factory
+}
+
+// NewExporter creates the span exporter described by the setting, which is in
+// the format `name` or `name:target`.
+//
+// Example: stdout, file:/var/log/chacerapp/traces.json
+func NewExporter(setting string) (sdktrace.SpanExporter, error) {
+	exporterName, target := setting, ""
+	if i := strings.Index(setting, ":"); i >= 0 {
+		exporterName, target = setting[:i], setting[i+1:]
+	}
+
+	exportersMu.RLock()
+	factory, ok := exporters[exporterName]
+	exportersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown trace exporter %q, expected one of %s", exporterName, strings.Join(exporterNames(), ", "))
+	}
+	return factory(target)
+}
+
+func exporterNames() []string {
+	exportersMu.RLock()
+	defer exportersMu.RUnlock()
+	names := make([]string, 0, len(exporters))
+	for name := range exporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newStdoutExporter writes every span to stdout as JSON.
+func newStdoutExporter(target string) (sdktrace.SpanExporter, error) {
+	if target != "" {
+		return nil, fmt.Errorf("the stdout trace exporter does not accept a target")
+	}
+	return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+}
+
+// newFileExporter appends every span to the file at the target path as JSON.
+func newFileExporter(target string) (sdktrace.SpanExporter, error) {
+	if target == "" {
+		return nil, fmt.Errorf("the file trace exporter requires a path, e.g. file:/tmp/traces.json")
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace file: %v", err)
+	}
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &fileExporter{exporter, file}, nil
+}
+
+// fileExporter closes the file once the exporter has been shut down.
+type fileExporter struct {
+	sdktrace.SpanExporter
+	file *os.File
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	err := e.SpanExporter.Shutdown(ctx)
+	if closeErr := e.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
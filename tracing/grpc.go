@@ -0,0 +1,112 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor starts a span for every unary RPC that continues the
+// trace propagated in the incoming metadata. The name and parent of the
+// request are recorded as attributes.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var attrs []attribute.KeyValue
+		if r, ok := req.(interface{ GetName() string }); ok {
+			attrs = append(attrs, resourceAttributes("chacerapp.resource", r.GetName())...)
+		}
+		if r, ok := req.(interface{ GetParent() string }); ok {
+			attrs = append(attrs, resourceAttributes("chacerapp.parent", r.GetParent())...)
+		}
+
+		ctx, span := startRPCSpan(ctx, info.FullMethod, attrs...)
+		resp, err := handler(ctx, req)
+		endRPCSpan(span, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor starts a span for every streaming RPC that
+// continues the trace propagated in the incoming metadata.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startRPCSpan(ss.Context(), info.FullMethod)
+		err := handler(srv, &tracedStream{ss, ctx})
+		endRPCSpan(span, err)
+		return err
+	}
+}
+
+func startRPCSpan(ctx context.Context, fullMethod string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	spanName := strings.TrimPrefix(fullMethod, "/")
+	attrs = append(attrs, attribute.String("rpc.system", "grpc"))
+	if i := strings.Index(spanName, "/"); i >= 0 {
+		attrs = append(attrs,
+			attribute.String("rpc.service", spanName[:i]),
+			attribute.String("rpc.method", spanName[i+1:]),
+		)
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, spanName,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// endRPCSpan records the status code of the RPC and ends the span. Only the
+// codes that indicate a problem with the server are recorded as errors, so
+// requests rejected because of the client, such as NotFound, are not.
+func endRPCSpan(span trace.Span, err error) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+	switch code {
+	case grpccodes.Unknown, grpccodes.DeadlineExceeded, grpccodes.Unimplemented,
+		grpccodes.Internal, grpccodes.Unavailable, grpccodes.DataLoss:
+		End(span, err)
+	default:
+		span.End()
+	}
+}
+
+// tracedStream replaces the context of a server stream with one that holds
+// the span of the RPC.
+type tracedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedStream) Context() context.Context {
+	return s.ctx
+}
+
+// metadataCarrier allows the trace context to be read from gRPC metadata.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chacerapp/apiserver/server/serverpb"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	))
+	info := &grpc.UnaryServerInfo{FullMethod: "/chacerapp.v1.Rooms/CreateRoom"}
+	req := &serverpb.CreateRoomRequest{Parent: "accounts/default/locations/main"}
+
+	_, err := UnaryServerInterceptor()(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, span := StartSpan(ctx, "store.GetLocation", "accounts/default/locations/main")
+		End(span, nil)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	store, rpc := spans[0], spans[1]
+
+	if rpc.Name() != "chacerapp.v1.Rooms/CreateRoom" {
+		t.Errorf("unexpected span name %q", rpc.Name())
+	}
+	if traceID := rpc.SpanContext().TraceID().String(); traceID != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected the trace to be continued from the metadata, got trace %s", traceID)
+	}
+	if store.Parent().SpanID() != rpc.SpanContext().SpanID() {
+		t.Error("expected the store span to be a child of the RPC span")
+	}
+
+	assertAttributes(t, rpc.Attributes(), map[attribute.Key]string{
+		"rpc.method":               "CreateRoom",
+		"chacerapp.parent.pattern": "accounts/*/locations/*",
+		"chacerapp.parent.type":    "chacerappapis.com/Location",
+	})
+	assertAttributes(t, store.Attributes(), map[attribute.Key]string{
+		"chacerapp.resource.name":    "accounts/default/locations/main",
+		"chacerapp.resource.pattern": "accounts/*/locations/*",
+	})
+}
+
+func assertAttributes(t *testing.T, attrs []attribute.KeyValue, expected map[attribute.Key]string) {
+	t.Helper()
+	actual := map[attribute.Key]string{}
+	for _, attr := range attrs {
+		actual[attr.Key] = attr.Value.Emit()
+	}
+	for key, value := range expected {
+		if actual[key] != value {
+			t.Errorf("expected attribute %s to be %q, got %q", key, value, actual[key])
+		}
+	}
+}
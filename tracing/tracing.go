@@ -0,0 +1,80 @@
+// Package tracing records spans for requests as they pass through the API
+// server, from the gRPC handlers down to each SQL statement.
+//
+// Spans are recorded with the global OpenTelemetry tracer provider, which
+// discards them until Start has been called with an exporter. The W3C trace
+// context of incoming requests is always propagated.
+package tracing
+
+import (
+	"context"
+
+	"github.com/chacerapp/apiserver/name"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/chacerapp/apiserver"
+	serviceName         = "chacerapp-apiserver"
+)
+
+func init() {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+}
+
+// Start installs a tracer provider that sends spans to the exporter. The
+// sample ratio is the fraction of new traces that will be recorded, while
+// requests that are part of a sampled trace are always recorded. The returned
+// function will flush any pending spans and stop the provider.
+func Start(exporter sdktrace.SpanExporter, sampleRatio float64) func(ctx context.Context) error {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown
+}
+
+// StartSpan starts a span as a child of any span in the context. When a
+// resource name is provided it will be recorded along with its resource type
+// and pattern, such as `accounts/*/locations/*`.
+func StartSpan(ctx context.Context, spanName, resourceName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, resourceAttributes("chacerapp.resource", resourceName)...)
+	return otel.Tracer(instrumentationName).Start(ctx, spanName, trace.WithAttributes(attrs...))
+}
+
+// End records the error, when there is one, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// resourceAttributes describes a resource name with attributes using the
+// given prefix. Nothing is returned for an empty name.
+func resourceAttributes(prefix, resourceName string) []attribute.KeyValue {
+	if resourceName == "" {
+		return nil
+	}
+
+	attrs := []attribute.KeyValue{attribute.String(prefix+".name", resourceName)}
+	if resourceType, pattern, ok := name.Match(resourceName, name.AllowWildcard()); ok {
+		attrs = append(attrs,
+			attribute.String(prefix+".type", resourceType.Type),
+			attribute.String(prefix+".pattern", pattern.Template()),
+		)
+	}
+	return attrs
+}